@@ -14,9 +14,13 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"text/tabwriter"
+	"time"
 
 	"github.com/gogo/protobuf/jsonpb"
 	"github.com/gogo/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
 	prompt "github.com/segmentio/go-prompt"
 	"github.com/spf13/cobra"
 	"github.com/zeebo/errs"
@@ -53,6 +57,11 @@ var (
 
 	irreparableLimit int32
 
+	uptimeWindow time.Duration
+
+	adminOperator string
+	nodeLogLimit  int32
+
 	// Commander CLI
 	rootCmd = &cobra.Command{
 		Use:   "inspector",
@@ -66,6 +75,10 @@ var (
 		Use:   "statdb",
 		Short: "commands for statdb",
 	}
+	overlayCmd = &cobra.Command{
+		Use:   "overlay",
+		Short: "commands for managing nodes in the overlay, every change is recorded in the admin log",
+	}
 	healthCmd = &cobra.Command{
 		Use:   "health",
 		Short: "commands for querying health of a stored data",
@@ -75,6 +88,12 @@ var (
 		Short: "list segments in irreparable database",
 		RunE:  getSegments,
 	}
+	markLostCmd = &cobra.Command{
+		Use:   "mark-lost <segment-path>",
+		Short: "mark an irreparable segment as permanently lost and notify the project",
+		Args:  cobra.MinimumNArgs(1),
+		RunE:  markSegmentLost,
+	}
 	countNodeCmd = &cobra.Command{
 		Use:   "count",
 		Short: "count nodes in kademlia and overlay",
@@ -103,6 +122,17 @@ var (
 		Short: "dump all nodes in the routing table",
 		RunE:  DumpNodes,
 	}
+	bucketsCmd = &cobra.Command{
+		Use:   "buckets",
+		Short: "show the fill, replacement cache and staleness of every routing table bucket",
+		RunE:  GetBucketList,
+	}
+	traceLookupCmd = &cobra.Command{
+		Use:   "trace <node_id>",
+		Short: "lookup a node by ID and show every node queried on the way",
+		Args:  cobra.MinimumNArgs(1),
+		RunE:  TraceLookup,
+	}
 	getStatsCmd = &cobra.Command{
 		Use:   "getstats <node_id>",
 		Short: "Get node stats",
@@ -115,6 +145,12 @@ var (
 		Args:  cobra.MinimumNArgs(1),
 		RunE:  GetCSVStats,
 	}
+	uptimeHistoryCmd = &cobra.Command{
+		Use:   "uptime <node_id>",
+		Short: "Show when a node was offline",
+		Args:  cobra.MinimumNArgs(1),
+		RunE:  GetUptimeHistory,
+	}
 	createStatsCmd = &cobra.Command{
 		// TODO: add args to usage
 		Use:   "createstats",
@@ -129,6 +165,41 @@ var (
 		Args:  cobra.MinimumNArgs(1),
 		RunE:  CreateCSVStats,
 	}
+	quarantineNodeCmd = &cobra.Command{
+		Use:   "quarantine <node_id> [reason]",
+		Short: "exclude a node from new uploads",
+		Args:  cobra.MinimumNArgs(1),
+		RunE:  QuarantineNode,
+	}
+	disqualifyNodeCmd = &cobra.Command{
+		Use:   "disqualify <node_id> [reason]",
+		Short: "disqualify a node permanently",
+		Args:  cobra.MinimumNArgs(1),
+		RunE:  DisqualifyNode,
+	}
+	reinstateNodeCmd = &cobra.Command{
+		Use:   "reinstate <node_id> [reason]",
+		Short: "lift the disqualification, suspension and quarantine of a node",
+		Args:  cobra.MinimumNArgs(1),
+		RunE:  ReinstateNode,
+	}
+	annotateNodeCmd = &cobra.Command{
+		Use:   "annotate <node_id> <note>",
+		Short: "add a note about a node to the admin log",
+		Args:  cobra.MinimumNArgs(2),
+		RunE:  AnnotateNode,
+	}
+	nodeLogCmd = &cobra.Command{
+		Use:   "log <node_id>",
+		Short: "show the changes made to a node by operators",
+		Args:  cobra.MinimumNArgs(1),
+		RunE:  GetNodeLog,
+	}
+	exportNodesCmd = &cobra.Command{
+		Use:   "export",
+		Short: "export the node table as csv",
+		RunE:  ExportNodes,
+	}
 	objectHealthCmd = &cobra.Command{
 		Use:   "object <project-id> <bucket> <encrypted-path>",
 		Short: "Get stats about an object's health",
@@ -150,6 +221,7 @@ type Inspector struct {
 	overlayclient pb.OverlayInspectorClient
 	irrdbclient   pb.IrreparableInspectorClient
 	healthclient  pb.HealthInspectorClient
+	adminclient   pb.OverlayAdminClient
 }
 
 // NewInspector creates a new gRPC inspector client for access to kad,
@@ -176,6 +248,7 @@ func NewInspector(address, path string) (*Inspector, error) {
 		overlayclient: pb.NewOverlayInspectorClient(conn),
 		irrdbclient:   pb.NewIrreparableInspectorClient(conn),
 		healthclient:  pb.NewHealthInspectorClient(conn),
+		adminclient:   pb.NewOverlayAdminClient(conn),
 	}, nil
 }
 
@@ -264,6 +337,247 @@ func DumpNodes(cmd *cobra.Command, args []string) (err error) {
 	return nil
 }
 
+// GetBucketList outputs a summary of every bucket in the routing table
+func GetBucketList(cmd *cobra.Command, args []string) (err error) {
+	i, err := NewInspector(*Addr, *IdentityPath)
+	if err != nil {
+		return ErrInspectorDial.Wrap(err)
+	}
+
+	res, err := i.kadclient.GetBucketList(context.Background(), &pb.GetBucketListRequest{})
+	if err != nil {
+		return ErrRequest.Wrap(err)
+	}
+
+	now := time.Now()
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Bucket ID\tNodes\tCached\tLast Lookup")
+	for _, bucket := range res.Buckets {
+		lastUpdated, err := ptypes.Timestamp(bucket.LastUpdated)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "%s\t%d/%d\t%d/%d\t%s ago\n", bucket.BucketId,
+			len(bucket.RoutingNodes), res.BucketSize,
+			len(bucket.CachedNodes), res.ReplacementCacheSize,
+			now.Sub(lastUpdated).Truncate(time.Second))
+		for _, node := range bucket.CachedNodes {
+			fmt.Fprintf(w, "  cached %s\t%s\t\t\n", node.Id, node.GetAddress().GetAddress())
+		}
+	}
+	return w.Flush()
+}
+
+// TraceLookup starts a Kademlia lookup for the provided Node ID and outputs every queried node
+func TraceLookup(cmd *cobra.Command, args []string) (err error) {
+	nodeID, err := storj.NodeIDFromString(args[0])
+	if err != nil {
+		return err
+	}
+
+	i, err := NewInspector(*Addr, *IdentityPath)
+	if err != nil {
+		return ErrInspectorDial.Wrap(err)
+	}
+
+	res, err := i.kadclient.TraceLookup(context.Background(), &pb.TraceLookupRequest{
+		Id: nodeID,
+	})
+	if err != nil {
+		return ErrRequest.Wrap(err)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Hop\tNode ID\tAddress\tResponse Time\tReturned\tError")
+	for k, hop := range res.Hops {
+		responseTime, err := ptypes.Duration(hop.ResponseTime)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%d\t%s\n", k+1, hop.Node.Id, hop.Node.GetAddress().GetAddress(),
+			responseTime, len(hop.ReturnedIds), hop.Error)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	duration, err := ptypes.Duration(res.Duration)
+	if err != nil {
+		return err
+	}
+	if res.Error != "" {
+		fmt.Printf("\nLookup failed after %s: %s\n", duration, res.Error)
+		return nil
+	}
+	fmt.Printf("\nFound %s at %s in %s\n", res.Node.Id, res.Node.GetAddress().GetAddress(), duration)
+	return nil
+}
+
+// QuarantineNode excludes a node from new uploads
+func QuarantineNode(cmd *cobra.Command, args []string) (err error) {
+	return changeNode(args, func(i *Inspector, req *pb.NodeAdminRequest) (*pb.NodeAdminResponse, error) {
+		return i.adminclient.QuarantineNode(context.Background(), req)
+	})
+}
+
+// DisqualifyNode disqualifies a node permanently
+func DisqualifyNode(cmd *cobra.Command, args []string) (err error) {
+	return changeNode(args, func(i *Inspector, req *pb.NodeAdminRequest) (*pb.NodeAdminResponse, error) {
+		return i.adminclient.DisqualifyNode(context.Background(), req)
+	})
+}
+
+// ReinstateNode lifts the disqualification, suspension and quarantine of a node
+func ReinstateNode(cmd *cobra.Command, args []string) (err error) {
+	return changeNode(args, func(i *Inspector, req *pb.NodeAdminRequest) (*pb.NodeAdminResponse, error) {
+		return i.adminclient.ReinstateNode(context.Background(), req)
+	})
+}
+
+// AnnotateNode adds a note about a node to the admin log
+func AnnotateNode(cmd *cobra.Command, args []string) (err error) {
+	return changeNode(args, func(i *Inspector, req *pb.NodeAdminRequest) (*pb.NodeAdminResponse, error) {
+		return i.adminclient.AnnotateNode(context.Background(), req)
+	})
+}
+
+// changeNode sends the change of the node in args[0] with the remaining args as details and prints the node status
+func changeNode(args []string, change func(*Inspector, *pb.NodeAdminRequest) (*pb.NodeAdminResponse, error)) (err error) {
+	if adminOperator == "" {
+		return ErrArgs.New("operator is required, set it with --operator")
+	}
+
+	nodeID, err := storj.NodeIDFromString(args[0])
+	if err != nil {
+		return err
+	}
+
+	i, err := NewInspector(*Addr, *IdentityPath)
+	if err != nil {
+		return ErrInspectorDial.Wrap(err)
+	}
+
+	res, err := change(i, &pb.NodeAdminRequest{
+		NodeId:   nodeID,
+		Operator: adminOperator,
+		Details:  strings.Join(args[1:], " "),
+	})
+	if err != nil {
+		return ErrRequest.Wrap(err)
+	}
+
+	fmt.Printf("Node %s:\n", nodeID)
+	fmt.Printf("Disqualified: %s, Suspended: %s, Quarantined: %s\n",
+		formatTimestamp(res.Disqualified), formatTimestamp(res.Suspended), formatTimestamp(res.Quarantined))
+	return nil
+}
+
+// GetNodeLog shows the changes made to a node by operators
+func GetNodeLog(cmd *cobra.Command, args []string) (err error) {
+	nodeID, err := storj.NodeIDFromString(args[0])
+	if err != nil {
+		return err
+	}
+
+	i, err := NewInspector(*Addr, *IdentityPath)
+	if err != nil {
+		return ErrInspectorDial.Wrap(err)
+	}
+
+	res, err := i.adminclient.GetNodeLog(context.Background(), &pb.GetNodeLogRequest{
+		NodeId: nodeID,
+		Limit:  nodeLogLimit,
+	})
+	if err != nil {
+		return ErrRequest.Wrap(err)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Time\tAction\tOperator\tOperator ID\tDetails")
+	for _, entry := range res.Entries {
+		operatorID := ""
+		if !entry.OperatorId.IsZero() {
+			operatorID = entry.OperatorId.String()
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", formatTimestamp(entry.CreatedAt), entry.Action, entry.Operator, operatorID, entry.Details)
+	}
+	return w.Flush()
+}
+
+// ExportNodes writes the node table as csv
+func ExportNodes(cmd *cobra.Command, args []string) (err error) {
+	i, err := NewInspector(*Addr, *IdentityPath)
+	if err != nil {
+		return ErrInspectorDial.Wrap(err)
+	}
+
+	f, err := csvOutput()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err := f.Close()
+		if err != nil {
+			fmt.Printf("error closing file: %+v\n", err)
+		}
+	}()
+
+	w := csv.NewWriter(f)
+	defer w.Flush()
+
+	err = w.Write([]string{
+		"node id", "address", "last net", "country code", "email", "wallet",
+		"free bandwidth", "free disk", "version",
+		"audit count", "audit success count", "uptime count", "uptime success count",
+		"audit reputation", "uptime reputation",
+		"last contact success", "last contact failure",
+		"disqualified", "suspended", "quarantined", "exit initiated at", "exit finished at",
+	})
+	if err != nil {
+		return err
+	}
+
+	for offset, more := int64(0), true; more; {
+		res, err := i.adminclient.ExportNodes(context.Background(), &pb.ExportNodesRequest{
+			Offset: offset,
+		})
+		if err != nil {
+			return ErrRequest.Wrap(err)
+		}
+		offset += int64(len(res.Nodes))
+		more = res.More && len(res.Nodes) > 0
+
+		for _, node := range res.Nodes {
+			err = w.Write([]string{
+				node.NodeId.String(), node.Address, node.LastNet, node.CountryCode, node.Email, node.Wallet,
+				strconv.FormatInt(node.FreeBandwidth, 10), strconv.FormatInt(node.FreeDisk, 10), node.Version,
+				strconv.FormatInt(node.AuditCount, 10), strconv.FormatInt(node.AuditSuccessCount, 10),
+				strconv.FormatInt(node.UptimeCount, 10), strconv.FormatInt(node.UptimeSuccessCount, 10),
+				strconv.FormatFloat(node.AuditReputation, 'f', -1, 64), strconv.FormatFloat(node.UptimeReputation, 'f', -1, 64),
+				formatTimestamp(node.LastContactSuccess), formatTimestamp(node.LastContactFailure),
+				formatTimestamp(node.Disqualified), formatTimestamp(node.Suspended), formatTimestamp(node.Quarantined),
+				formatTimestamp(node.ExitInitiatedAt), formatTimestamp(node.ExitFinishedAt),
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// formatTimestamp formats ts as RFC3339, empty when ts is nil
+func formatTimestamp(ts *timestamp.Timestamp) string {
+	if ts == nil {
+		return ""
+	}
+	t, err := ptypes.Timestamp(ts)
+	if err != nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
 func prettyPrint(unformatted proto.Message) string {
 	m := jsonpb.Marshaler{Indent: "  ", EmitDefaults: true}
 	formatted, err := m.MarshalToString(unformatted)
@@ -328,9 +642,81 @@ func GetStats(cmd *cobra.Command, args []string) (err error) {
 	fmt.Printf("Stats for ID %s:\n", nodeID)
 	fmt.Printf("AuditSuccessRatio: %f, AuditCount: %d, UptimeRatio: %f, UptimeCount: %d,\n",
 		res.AuditRatio, res.AuditCount, res.UptimeRatio, res.UptimeCount)
+	fmt.Printf("AuditReputation: (alpha: %f, beta: %f), UptimeReputation: (alpha: %f, beta: %f),\n",
+		res.AuditReputationAlpha, res.AuditReputationBeta, res.UptimeReputationAlpha, res.UptimeReputationBeta)
 	return nil
 }
 
+// GetUptimeHistory shows when a node was offline within the window
+func GetUptimeHistory(cmd *cobra.Command, args []string) (err error) {
+	i, err := NewInspector(*Addr, *IdentityPath)
+	if err != nil {
+		return ErrInspectorDial.Wrap(err)
+	}
+
+	nodeID, err := storj.NodeIDFromString(args[0])
+	if err != nil {
+		return err
+	}
+
+	res, err := i.overlayclient.GetUptimeHistory(context.Background(), &pb.GetUptimeHistoryRequest{
+		NodeId: nodeID,
+		Window: ptypes.DurationProto(uptimeWindow),
+	})
+	if err != nil {
+		return ErrRequest.Wrap(err)
+	}
+
+	since, err := ptypes.Timestamp(res.Since)
+	if err != nil {
+		return err
+	}
+	until, err := ptypes.Timestamp(res.Until)
+	if err != nil {
+		return err
+	}
+	downtime, err := ptypes.Duration(res.Downtime)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Uptime history for ID %s from %s to %s:\n", nodeID, since.Format(time.RFC3339), until.Format(time.RFC3339))
+	fmt.Printf("Attempts: %d, FailedAttempts: %d, Downtime: %s\n", res.Attempts, res.FailedAttempts, downtime.Truncate(time.Second))
+	if res.Suspended != nil {
+		suspended, err := ptypes.Timestamp(res.Suspended)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Suspended since %s\n", suspended.Format(time.RFC3339))
+	}
+	if res.Disqualified != nil {
+		disqualified, err := ptypes.Timestamp(res.Disqualified)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Disqualified since %s\n", disqualified.Format(time.RFC3339))
+	}
+	if len(res.Windows) == 0 {
+		return nil
+	}
+
+	fmt.Println()
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Offline From\tOffline Until\tDuration")
+	for _, window := range res.Windows {
+		start, err := ptypes.Timestamp(window.Start)
+		if err != nil {
+			return err
+		}
+		end, err := ptypes.Timestamp(window.End)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", start.Format(time.RFC3339), end.Format(time.RFC3339), end.Sub(start).Truncate(time.Second))
+	}
+	return w.Flush()
+}
+
 // GetCSVStats gets node stats from overlay based on a csv
 func GetCSVStats(cmd *cobra.Command, args []string) (err error) {
 	i, err := NewInspector(*Addr, *IdentityPath)
@@ -364,6 +750,8 @@ func GetCSVStats(cmd *cobra.Command, args []string) (err error) {
 		fmt.Printf("Stats for ID %s:\n", nodeID)
 		fmt.Printf("AuditSuccessRatio: %f, AuditCount: %d, UptimeRatio: %f, UptimeCount: %d,\n",
 			res.AuditRatio, res.AuditCount, res.UptimeRatio, res.UptimeCount)
+		fmt.Printf("AuditReputation: (alpha: %f, beta: %f), UptimeReputation: (alpha: %f, beta: %f),\n",
+			res.AuditReputationAlpha, res.AuditReputationBeta, res.UptimeReputationAlpha, res.UptimeReputationBeta)
 	}
 	return nil
 }
@@ -707,6 +1095,26 @@ func getSegments(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// markSegmentLost marks the irreparable segment as permanently lost
+func markSegmentLost(cmd *cobra.Command, args []string) error {
+	i, err := NewInspector(*Addr, *IdentityPath)
+	if err != nil {
+		return ErrInspectorDial.Wrap(err)
+	}
+
+	if !prompt.Confirm(fmt.Sprintf("Segment %q will be deleted and the project notified. Continue? (y/n)", args[0])) {
+		return nil
+	}
+
+	res, err := i.irrdbclient.MarkSegmentLost(context.Background(), &pb.MarkSegmentLostRequest{Path: []byte(args[0])})
+	if err != nil {
+		return ErrRequest.Wrap(err)
+	}
+
+	fmt.Printf("Segment marked as lost, %d project members notified\n", res.NotifiedMembers)
+	return nil
+}
+
 // sortSegments by the object they belong to
 func sortSegments(segments []*pb.IrreparableSegment) map[string][]*pb.IrreparableSegment {
 	objects := make(map[string][]*pb.IrreparableSegment)
@@ -726,18 +1134,31 @@ func init() {
 	rootCmd.AddCommand(statsCmd)
 	rootCmd.AddCommand(irreparableCmd)
 	rootCmd.AddCommand(healthCmd)
+	rootCmd.AddCommand(overlayCmd)
 
 	kadCmd.AddCommand(countNodeCmd)
 	kadCmd.AddCommand(pingNodeCmd)
 	kadCmd.AddCommand(lookupNodeCmd)
 	kadCmd.AddCommand(nodeInfoCmd)
 	kadCmd.AddCommand(dumpNodesCmd)
+	kadCmd.AddCommand(bucketsCmd)
+	kadCmd.AddCommand(traceLookupCmd)
 
 	statsCmd.AddCommand(getStatsCmd)
 	statsCmd.AddCommand(getCSVStatsCmd)
+	statsCmd.AddCommand(uptimeHistoryCmd)
 	statsCmd.AddCommand(createStatsCmd)
 	statsCmd.AddCommand(createCSVStatsCmd)
 
+	irreparableCmd.AddCommand(markLostCmd)
+
+	overlayCmd.AddCommand(quarantineNodeCmd)
+	overlayCmd.AddCommand(disqualifyNodeCmd)
+	overlayCmd.AddCommand(reinstateNodeCmd)
+	overlayCmd.AddCommand(annotateNodeCmd)
+	overlayCmd.AddCommand(nodeLogCmd)
+	overlayCmd.AddCommand(exportNodesCmd)
+
 	healthCmd.AddCommand(objectHealthCmd)
 	healthCmd.AddCommand(segmentHealthCmd)
 
@@ -745,6 +1166,12 @@ func init() {
 
 	irreparableCmd.Flags().Int32Var(&irreparableLimit, "limit", 50, "max number of results per page")
 
+	overlayCmd.PersistentFlags().StringVar(&adminOperator, "operator", os.Getenv("USER"), "name of the operator making the change, recorded in the admin log")
+	nodeLogCmd.Flags().Int32Var(&nodeLogLimit, "limit", 50, "max number of log entries")
+	exportNodesCmd.Flags().StringVar(&CSVPath, "csv-path", "stdout", "csv path where command output is written")
+
+	uptimeHistoryCmd.Flags().DurationVar(&uptimeWindow, "window", 720*time.Hour, "how far back to show the uptime history")
+
 	flag.Parse()
 }
 
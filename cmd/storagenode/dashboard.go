@@ -35,6 +35,10 @@ func (dash *dashboardClient) dashboard(ctx context.Context) (*pb.DashboardRespon
 	return dash.client.Dashboard(ctx, &pb.DashboardRequest{})
 }
 
+func (dash *dashboardClient) satelliteStats(ctx context.Context) (*pb.SatelliteStatsResponse, error) {
+	return dash.client.SatelliteStats(ctx, &pb.SatelliteStatsRequest{})
+}
+
 func newDashboardClient(ctx context.Context, address string) (*dashboardClient, error) {
 	conn, err := transport.DialAddressInsecure(ctx, address)
 	if err != nil {
@@ -67,7 +71,12 @@ func cmdDashboard(cmd *cobra.Command, args []string) (err error) {
 			return err
 		}
 
-		if err := printDashboard(data); err != nil {
+		satellites, err := client.satelliteStats(ctx)
+		if err != nil {
+			return err
+		}
+
+		if err := printDashboard(data, satellites); err != nil {
 			return err
 		}
 
@@ -76,7 +85,7 @@ func cmdDashboard(cmd *cobra.Command, args []string) (err error) {
 	}
 }
 
-func printDashboard(data *pb.DashboardResponse) error {
+func printDashboard(data *pb.DashboardResponse, satellites *pb.SatelliteStatsResponse) error {
 	clearScreen()
 	color.NoColor = !useColor
 
@@ -136,6 +145,36 @@ func printDashboard(data *pb.DashboardResponse) error {
 			return err
 		}
 
+		if len(satellites.GetSatellites()) > 0 {
+			w = tabwriter.NewWriter(color.Output, 0, 0, 5, ' ', tabwriter.AlignRight)
+			fmt.Fprintf(w, "\n\t%s\t%s\t%s\t%s\t\n", color.GreenString("Disk"), color.GreenString("Bandwidth"), color.GreenString("Egress"), color.GreenString("Ingress"))
+			for _, satellite := range satellites.GetSatellites() {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t\n", satellite.SatelliteId.String(),
+					usedOf(satellite.GetUsedSpace(), satellite.GetAllocatedSpace()),
+					usedOf(satellite.GetUsedBandwidth(), satellite.GetAllocatedBandwidth()),
+					color.WhiteString(memory.Size(satellite.GetUsedEgress()).Base10String()),
+					color.WhiteString(memory.Size(satellite.GetUsedIngress()).Base10String()))
+			}
+			if err = w.Flush(); err != nil {
+				return err
+			}
+		}
+
+		freedSpace := color.WhiteString(memory.Size(stats.GetExpiredBytesFreed()).Base10String())
+		fmt.Fprintf(color.Output, "\nExpired pieces deleted %s (%s freed)\n", whiteInt(stats.GetExpiredPiecesDeleted()), freedSpace)
+
+		diskFree := memory.Size(stats.GetDiskFree()).Base10String()
+		if stats.GetDiskFull() {
+			fmt.Fprintf(color.Output, "Disk is almost full, %s free, uploads are refused\n", color.RedString(diskFree))
+		} else {
+			fmt.Fprintf(color.Output, "Disk has %s free\n", color.WhiteString(diskFree))
+		}
+		if stats.GetDiskDiscrepancy() {
+			fmt.Fprintf(color.Output, "Stored pieces take %s, but %s are accounted for\n",
+				color.YellowString(memory.Size(stats.GetDiskUsedEstimate()).Base10String()),
+				color.YellowString(memory.Size(stats.GetUsedSpace()).Base10String()))
+		}
+
 	} else {
 		color.Yellow("Loading...\n")
 	}
@@ -153,6 +192,14 @@ func printDashboard(data *pb.DashboardResponse) error {
 	return nil
 }
 
+// usedOf formats used bytes together with the allocation, when there is one
+func usedOf(used, allocated int64) string {
+	if allocated == 0 {
+		return color.WhiteString(memory.Size(used).Base10String())
+	}
+	return color.WhiteString(memory.Size(used).Base10String() + " / " + memory.Size(allocated).Base10String())
+}
+
 func whiteInt(value int64) string {
 	return color.WhiteString(fmt.Sprintf("%+v", value))
 }
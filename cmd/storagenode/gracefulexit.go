@@ -0,0 +1,88 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/process"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/transport"
+)
+
+func cmdExitSatellite(cmd *cobra.Command, args []string) (err error) {
+	ctx := process.Ctx(cmd)
+
+	satelliteID, err := storj.NodeIDFromString(args[0])
+	if err != nil {
+		return errs.New("invalid satellite id %q: %v", args[0], err)
+	}
+
+	conn, err := transport.DialAddressInsecure(ctx, gracefulExitCfg.Address)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, conn.Close()) }()
+
+	progress, err := pb.NewNodeGracefulExitClient(conn).InitiateGracefulExit(ctx, &pb.InitiateGracefulExitRequest{
+		SatelliteId: satelliteID,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Graceful exit from %s initiated, pieces will be transferred in the background.\n", satelliteID)
+	return printExitProgress([]*pb.ExitProgress{progress})
+}
+
+func cmdExitStatus(cmd *cobra.Command, args []string) (err error) {
+	ctx := process.Ctx(cmd)
+
+	conn, err := transport.DialAddressInsecure(ctx, gracefulExitCfg.Address)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, conn.Close()) }()
+
+	response, err := pb.NewNodeGracefulExitClient(conn).GetExitProgress(ctx, &pb.GetExitProgressRequest{})
+	if err != nil {
+		return err
+	}
+
+	if len(response.Progress) == 0 {
+		fmt.Println("No graceful exits initiated.")
+		return nil
+	}
+	return printExitProgress(response.Progress)
+}
+
+func printExitProgress(progress []*pb.ExitProgress) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Satellite ID\tInitiated\tStatus\tTransferred\tFailed Transfers")
+	for _, exit := range progress {
+		initiatedAt, err := ptypes.Timestamp(exit.InitiatedAt)
+		if err != nil {
+			return err
+		}
+
+		status := "in progress"
+		if exit.FinishedAt != nil {
+			status = "failed"
+			if exit.Successful {
+				status = "successful"
+			}
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%d\n", exit.SatelliteId, initiatedAt.Format(time.RFC3339), status, exit.PiecesTransferred, exit.TransfersFailed)
+	}
+	return w.Flush()
+}
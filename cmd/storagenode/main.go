@@ -8,7 +8,9 @@ import (
 	"os"
 	"path/filepath"
 	"sort"
+	"strings"
 	"text/tabwriter"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/zeebo/errs"
@@ -20,6 +22,7 @@ import (
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/process"
 	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storage/filestore"
 	"storj.io/storj/storagenode"
 	"storj.io/storj/storagenode/storagenodedb"
 )
@@ -66,13 +69,61 @@ var (
 		RunE:        cmdDashboard,
 		Annotations: map[string]string{"type": "helper"},
 	}
+	exitSatelliteCmd = &cobra.Command{
+		Use:         "exit-satellite <satellite-id>",
+		Short:       "Gracefully exit a satellite",
+		Args:        cobra.ExactArgs(1),
+		RunE:        cmdExitSatellite,
+		Annotations: map[string]string{"type": "helper"},
+	}
+	exitStatusCmd = &cobra.Command{
+		Use:         "exit-status",
+		Short:       "Display the progress of graceful exits",
+		RunE:        cmdExitStatus,
+		Annotations: map[string]string{"type": "helper"},
+	}
+	emptyTrashCmd = &cobra.Command{
+		Use:         "empty-trash",
+		Short:       "Permanently delete trashed pieces",
+		RunE:        cmdEmptyTrash,
+		Annotations: map[string]string{"type": "helper"},
+	}
+	migrateCmd = &cobra.Command{
+		Use:         "migrate <from-dir> <to-dir>",
+		Short:       "Move pieces between storage directories",
+		Long:        "Moves the pieces stored in one directory to another, for example to a new disk. The storage node must not be running. Trashed pieces are not moved, empty the trash first when the source directory is going to be removed.",
+		Args:        cobra.ExactArgs(2),
+		RunE:        cmdMigrate,
+		Annotations: map[string]string{"type": "helper"},
+	}
+	orderQueuesCmd = &cobra.Command{
+		Use:         "order-queues",
+		Short:       "Display the unsent orders of every satellite",
+		RunE:        cmdOrderQueues,
+		Annotations: map[string]string{"type": "helper"},
+	}
 
-	runCfg       StorageNodeFlags
-	setupCfg     StorageNodeFlags
-	diagCfg      storagenode.Config
+	runCfg     StorageNodeFlags
+	setupCfg   StorageNodeFlags
+	diagCfg    storagenode.Config
+	migrateCfg struct {
+		SkipUnknown bool `default:"false" help:"leave blobs without piece information in the source directory instead of failing"`
+
+		storagenode.Config
+	}
 	dashboardCfg struct {
 		Address string `default:"127.0.0.1:7778" help:"address for dashboard service"`
 	}
+	gracefulExitCfg struct {
+		Address string `default:"127.0.0.1:7778" help:"address for graceful exit service"`
+	}
+	emptyTrashCfg struct {
+		Address   string        `default:"127.0.0.1:7778" help:"address for the piece store inspector service"`
+		OlderThan time.Duration `default:"0s" help:"only delete pieces that were trashed at least this long ago"`
+	}
+	orderQueuesCfg struct {
+		Address string `default:"127.0.0.1:7778" help:"address for the piece store inspector service"`
+	}
 	defaultDiagDir string
 	confDir        string
 	identityDir    string
@@ -98,11 +149,21 @@ func init() {
 	rootCmd.AddCommand(configCmd)
 	rootCmd.AddCommand(diagCmd)
 	rootCmd.AddCommand(dashboardCmd)
+	rootCmd.AddCommand(exitSatelliteCmd)
+	rootCmd.AddCommand(exitStatusCmd)
+	rootCmd.AddCommand(emptyTrashCmd)
+	rootCmd.AddCommand(orderQueuesCmd)
+	rootCmd.AddCommand(migrateCmd)
 	cfgstruct.Bind(runCmd.Flags(), &runCfg, isDev, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	cfgstruct.BindSetup(setupCmd.Flags(), &setupCfg, isDev, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	cfgstruct.BindSetup(configCmd.Flags(), &setupCfg, isDev, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	cfgstruct.Bind(diagCmd.Flags(), &diagCfg, isDev, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	cfgstruct.Bind(dashboardCmd.Flags(), &dashboardCfg, isDev, cfgstruct.ConfDir(defaultDiagDir))
+	cfgstruct.Bind(exitSatelliteCmd.Flags(), &gracefulExitCfg, isDev, cfgstruct.ConfDir(defaultDiagDir))
+	cfgstruct.Bind(exitStatusCmd.Flags(), &gracefulExitCfg, isDev, cfgstruct.ConfDir(defaultDiagDir))
+	cfgstruct.Bind(emptyTrashCmd.Flags(), &emptyTrashCfg, isDev, cfgstruct.ConfDir(defaultDiagDir))
+	cfgstruct.Bind(orderQueuesCmd.Flags(), &orderQueuesCfg, isDev, cfgstruct.ConfDir(defaultDiagDir))
+	cfgstruct.Bind(migrateCmd.Flags(), &migrateCfg, isDev, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 }
 
 func databaseConfig(config storagenode.Config) storagenodedb.Config {
@@ -112,7 +173,22 @@ func databaseConfig(config storagenode.Config) storagenodedb.Config {
 		Info2:    filepath.Join(config.Storage.Path, "info.db"),
 		Pieces:   config.Storage.Path,
 		Kademlia: config.Kademlia.DBPath,
+
+		AdditionalPieces: additionalPaths(config.Storage.AdditionalPaths),
+		Placement:        filestore.Placement(config.Storage.Placement),
+	}
+}
+
+// additionalPaths splits a comma-separated list of directories
+func additionalPaths(list string) []string {
+	var paths []string
+	for _, path := range strings.Split(list, ",") {
+		path = strings.TrimSpace(path)
+		if path != "" {
+			paths = append(paths, path)
+		}
 	}
+	return paths
 }
 
 func cmdRun(cmd *cobra.Command, args []string) (err error) {
@@ -0,0 +1,81 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/pkg/process"
+	"storj.io/storj/storage/filestore"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/storagenodedb"
+)
+
+func cmdMigrate(cmd *cobra.Command, args []string) (err error) {
+	ctx := process.Ctx(cmd)
+	log := zap.L()
+
+	fromPath, err := filepath.Abs(args[0])
+	if err != nil {
+		return err
+	}
+	toPath, err := filepath.Abs(args[1])
+	if err != nil {
+		return err
+	}
+	if fromPath == toPath {
+		return errs.New("source and destination are the same directory %q", fromPath)
+	}
+	if _, err := os.Stat(fromPath); err != nil {
+		return err
+	}
+
+	// the storage node only finds pieces in the configured directories
+	storagePaths := append([]string{migrateCfg.Storage.Path}, additionalPaths(migrateCfg.Storage.AdditionalPaths)...)
+	configured := false
+	for _, path := range storagePaths {
+		path, err := filepath.Abs(path)
+		if err != nil {
+			return err
+		}
+		configured = configured || path == toPath
+	}
+	if !configured {
+		return errs.New("destination %q is neither the storage path nor one of the additional storage paths, configure it before migrating", toPath)
+	}
+
+	db, err := storagenodedb.New(log.Named("db"), databaseConfig(migrateCfg.Config))
+	if err != nil {
+		return errs.New("Error starting master database on storagenode: %v", err)
+	}
+	defer func() { err = errs.Combine(err, db.Close()) }()
+
+	if err := db.CreateTables(); err != nil {
+		return errs.New("Error creating tables for master database on storagenode: %v", err)
+	}
+
+	from, err := filestore.NewAt(fromPath)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, from.Close()) }()
+
+	to, err := filestore.NewAt(toPath)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, to.Close()) }()
+
+	report, err := pieces.Migrate(ctx, log.Named("migrate"), from, to, db.PieceInfo(), migrateCfg.SkipUnknown)
+	fmt.Printf("Moved %d pieces (%s), skipped %d blobs without piece information.\n",
+		report.Moved, memory.Size(report.MovedBytes), report.Skipped)
+	return err
+}
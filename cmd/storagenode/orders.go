@@ -0,0 +1,58 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/process"
+	"storj.io/storj/pkg/transport"
+)
+
+func cmdOrderQueues(cmd *cobra.Command, args []string) (err error) {
+	ctx := process.Ctx(cmd)
+
+	conn, err := transport.DialAddressInsecure(ctx, orderQueuesCfg.Address)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, conn.Close()) }()
+
+	response, err := pb.NewPieceStoreInspectorClient(conn).OrderQueues(ctx, &pb.OrderQueuesRequest{})
+	if err != nil {
+		return err
+	}
+
+	if len(response.Queues) == 0 {
+		fmt.Println("No unsent orders.")
+		return nil
+	}
+	return printOrderQueues(response.Queues)
+}
+
+func printOrderQueues(queues []*pb.OrderQueuesResponse_Queue) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Satellite ID\tUnsent\tFailures\tNext Attempt")
+	for _, queue := range queues {
+		nextAttempt := "next interval"
+		if queue.NextAttempt != nil {
+			at, err := ptypes.Timestamp(queue.NextAttempt)
+			if err != nil {
+				return err
+			}
+			nextAttempt = at.Local().Format(time.RFC3339)
+		}
+
+		fmt.Fprintf(w, "%s\t%d\t%d\t%s\n", queue.SatelliteId, queue.Unsent, queue.Failures, nextAttempt)
+	}
+	return w.Flush()
+}
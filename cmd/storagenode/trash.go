@@ -0,0 +1,47 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/process"
+	"storj.io/storj/pkg/transport"
+)
+
+func cmdEmptyTrash(cmd *cobra.Command, args []string) (err error) {
+	ctx := process.Ctx(cmd)
+
+	if emptyTrashCfg.OlderThan < 0 {
+		return errs.New("invalid age %v, expected a positive duration", emptyTrashCfg.OlderThan)
+	}
+
+	trashedBefore, err := ptypes.TimestampProto(time.Now().Add(-emptyTrashCfg.OlderThan))
+	if err != nil {
+		return err
+	}
+
+	conn, err := transport.DialAddressInsecure(ctx, emptyTrashCfg.Address)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, conn.Close()) }()
+
+	response, err := pb.NewPieceStoreInspectorClient(conn).EmptyTrash(ctx, &pb.EmptyTrashRequest{
+		TrashedBefore: trashedBefore,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Deleted %d trashed pieces, freed %s.\n", response.PiecesDeleted, memory.Size(response.BytesFreed))
+	return nil
+}
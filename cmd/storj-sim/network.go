@@ -156,6 +156,7 @@ func newNetwork(flags *Flags) (*Processes, error) {
 		satellitePrivatePort   = 11000
 		storageNodePort        = 12000
 		storageNodePrivatePort = 13000
+		storageNodeConsolePort = 14000
 		consolePort            = 10100
 		bootstrapWebPort       = 10010
 		versioncontrolPort     = 10011
@@ -251,6 +252,9 @@ func newNetwork(flags *Flags) (*Processes, error) {
 				"--server.extensions.revocation=false",
 				"--server.use-peer-ca-whitelist=false",
 
+				// all storage nodes run on the same host
+				"--overlay.node.distinct-ip=false",
+
 				"--mail.smtp-server-address", "smtp.gmail.com:587",
 				"--mail.from", "Storj <yaroslav-satellite-test@storj.io>",
 				"--mail.template-path", filepath.Join(storjRoot, "web/satellite/static/emails"),
@@ -381,6 +385,7 @@ func newNetwork(flags *Flags) (*Processes, error) {
 				"--identity-dir", process.Directory,
 				"--server.address", process.Address,
 				"--server.private-address", net.JoinHostPort(host, strconv.Itoa(storageNodePrivatePort+i)),
+				"--console.address", net.JoinHostPort(host, strconv.Itoa(storageNodeConsolePort+i)),
 
 				"--kademlia.bootstrap-addr", bootstrap.Address,
 				"--kademlia.operator.email", fmt.Sprintf("storage%d@example.com", i),
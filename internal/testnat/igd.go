@@ -0,0 +1,282 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package testnat implements a stand-in internet gateway device for testing port mapping.
+package testnat
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+)
+
+const serviceType = "urn:schemas-upnp-org:service:WANIPConnection:1"
+
+// Mapping is a port mapping created on the IGD
+type Mapping struct {
+	ExternalPort   int
+	InternalPort   int
+	InternalClient string
+	Lifetime       time.Duration
+}
+
+// IGD is an internet gateway device answering UPnP and NAT-PMP requests on localhost
+type IGD struct {
+	ExternalIP net.IP
+
+	ssdp   net.PacketConn
+	natpmp net.PacketConn
+	http   *httptest.Server
+
+	mu            sync.Mutex
+	mappings      map[int]Mapping
+	permanentOnly bool
+}
+
+// NewIGD starts a new IGD with the external IP
+func NewIGD(externalIP net.IP) (*IGD, error) {
+	igd := &IGD{
+		ExternalIP: externalIP,
+		mappings:   map[int]Mapping{},
+	}
+
+	var err error
+	igd.ssdp, err = net.ListenPacket("udp4", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	igd.natpmp, err = net.ListenPacket("udp4", "127.0.0.1:0")
+	if err != nil {
+		return nil, errs.Combine(err, igd.ssdp.Close())
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/rootDesc.xml", igd.serveDescription)
+	mux.HandleFunc("/ctl/IPConn", igd.serveControl)
+	igd.http = httptest.NewServer(mux)
+
+	go igd.serveSSDP()
+	go igd.serveNATPMP()
+
+	return igd, nil
+}
+
+// SSDPAddress returns the address to send SSDP searches to
+func (igd *IGD) SSDPAddress() string { return igd.ssdp.LocalAddr().String() }
+
+// NATPMPAddress returns the address of the NAT-PMP service
+func (igd *IGD) NATPMPAddress() string { return igd.natpmp.LocalAddr().String() }
+
+// SetPermanentOnly makes the IGD accept only UPnP mappings without a lease duration
+func (igd *IGD) SetPermanentOnly(permanentOnly bool) {
+	igd.mu.Lock()
+	defer igd.mu.Unlock()
+	igd.permanentOnly = permanentOnly
+}
+
+// Mappings returns the current port mappings
+func (igd *IGD) Mappings() []Mapping {
+	igd.mu.Lock()
+	defer igd.mu.Unlock()
+
+	var mappings []Mapping
+	for _, mapping := range igd.mappings {
+		mappings = append(mappings, mapping)
+	}
+	return mappings
+}
+
+// Close stops the IGD
+func (igd *IGD) Close() error {
+	igd.http.Close()
+	return errs.Combine(igd.ssdp.Close(), igd.natpmp.Close())
+}
+
+func (igd *IGD) serveSSDP() {
+	buf := make([]byte, 2048)
+	for {
+		n, addr, err := igd.ssdp.ReadFrom(buf)
+		if err != nil {
+			return
+		}
+		if !bytes.HasPrefix(buf[:n], []byte("M-SEARCH")) {
+			continue
+		}
+
+		response := "HTTP/1.1 200 OK\r\n" +
+			"CACHE-CONTROL: max-age=120\r\n" +
+			"ST: urn:schemas-upnp-org:device:InternetGatewayDevice:1\r\n" +
+			"USN: uuid:testnat::urn:schemas-upnp-org:device:InternetGatewayDevice:1\r\n" +
+			"LOCATION: " + igd.http.URL + "/rootDesc.xml\r\n\r\n"
+		_, _ = igd.ssdp.WriteTo([]byte(response), addr)
+	}
+}
+
+func (igd *IGD) serveDescription(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/xml")
+	_, _ = fmt.Fprintf(w, `<?xml version="1.0"?>
+<root xmlns="urn:schemas-upnp-org:device-1-0">
+  <device>
+    <deviceType>urn:schemas-upnp-org:device:InternetGatewayDevice:1</deviceType>
+    <deviceList>
+      <device>
+        <deviceType>urn:schemas-upnp-org:device:WANDevice:1</deviceType>
+        <deviceList>
+          <device>
+            <deviceType>urn:schemas-upnp-org:device:WANConnectionDevice:1</deviceType>
+            <serviceList>
+              <service>
+                <serviceType>%s</serviceType>
+                <controlURL>/ctl/IPConn</controlURL>
+              </service>
+            </serviceList>
+          </device>
+        </deviceList>
+      </device>
+    </deviceList>
+  </device>
+</root>`, serviceType)
+}
+
+func (igd *IGD) serveControl(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	action := strings.Trim(r.Header.Get("SOAPAction"), `"`)
+	action = action[strings.LastIndex(action, "#")+1:]
+	args := parseArguments(body)
+
+	igd.mu.Lock()
+	defer igd.mu.Unlock()
+
+	switch action {
+	case "GetExternalIPAddress":
+		writeSOAP(w, action, "<NewExternalIPAddress>"+igd.ExternalIP.String()+"</NewExternalIPAddress>")
+	case "AddPortMapping":
+		lifetime, _ := strconv.Atoi(args["NewLeaseDuration"])
+		if igd.permanentOnly && lifetime != 0 {
+			writeSOAPError(w, 725, "OnlyPermanentLeasesSupported")
+			return
+		}
+		externalPort, _ := strconv.Atoi(args["NewExternalPort"])
+		internalPort, _ := strconv.Atoi(args["NewInternalPort"])
+		igd.mappings[externalPort] = Mapping{
+			ExternalPort:   externalPort,
+			InternalPort:   internalPort,
+			InternalClient: args["NewInternalClient"],
+			Lifetime:       time.Duration(lifetime) * time.Second,
+		}
+		writeSOAP(w, action, "")
+	case "DeletePortMapping":
+		externalPort, _ := strconv.Atoi(args["NewExternalPort"])
+		if _, ok := igd.mappings[externalPort]; !ok {
+			writeSOAPError(w, 714, "NoSuchEntryInArray")
+			return
+		}
+		delete(igd.mappings, externalPort)
+		writeSOAP(w, action, "")
+	default:
+		writeSOAPError(w, 401, "Invalid Action")
+	}
+}
+
+// parseArguments returns the arguments of a SOAP action
+func parseArguments(body []byte) map[string]string {
+	args := map[string]string{}
+	decoder := xml.NewDecoder(bytes.NewReader(body))
+	var name string
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return args
+		}
+		switch token := token.(type) {
+		case xml.StartElement:
+			name = token.Name.Local
+		case xml.CharData:
+			if name != "" {
+				args[name] = string(token)
+			}
+		case xml.EndElement:
+			name = ""
+		}
+	}
+}
+
+func writeSOAP(w http.ResponseWriter, action, body string) {
+	w.Header().Set("Content-Type", `text/xml; charset="utf-8"`)
+	_, _ = fmt.Fprintf(w, `<?xml version="1.0"?><s:Envelope xmlns:s="http://schemas.xmlsoap.org/soap/envelope/"><s:Body><u:%sResponse xmlns:u="%s">%s</u:%sResponse></s:Body></s:Envelope>`,
+		action, serviceType, body, action)
+}
+
+func writeSOAPError(w http.ResponseWriter, code int, description string) {
+	w.Header().Set("Content-Type", `text/xml; charset="utf-8"`)
+	w.WriteHeader(http.StatusInternalServerError)
+	_, _ = fmt.Fprintf(w, `<?xml version="1.0"?><s:Envelope xmlns:s="http://schemas.xmlsoap.org/soap/envelope/"><s:Body><s:Fault><faultcode>s:Client</faultcode><faultstring>UPnPError</faultstring><detail><UPnPError xmlns="urn:schemas-upnp-org:control-1-0"><errorCode>%d</errorCode><errorDescription>%s</errorDescription></UPnPError></detail></s:Fault></s:Body></s:Envelope>`,
+		code, description)
+}
+
+func (igd *IGD) serveNATPMP() {
+	buf := make([]byte, 64)
+	for {
+		n, addr, err := igd.natpmp.ReadFrom(buf)
+		if err != nil {
+			return
+		}
+		if n < 2 || buf[0] != 0 {
+			continue
+		}
+
+		switch op := buf[1]; {
+		case op == 0:
+			response := make([]byte, 12)
+			response[1] = 0x80
+			copy(response[8:12], igd.ExternalIP.To4())
+			_, _ = igd.natpmp.WriteTo(response, addr)
+		case (op == 1 || op == 2) && n >= 12:
+			internalPort := int(binary.BigEndian.Uint16(buf[4:6]))
+			externalPort := int(binary.BigEndian.Uint16(buf[6:8]))
+			lifetime := binary.BigEndian.Uint32(buf[8:12])
+
+			igd.mu.Lock()
+			if lifetime == 0 {
+				for port, mapping := range igd.mappings {
+					if mapping.InternalPort == internalPort {
+						delete(igd.mappings, port)
+					}
+				}
+			} else {
+				if externalPort == 0 {
+					externalPort = internalPort
+				}
+				igd.mappings[externalPort] = Mapping{
+					ExternalPort:   externalPort,
+					InternalPort:   internalPort,
+					InternalClient: addr.(*net.UDPAddr).IP.String(),
+					Lifetime:       time.Duration(lifetime) * time.Second,
+				}
+			}
+			igd.mu.Unlock()
+
+			response := make([]byte, 16)
+			response[1] = 0x80 | op
+			binary.BigEndian.PutUint16(response[8:10], uint16(internalPort))
+			binary.BigEndian.PutUint16(response[10:12], uint16(externalPort))
+			binary.BigEndian.PutUint32(response[12:16], lifetime)
+			_, _ = igd.natpmp.WriteTo(response, addr)
+		}
+	}
+}
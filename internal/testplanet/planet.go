@@ -35,6 +35,7 @@ import (
 	"storj.io/storj/pkg/audit"
 	"storj.io/storj/pkg/bwagreement"
 	"storj.io/storj/pkg/datarepair/checker"
+	"storj.io/storj/pkg/datarepair/irreparable"
 	"storj.io/storj/pkg/datarepair/repairer"
 	"storj.io/storj/pkg/discovery"
 	"storj.io/storj/pkg/identity"
@@ -50,9 +51,17 @@ import (
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/consoleweb"
+	"storj.io/storj/satellite/downtime"
+	"storj.io/storj/satellite/gc"
+	"storj.io/storj/satellite/gracefulexit"
 	"storj.io/storj/satellite/mailservice"
+	"storj.io/storj/satellite/metainfo"
 	"storj.io/storj/satellite/satellitedb"
 	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/collector"
+	"storj.io/storj/storagenode/console/consoleserver"
+	"storj.io/storj/storagenode/contact"
+	sngracefulexit "storj.io/storj/storagenode/gracefulexit"
 	"storj.io/storj/storagenode/orders"
 	"storj.io/storj/storagenode/piecestore"
 	"storj.io/storj/storagenode/storagenodedb"
@@ -445,12 +454,23 @@ func (planet *Planet) newSatellites(count int) ([]*satellite.Peer, error) {
 			},
 			Overlay: overlay.Config{
 				Node: overlay.NodeSelectionConfig{
-					UptimeRatio:           0,
-					UptimeCount:           0,
-					AuditSuccessRatio:     0,
-					AuditCount:            0,
-					NewNodeAuditThreshold: 0,
-					NewNodePercentage:     0,
+					UptimeCount:             0,
+					AuditCount:              0,
+					MinimumUptimeReputation: 0,
+					MinimumAuditReputation:  0,
+					NewNodeAuditThreshold:   0,
+					NewNodePercentage:       0,
+					DistinctIP:              false,
+
+					AuditReputationAlpha0:  1,
+					AuditReputationBeta0:   0,
+					AuditReputationLambda:  1,
+					AuditReputationWeight:  1,
+					AuditReputationDQ:      0,
+					UptimeReputationAlpha0: 1,
+					UptimeReputationBeta0:  0,
+					UptimeReputationLambda: 1,
+					UptimeReputationWeight: 1,
 				},
 			},
 			Discovery: discovery.Config{
@@ -458,6 +478,7 @@ func (planet *Planet) newSatellites(count int) ([]*satellite.Peer, error) {
 				DiscoveryInterval: 1 * time.Second,
 				RefreshInterval:   1 * time.Second,
 				RefreshLimit:      100,
+				ProbeAfter:        time.Hour,
 			},
 			PointerDB: pointerdb.Config{
 				DatabaseURL:          "bolt://" + filepath.Join(storageDir, "pointers.db"),
@@ -466,6 +487,9 @@ func (planet *Planet) newSatellites(count int) ([]*satellite.Peer, error) {
 				Overlay:              true,
 				BwExpiration:         45,
 			},
+			MetainfoLoop: metainfo.LoopConfig{
+				CoalesceDuration: 1 * time.Second,
+			},
 			BwAgreement: bwagreement.Config{},
 			Checker: checker.Config{
 				Interval: 30 * time.Second,
@@ -476,10 +500,38 @@ func (planet *Planet) newSatellites(count int) ([]*satellite.Peer, error) {
 				Timeout:      2 * time.Second,
 				MaxBufferMem: 4 * memory.MiB,
 			},
+			Irreparable: irreparable.Config{
+				Interval:   time.Hour,
+				BatchSize:  100,
+				RecheckAge: 24 * time.Hour,
+			},
 			Audit: audit.Config{
 				MaxRetriesStatDB:  0,
 				Interval:          30 * time.Second,
 				MinBytesPerSecond: 1 * memory.KB,
+				ReservoirSize:     100,
+			},
+			GarbageCollection: gc.Config{
+				Interval:          time.Hour,
+				Enabled:           true,
+				InitialPieces:     10,
+				FalsePositiveRate: 0.1,
+				ConcurrentSends:   1,
+				RetainSendTimeout: time.Minute,
+			},
+			GracefulExit: gracefulexit.Config{
+				ChoreInterval:       time.Minute,
+				ChoreBatchSize:      10,
+				EndpointBatchSize:   100,
+				MaxFailuresPerPiece: 3,
+				MaxFailurePercent:   10,
+			},
+			Downtime: downtime.Config{
+				Interval:            time.Minute,
+				Window:              720 * time.Hour,
+				SuspensionThreshold: 48 * time.Hour,
+				Retention:           1440 * time.Hour,
+				BatchSize:           100,
 			},
 			Tally: tally.Config{
 				Interval: 30 * time.Second,
@@ -605,11 +657,32 @@ func (planet *Planet) newStorageNodes(count int, whitelistedSatelliteIDs []strin
 				WhitelistedSatelliteIDs: strings.Join(whitelistedSatelliteIDs, ","),
 			},
 			Storage2: piecestore.Config{
+				MaxUsedSerialsSize:  memory.MiB,
+				UsedSerialsInterval: time.Hour,
 				Sender: orders.SenderConfig{
-					Interval: time.Hour,
-					Timeout:  time.Hour,
+					Interval:   time.Hour,
+					Timeout:    time.Hour,
+					BatchSize:  100,
+					MaxBackoff: time.Hour,
 				},
 			},
+			Collector: collector.Config{
+				Interval:       time.Hour,
+				BatchSize:      100,
+				TrashRetention: time.Hour,
+			},
+			Contact: contact.Config{
+				Interval: time.Hour,
+				Timeout:  time.Minute,
+			},
+			GracefulExit: sngracefulexit.Config{
+				Interval:          time.Hour,
+				TransferBatchSize: 10,
+				TransferTimeout:   time.Minute,
+			},
+			Console: consoleserver.Config{
+				Address: "127.0.0.1:0",
+			},
 			Version: planet.NewVersionConfig(),
 		}
 		if planet.config.Reconfigure.StorageNode != nil {
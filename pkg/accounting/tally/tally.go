@@ -7,16 +7,14 @@ import (
 	"context"
 	"time"
 
-	"github.com/gogo/protobuf/proto"
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
 	"storj.io/storj/pkg/accounting"
 	"storj.io/storj/pkg/overlay"
 	"storj.io/storj/pkg/pb"
-	"storj.io/storj/pkg/pointerdb"
 	"storj.io/storj/pkg/storj"
-	"storj.io/storj/storage"
+	"storj.io/storj/satellite/metainfo"
 )
 
 // Config contains configurable values for the tally service
@@ -27,7 +25,7 @@ type Config struct {
 // Service is the tally service for data stored on each storage node
 type Service struct {
 	logger       *zap.Logger
-	pointerdb    *pointerdb.Service
+	metaLoop     *metainfo.Loop
 	overlay      *overlay.Cache
 	limit        int
 	ticker       *time.Ticker
@@ -35,10 +33,10 @@ type Service struct {
 }
 
 // New creates a new tally Service
-func New(logger *zap.Logger, accountingDB accounting.DB, pointerdb *pointerdb.Service, overlay *overlay.Cache, limit int, interval time.Duration) *Service {
+func New(logger *zap.Logger, accountingDB accounting.DB, metaLoop *metainfo.Loop, overlay *overlay.Cache, limit int, interval time.Duration) *Service {
 	return &Service{
 		logger:       logger,
-		pointerdb:    pointerdb,
+		metaLoop:     metaLoop,
 		overlay:      overlay,
 		limit:        limit,
 		ticker:       time.NewTicker(interval),
@@ -86,8 +84,8 @@ func (t *Service) Tally(ctx context.Context) error {
 	return errs.Combine(errAtRest, errBucketInfo)
 }
 
-// CalculateAtRestData iterates through the pieces on pointerdb and calculates
-// the amount of at-rest data stored in each bucket and on each respective node
+// CalculateAtRestData joins the metainfo loop and calculates the amount
+// of at-rest data stored in each bucket and on each respective node
 func (t *Service) CalculateAtRestData(ctx context.Context) (latestTally time.Time, nodeData map[storj.NodeID]float64, bucketTallies map[string]*accounting.BucketTally, err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -95,95 +93,15 @@ func (t *Service) CalculateAtRestData(ctx context.Context) (latestTally time.Tim
 	if err != nil {
 		return latestTally, nodeData, bucketTallies, Error.Wrap(err)
 	}
-	nodeData = make(map[storj.NodeID]float64)
-	bucketTallies = make(map[string]*accounting.BucketTally)
-
-	var currentBucket string
-	var bucketCount int64
-	var totalTallies, currentBucketTally accounting.BucketTally
-
-	err = t.pointerdb.Iterate("", "", true, false,
-		func(it storage.Iterator) error {
-			var item storage.ListItem
-			for it.Next(&item) {
-
-				pointer := &pb.Pointer{}
-				err = proto.Unmarshal(item.Value, pointer)
-				if err != nil {
-					return Error.Wrap(err)
-				}
-
-				pathElements := storj.SplitPath(storj.Path(item.Key))
-				// check to make sure there are at least *4* path elements. the first three
-				// are project, segment, and bucket name, but we want to make sure we're talking
-				// about an actual object, and that there's an object name specified
-
-				// handle conditions with buckets with no files
-				if len(pathElements) == 3 {
-					bucketCount++
-				} else if len(pathElements) >= 4 {
-
-					project, segment, bucketName := pathElements[0], pathElements[1], pathElements[2]
-					bucketID := storj.JoinPaths(project, bucketName)
-
-					// paths are iterated in order, so everything in a bucket is
-					// iterated together. When a project or bucket changes,
-					// the previous bucket is completely finished.
-					if currentBucket != bucketID {
-						if currentBucket != "" {
-							// report the previous bucket and add to the totals
-							currentBucketTally.Report("bucket")
-							totalTallies.Combine(&currentBucketTally)
-
-							// add currentBucketTally to bucketTallies
-							bucketTallies[currentBucket] = &currentBucketTally
-							currentBucketTally = accounting.BucketTally{}
-						}
-						currentBucket = bucketID
-					}
-
-					currentBucketTally.AddSegment(pointer, segment == "l")
-				}
-
-				remote := pointer.GetRemote()
-				if remote == nil {
-					continue
-				}
-				pieces := remote.GetRemotePieces()
-				if pieces == nil {
-					t.logger.Debug("no pieces on remote segment")
-					continue
-				}
-				segmentSize := pointer.GetSegmentSize()
-				redundancy := remote.GetRedundancy()
-				if redundancy == nil {
-					t.logger.Debug("no redundancy scheme present")
-					continue
-				}
-				minReq := redundancy.GetMinReq()
-				if minReq <= 0 {
-					t.logger.Debug("pointer minReq must be an int greater than 0")
-					continue
-				}
-				pieceSize := segmentSize / int64(minReq)
-				for _, piece := range pieces {
-					nodeData[piece.NodeId] += float64(pieceSize)
-				}
-			}
-			return nil
-		},
-	)
+
+	observer := newObserver(t.logger)
+	err = t.metaLoop.Join(ctx, observer)
 	if err != nil {
 		return latestTally, nodeData, bucketTallies, Error.Wrap(err)
 	}
-
-	if currentBucket != "" {
-		// wrap up the last bucket
-		totalTallies.Combine(&currentBucketTally)
-		bucketTallies[currentBucket] = &currentBucketTally
-	}
-	totalTallies.Report("total")
-	mon.IntVal("bucket_count").Observe(bucketCount)
+	observer.finish()
+	nodeData, bucketTallies = observer.Node, observer.Bucket
+	mon.IntVal("bucket_count").Observe(observer.bucketCount)
 
 	if len(nodeData) == 0 {
 		return latestTally, nodeData, bucketTallies, nil
@@ -201,6 +119,119 @@ func (t *Service) CalculateAtRestData(ctx context.Context) (latestTally time.Tim
 	return latestTally, nodeData, bucketTallies, err
 }
 
+// observer observes metainfo and adds up tallies for nodes and buckets
+type observer struct {
+	log    *zap.Logger
+	Node   map[storj.NodeID]float64
+	Bucket map[string]*accounting.BucketTally
+
+	bucketCount        int64
+	currentBucket      string
+	totalTallies       accounting.BucketTally
+	currentBucketTally accounting.BucketTally
+}
+
+// newObserver returns an metainfo loop observer that adds up totals for buckets and nodes.
+func newObserver(log *zap.Logger) *observer {
+	return &observer{
+		log:    log,
+		Node:   make(map[storj.NodeID]float64),
+		Bucket: make(map[string]*accounting.BucketTally),
+	}
+}
+
+// addSegment adds the segment to the tally of the bucket it belongs to
+func (observer *observer) addSegment(path storj.Path, pointer *pb.Pointer) {
+	pathElements := storj.SplitPath(path)
+	// check to make sure there are at least *4* path elements. the first three
+	// are project, segment, and bucket name, but we want to make sure we're talking
+	// about an actual object, and that there's an object name specified
+
+	// handle conditions with buckets with no files
+	if len(pathElements) == 3 {
+		observer.bucketCount++
+		return
+	}
+	if len(pathElements) < 4 {
+		return
+	}
+
+	project, segment, bucketName := pathElements[0], pathElements[1], pathElements[2]
+	bucketID := storj.JoinPaths(project, bucketName)
+
+	// paths are iterated in order, so everything in a bucket is
+	// iterated together. When a project or bucket changes,
+	// the previous bucket is completely finished.
+	if observer.currentBucket != bucketID {
+		if observer.currentBucket != "" {
+			// report the previous bucket and add to the totals
+			observer.currentBucketTally.Report("bucket")
+			observer.finishBucket()
+		}
+		observer.currentBucket = bucketID
+	}
+
+	observer.currentBucketTally.AddSegment(pointer, segment == "l")
+}
+
+// finishBucket adds the current bucket to the totals
+func (observer *observer) finishBucket() {
+	observer.totalTallies.Combine(&observer.currentBucketTally)
+
+	currentBucketTally := observer.currentBucketTally
+	observer.Bucket[observer.currentBucket] = &currentBucketTally
+	observer.currentBucketTally = accounting.BucketTally{}
+}
+
+// finish wraps up the last bucket and reports the totals
+func (observer *observer) finish() {
+	if observer.currentBucket != "" {
+		// wrap up the last bucket
+		observer.finishBucket()
+		observer.currentBucket = ""
+	}
+	observer.totalTallies.Report("total")
+}
+
+// InlineSegment is called for each inline segment.
+func (observer *observer) InlineSegment(ctx context.Context, path storj.Path, pointer *pb.Pointer) (err error) {
+	observer.addSegment(path, pointer)
+	return nil
+}
+
+// RemoteObject is called for the last segment of each remote object, it's already counted by RemoteSegment.
+func (observer *observer) RemoteObject(ctx context.Context, path storj.Path, pointer *pb.Pointer) (err error) {
+	return nil
+}
+
+// RemoteSegment is called for each remote segment.
+func (observer *observer) RemoteSegment(ctx context.Context, path storj.Path, pointer *pb.Pointer) (err error) {
+	observer.addSegment(path, pointer)
+
+	remote := pointer.GetRemote()
+	pieces := remote.GetRemotePieces()
+	if pieces == nil {
+		observer.log.Debug("no pieces on remote segment")
+		return nil
+	}
+	segmentSize := pointer.GetSegmentSize()
+	redundancy := remote.GetRedundancy()
+	if redundancy == nil {
+		observer.log.Debug("no redundancy scheme present")
+		return nil
+	}
+	minReq := redundancy.GetMinReq()
+	if minReq <= 0 {
+		observer.log.Debug("pointer minReq must be an int greater than 0")
+		return nil
+	}
+	pieceSize := segmentSize / int64(minReq)
+	for _, piece := range pieces {
+		observer.Node[piece.NodeId] += float64(pieceSize)
+	}
+	return nil
+}
+
 // SaveAtRestRaw records raw tallies of at-rest-data and updates the LastTimestamp
 func (t *Service) SaveAtRestRaw(ctx context.Context, latestTally time.Time, created time.Time, nodeData map[storj.NodeID]float64) error {
 	return t.accountingDB.SaveAtRestRaw(ctx, latestTally, created, nodeData)
@@ -17,6 +17,7 @@ import (
 	"storj.io/storj/pkg/pointerdb"
 	"storj.io/storj/pkg/storage/meta"
 	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storage"
 )
 
 // Stripe keeps track of a stripe's index and its parent segment
@@ -27,9 +28,13 @@ type Stripe struct {
 }
 
 // Cursor keeps track of audit location in pointer db
+//
+// Paths sampled by the metainfo loop are audited first, when there are none
+// the cursor falls back to listing pointer db.
 type Cursor struct {
 	pointerdb *pointerdb.Service
 	lastPath  storj.Path
+	queue     []storj.Path
 	mutex     sync.Mutex
 }
 
@@ -40,11 +45,33 @@ func NewCursor(pointerdb *pointerdb.Service) *Cursor {
 	}
 }
 
+// Enqueue adds sampled segment paths to be audited
+func (cursor *Cursor) Enqueue(paths ...storj.Path) {
+	cursor.mutex.Lock()
+	defer cursor.mutex.Unlock()
+
+	cursor.queue = append(cursor.queue, paths...)
+}
+
+// Queued returns the number of sampled segment paths waiting to be audited
+func (cursor *Cursor) Queued() int {
+	cursor.mutex.Lock()
+	defer cursor.mutex.Unlock()
+
+	return len(cursor.queue)
+}
+
 // NextStripe returns a random stripe to be audited
 func (cursor *Cursor) NextStripe(ctx context.Context) (stripe *Stripe, err error) {
 	cursor.mutex.Lock()
 	defer cursor.mutex.Unlock()
 
+	if len(cursor.queue) > 0 {
+		path := cursor.queue[0]
+		cursor.queue = cursor.queue[1:]
+		return cursor.stripeAt(path)
+	}
+
 	var pointerItems []*pb.ListResponse_Item
 	var path storj.Path
 	var more bool
@@ -72,9 +99,18 @@ func (cursor *Cursor) NextStripe(ctx context.Context) (stripe *Stripe, err error
 		cursor.lastPath = pointerItems[len(pointerItems)-1].Path
 	}
 
+	return cursor.stripeAt(path)
+}
+
+// stripeAt returns a random stripe of the segment at path
+func (cursor *Cursor) stripeAt(path storj.Path) (stripe *Stripe, err error) {
 	// get pointer info
 	pointer, err := cursor.pointerdb.Get(path)
 	if err != nil {
+		if storage.ErrKeyNotFound.Has(err) {
+			// the segment was deleted after it was listed or sampled
+			return nil, nil
+		}
 		return nil, err
 	}
 
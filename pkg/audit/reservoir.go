@@ -0,0 +1,60 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package audit
+
+import (
+	"context"
+	"math/rand"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+)
+
+// Reservoir holds a uniformly random sample of remote segment paths seen during a metainfo loop cycle
+type Reservoir struct {
+	Paths []storj.Path
+	size  int
+	seen  int64
+}
+
+// NewReservoir creates a reservoir which keeps at most size paths
+func NewReservoir(size int) *Reservoir {
+	return &Reservoir{
+		Paths: make([]storj.Path, 0, size),
+		size:  size,
+	}
+}
+
+// Sample adds the path to the reservoir with probability size/seen
+func (reservoir *Reservoir) Sample(path storj.Path) {
+	reservoir.seen++
+	if len(reservoir.Paths) < reservoir.size {
+		reservoir.Paths = append(reservoir.Paths, path)
+		return
+	}
+
+	index := rand.Int63n(reservoir.seen)
+	if index < int64(reservoir.size) {
+		reservoir.Paths[index] = path
+	}
+}
+
+// RemoteSegment samples remote segments which have data to audit
+func (reservoir *Reservoir) RemoteSegment(ctx context.Context, path storj.Path, pointer *pb.Pointer) (err error) {
+	if pointer.GetSegmentSize() == 0 {
+		return nil
+	}
+	reservoir.Sample(path)
+	return nil
+}
+
+// RemoteObject is called for the last segment of every remote object, it's already sampled by RemoteSegment
+func (reservoir *Reservoir) RemoteObject(ctx context.Context, path storj.Path, pointer *pb.Pointer) (err error) {
+	return nil
+}
+
+// InlineSegment is called for every inline segment, inline segments are not audited
+func (reservoir *Reservoir) InlineSegment(ctx context.Context, path storj.Path, pointer *pb.Pointer) (err error) {
+	return nil
+}
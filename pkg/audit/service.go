@@ -15,6 +15,7 @@ import (
 	"storj.io/storj/pkg/overlay"
 	"storj.io/storj/pkg/pointerdb"
 	"storj.io/storj/pkg/transport"
+	"storj.io/storj/satellite/metainfo"
 	"storj.io/storj/satellite/orders"
 )
 
@@ -23,11 +24,14 @@ type Config struct {
 	MaxRetriesStatDB  int           `help:"max number of times to attempt updating a statdb batch" default:"3"`
 	Interval          time.Duration `help:"how frequently segments are audited" default:"30s"`
 	MinBytesPerSecond memory.Size   `help:"the minimum acceptable bytes that storage nodes can transfer per second to the satellite" default:"128B"`
+	ReservoirSize     int           `help:"number of segments sampled for auditing during each metainfo loop cycle" default:"100"`
 }
 
 // Service helps coordinate Cursor and Verifier to run the audit process continuously
 type Service struct {
-	log *zap.Logger
+	log           *zap.Logger
+	metaLoop      *metainfo.Loop
+	reservoirSize int
 
 	Cursor   *Cursor
 	Verifier *Verifier
@@ -37,11 +41,13 @@ type Service struct {
 }
 
 // NewService instantiates a Service with access to a Cursor and Verifier
-func NewService(log *zap.Logger, config Config, pointerdb *pointerdb.Service,
+func NewService(log *zap.Logger, config Config, pointerdb *pointerdb.Service, metaLoop *metainfo.Loop,
 	orders *orders.Service, transport transport.Client, overlay *overlay.Cache,
 	identity *identity.FullIdentity) (service *Service, err error) {
 	return &Service{
-		log: log,
+		log:           log,
+		metaLoop:      metaLoop,
+		reservoirSize: config.ReservoirSize,
 
 		Cursor:   NewCursor(pointerdb),
 		Verifier: NewVerifier(log.Named("audit:verifier"), transport, overlay, orders, identity, config.MinBytesPerSecond),
@@ -73,6 +79,13 @@ func (service *Service) Close() error {
 
 // process picks a random stripe and verifies correctness
 func (service *Service) process(ctx context.Context) error {
+	if service.Cursor.Queued() == 0 && service.reservoirSize > 0 {
+		err := service.sample(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
 	stripe, err := service.Cursor.NextStripe(ctx)
 	if err != nil {
 		return err
@@ -94,3 +107,17 @@ func (service *Service) process(ctx context.Context) error {
 
 	return nil
 }
+
+// sample joins the metainfo loop and queues a random sample of segments for auditing
+func (service *Service) sample(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	reservoir := NewReservoir(service.reservoirSize)
+	err = service.metaLoop.Join(ctx, reservoir)
+	if err != nil {
+		return err
+	}
+
+	service.Cursor.Enqueue(reservoir.Paths...)
+	return nil
+}
@@ -0,0 +1,184 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bloomfilter
+
+import (
+	"encoding/binary"
+	"math"
+	"math/rand"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/storj"
+)
+
+const (
+	version1 = 1
+
+	// headerSize is the size of version, seed and hash count
+	headerSize = 3
+)
+
+// rangeOffsets contains offsets for selecting subranges
+// that minimize overlap in the first hash functions
+var rangeOffsets = [...]byte{9, 13, 19, 23}
+
+// Error is the default error class for bloom filters
+var Error = errs.Class("bloom filter error")
+
+// Filter is a bloom filter implementation for piece IDs
+//
+// Piece IDs are already uniformly random, so their bytes are
+// used directly as hashes instead of hashing them again.
+type Filter struct {
+	seed      byte
+	hashCount byte
+	table     []byte
+}
+
+// NewOptimal returns a filter based on expected element count and false positive rate.
+func NewOptimal(expectedElements int, falsePositiveRate float64) *Filter {
+	hashCount, tableSize := getHashCountAndSize(expectedElements, falsePositiveRate)
+	seed := byte(rand.Intn(255))
+
+	return newExplicit(seed, byte(hashCount), tableSize)
+}
+
+// newExplicit returns a new custom filter.
+func newExplicit(seed, hashCount byte, sizeInBytes int) *Filter {
+	return &Filter{
+		seed:      seed,
+		hashCount: hashCount,
+		table:     make([]byte, sizeInBytes),
+	}
+}
+
+// getHashCountAndSize returns the number of hash functions
+// and the table size in bytes for the expected element count and false positive rate.
+func getHashCountAndSize(expectedElements int, falsePositiveRate float64) (hashCount, size int) {
+	if expectedElements < 1 {
+		expectedElements = 1
+	}
+	if falsePositiveRate <= 0 || falsePositiveRate >= 1 {
+		falsePositiveRate = 0.1
+	}
+
+	bitsPerElement := -1.44 * math.Log2(falsePositiveRate)
+	hashCount = int(math.Ceil(bitsPerElement * math.Ln2))
+	if hashCount > 32 {
+		// it will never be larger, but just in case to avoid overflow
+		hashCount = 32
+	}
+	if hashCount < 1 {
+		hashCount = 1
+	}
+
+	size = int(math.Ceil(float64(expectedElements) * bitsPerElement / 8))
+	if size < 1 {
+		size = 1
+	}
+	return hashCount, size
+}
+
+// Parameters returns the seed and hash count of the filter.
+func (filter *Filter) Parameters() (seed, hashCount byte) {
+	return filter.seed, filter.hashCount
+}
+
+// Size returns the size of the table in bytes.
+func (filter *Filter) Size() int {
+	return len(filter.table)
+}
+
+// Add adds a piece ID to the filter.
+func (filter *Filter) Add(pieceID storj.PieceID) {
+	offset, rangeOffset := initialConditions(filter.seed)
+
+	for k := byte(0); k < filter.hashCount; k++ {
+		hash, bit := subrange(offset, pieceID)
+
+		offset += rangeOffset
+		if offset >= len(storj.PieceID{}) {
+			offset -= len(storj.PieceID{})
+		}
+
+		bucket := hash % uint64(len(filter.table))
+		filter.table[bucket] |= 1 << (bit % 8)
+	}
+}
+
+// Contains returns true if pieceID may be in the filter.
+func (filter *Filter) Contains(pieceID storj.PieceID) bool {
+	offset, rangeOffset := initialConditions(filter.seed)
+
+	for k := byte(0); k < filter.hashCount; k++ {
+		hash, bit := subrange(offset, pieceID)
+
+		offset += rangeOffset
+		if offset >= len(storj.PieceID{}) {
+			offset -= len(storj.PieceID{})
+		}
+
+		bucket := hash % uint64(len(filter.table))
+		if filter.table[bucket]&(1<<(bit%8)) == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// initialConditions returns the first byte offset and the step between offsets for the seed.
+func initialConditions(seed byte) (initialOffset, rangeOffset int) {
+	initialOffset = int(seed % 32)
+	rangeOffset = int(rangeOffsets[int(seed/32)%len(rangeOffsets)])
+	return initialOffset, rangeOffset
+}
+
+// subrange reads 9 bytes starting at seed from pieceID, wrapping around the end.
+// The first 8 bytes are used as the hash and the last one to select the bit.
+func subrange(seed int, id storj.PieceID) (uint64, byte) {
+	if seed > len(id)-9 {
+		var unwrap [9]byte
+		n := copy(unwrap[:], id[seed:])
+		copy(unwrap[n:], id[:])
+		return binary.LittleEndian.Uint64(unwrap[:]), unwrap[8]
+	}
+	return binary.LittleEndian.Uint64(id[seed : seed+8]), id[seed+8]
+}
+
+// NewFromBytes decodes the filter from a sequence of bytes.
+//
+// Note: data will be referenced inside the table.
+func NewFromBytes(data []byte) (*Filter, error) {
+	if len(data) < headerSize+1 {
+		return nil, Error.New("not enough data")
+	}
+	if data[0] != version1 {
+		return nil, Error.New("unsupported version %d", data[0])
+	}
+
+	filter := &Filter{}
+	filter.seed = data[1]
+	filter.hashCount = data[2]
+	filter.table = data[headerSize:]
+
+	if filter.hashCount == 0 {
+		return nil, Error.New("invalid hash count %d", filter.hashCount)
+	}
+
+	return filter, nil
+}
+
+// Bytes returns the serialized filter.
+func (filter *Filter) Bytes() []byte {
+	bytes := make([]byte, headerSize+len(filter.table))
+
+	bytes[0] = version1
+	bytes[1] = filter.seed
+	bytes[2] = filter.hashCount
+	copy(bytes[headerSize:], filter.table)
+
+	return bytes
+}
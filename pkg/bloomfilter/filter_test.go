@@ -0,0 +1,90 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bloomfilter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/pkg/bloomfilter"
+	"storj.io/storj/pkg/storj"
+)
+
+func TestNoFalseNegatives(t *testing.T) {
+	pieceIDs := generateTestIDs(10000)
+
+	for _, ratio := range []float32{0.5, 1, 2} {
+		size := int(float32(len(pieceIDs)) * ratio)
+		filter := bloomfilter.NewOptimal(size, 0.1)
+
+		for _, pieceID := range pieceIDs {
+			filter.Add(pieceID)
+		}
+
+		for _, pieceID := range pieceIDs {
+			require.True(t, filter.Contains(pieceID))
+		}
+	}
+}
+
+func TestFalsePositiveRate(t *testing.T) {
+	const expectedElements = 10000
+	const falsePositiveRate = 0.1
+
+	filter := bloomfilter.NewOptimal(expectedElements, falsePositiveRate)
+	for _, pieceID := range generateTestIDs(expectedElements) {
+		filter.Add(pieceID)
+	}
+
+	const checks = 10000
+	var falsePositives int
+	for _, pieceID := range generateTestIDs(checks) {
+		if filter.Contains(pieceID) {
+			falsePositives++
+		}
+	}
+
+	rate := float64(falsePositives) / checks
+	assert.InDelta(t, falsePositiveRate, rate, 0.05)
+}
+
+func TestBytes(t *testing.T) {
+	pieceIDs := generateTestIDs(1000)
+
+	filter := bloomfilter.NewOptimal(len(pieceIDs), 0.1)
+	for _, pieceID := range pieceIDs[:len(pieceIDs)/2] {
+		filter.Add(pieceID)
+	}
+
+	decoded, err := bloomfilter.NewFromBytes(filter.Bytes())
+	require.NoError(t, err)
+
+	seed, hashCount := filter.Parameters()
+	decodedSeed, decodedHashCount := decoded.Parameters()
+	require.Equal(t, seed, decodedSeed)
+	require.Equal(t, hashCount, decodedHashCount)
+	require.Equal(t, filter.Size(), decoded.Size())
+
+	for _, pieceID := range pieceIDs {
+		require.Equal(t, filter.Contains(pieceID), decoded.Contains(pieceID))
+	}
+
+	_, err = bloomfilter.NewFromBytes(nil)
+	require.Error(t, err)
+
+	invalidVersion := filter.Bytes()
+	invalidVersion[0] = 2
+	_, err = bloomfilter.NewFromBytes(invalidVersion)
+	require.Error(t, err)
+}
+
+func generateTestIDs(n int) []storj.PieceID {
+	ids := make([]storj.PieceID, n)
+	for i := range ids {
+		ids[i] = storj.NewPieceID()
+	}
+	return ids
+}
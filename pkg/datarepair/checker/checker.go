@@ -7,7 +7,6 @@ import (
 	"context"
 	"time"
 
-	"github.com/gogo/protobuf/proto"
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 	monkit "gopkg.in/spacemonkeygo/monkit.v2"
@@ -17,9 +16,8 @@ import (
 	"storj.io/storj/pkg/datarepair/queue"
 	"storj.io/storj/pkg/overlay"
 	"storj.io/storj/pkg/pb"
-	"storj.io/storj/pkg/pointerdb"
 	"storj.io/storj/pkg/storj"
-	"storj.io/storj/storage"
+	"storj.io/storj/satellite/metainfo"
 )
 
 // Error is a standard error class for this package.
@@ -35,7 +33,7 @@ type Config struct {
 
 // Checker contains the information needed to do checks for missing pieces
 type Checker struct {
-	pointerdb   *pointerdb.Service
+	metaLoop    *metainfo.Loop
 	repairQueue queue.RepairQueue
 	overlay     *overlay.Cache
 	irrdb       irreparable.DB
@@ -44,10 +42,10 @@ type Checker struct {
 }
 
 // NewChecker creates a new instance of checker
-func NewChecker(pointerdb *pointerdb.Service, repairQueue queue.RepairQueue, overlay *overlay.Cache, irrdb irreparable.DB, limit int, logger *zap.Logger, interval time.Duration) *Checker {
+func NewChecker(metaLoop *metainfo.Loop, repairQueue queue.RepairQueue, overlay *overlay.Cache, irrdb irreparable.DB, limit int, logger *zap.Logger, interval time.Duration) *Checker {
 	// TODO: reorder arguments
 	checker := &Checker{
-		pointerdb:   pointerdb,
+		metaLoop:    metaLoop,
 		repairQueue: repairQueue,
 		overlay:     overlay,
 		irrdb:       irrdb,
@@ -76,126 +74,143 @@ func (checker *Checker) Close() error {
 	return nil
 }
 
-// IdentifyInjuredSegments checks for missing pieces off of the pointerdb and overlay cache
+// IdentifyInjuredSegments checks for missing pieces off of the metainfo loop and overlay cache
 func (checker *Checker) IdentifyInjuredSegments(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var remoteSegmentsChecked int64
-	var remoteSegmentsNeedingRepair int64
-	var remoteSegmentsLost int64
-	var remoteSegmentInfo []string
-
-	err = checker.pointerdb.Iterate("", "", true, false,
-		func(it storage.Iterator) error {
-			var item storage.ListItem
-			for it.Next(&item) {
-				pointer := &pb.Pointer{}
-
-				err = proto.Unmarshal(item.Value, pointer)
-				if err != nil {
-					return Error.New("error unmarshalling pointer %s", err)
-				}
-
-				remote := pointer.GetRemote()
-				if remote == nil {
-					continue
-				}
-
-				pieces := remote.GetRemotePieces()
-				if pieces == nil {
-					checker.logger.Debug("no pieces on remote segment")
-					continue
-				}
-
-				var nodeIDs storj.NodeIDList
-				for _, p := range pieces {
-					nodeIDs = append(nodeIDs, p.NodeId)
-				}
-
-				// Find all offline nodes
-				offlineNodes, err := checker.overlay.OfflineNodes(ctx, nodeIDs)
-				if err != nil {
-					return Error.New("error getting offline nodes %s", err)
-				}
-
-				invalidNodes, err := checker.invalidNodes(ctx, nodeIDs)
-				if err != nil {
-					return Error.New("error getting invalid nodes %s", err)
-				}
-
-				missingIndices := combineOfflineWithInvalid(offlineNodes, invalidNodes)
-				var missingPieces []int32
-				for _, i := range missingIndices {
-					missingPieces = append(missingPieces, pieces[i].GetPieceNum())
-				}
-
-				remoteSegmentsChecked++
-				numHealthy := len(nodeIDs) - len(missingPieces)
-				if (int32(numHealthy) >= pointer.Remote.Redundancy.MinReq) && (int32(numHealthy) <= pointer.Remote.Redundancy.RepairThreshold) {
-					remoteSegmentsNeedingRepair++
-					err = checker.repairQueue.Insert(ctx, &pb.InjuredSegment{
-						Path:       string(item.Key),
-						LostPieces: missingPieces,
-					})
-					if err != nil {
-						return Error.New("error adding injured segment to queue %s", err)
-					}
-				} else if int32(numHealthy) < pointer.Remote.Redundancy.MinReq {
-					pathElements := storj.SplitPath(storj.Path(item.Key))
-					// check to make sure there are at least *4* path elements. the first three
-					// are project, segment, and bucket name, but we want to make sure we're talking
-					// about an actual object, and that there's an object name specified
-					if len(pathElements) >= 4 {
-						project, bucketName, segmentpath := pathElements[0], pathElements[2], pathElements[3]
-						lostSegInfo := storj.JoinPaths(project, bucketName, segmentpath)
-						if contains(remoteSegmentInfo, lostSegInfo) == false {
-							remoteSegmentInfo = append(remoteSegmentInfo, lostSegInfo)
-						}
-					}
-
-					// TODO: irreparable segment should be using storj.NodeID or something, since at the point of repair
-					//       it may have been already repaired once.
-
-					remoteSegmentsLost++
-					// make an entry in to the irreparable table
-					segmentInfo := &pb.IrreparableSegment{
-						Path:               item.Key,
-						SegmentDetail:      pointer,
-						LostPieces:         int32(len(missingPieces)),
-						LastRepairAttempt:  time.Now().Unix(),
-						RepairAttemptCount: int64(1),
-					}
-
-					//add the entry if new or update attempt count if already exists
-					err := checker.irrdb.IncrementRepairAttempts(ctx, segmentInfo)
-					if err != nil {
-						return Error.New("error handling irreparable segment to queue %s", err)
-					}
-				}
-			}
-			return nil
-		},
-	)
+	observer := &checkerObserver{
+		checker: checker,
+		log:     checker.logger,
+	}
+	err = checker.metaLoop.Join(ctx, observer)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	mon.IntVal("remote_segments_checked").Observe(observer.remoteSegmentsChecked)
+	mon.IntVal("remote_segments_needing_repair").Observe(observer.remoteSegmentsNeedingRepair)
+	mon.IntVal("remote_segments_lost").Observe(observer.remoteSegmentsLost)
+	mon.IntVal("remote_files_lost").Observe(int64(len(observer.remoteSegmentInfo)))
+
+	return nil
+}
+
+// checkerObserver implements the metainfo loop observer interface for the checker
+type checkerObserver struct {
+	checker *Checker
+	log     *zap.Logger
+
+	remoteSegmentsChecked       int64
+	remoteSegmentsNeedingRepair int64
+	remoteSegmentsLost          int64
+	remoteSegmentInfo           []string
+}
+
+// RemoteSegment checks the health of a single remote segment
+func (observer *checkerObserver) RemoteSegment(ctx context.Context, path storj.Path, pointer *pb.Pointer) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	checker := observer.checker
+
+	pieces := pointer.GetRemote().GetRemotePieces()
+	if pieces == nil {
+		observer.log.Debug("no pieces on remote segment")
+		return nil
+	}
+
+	var nodeIDs storj.NodeIDList
+	for _, p := range pieces {
+		nodeIDs = append(nodeIDs, p.NodeId)
+	}
+
+	// Find all offline nodes
+	offlineNodes, err := checker.overlay.OfflineNodes(ctx, nodeIDs)
+	if err != nil {
+		return Error.New("error getting offline nodes %s", err)
+	}
+
+	invalidNodes, err := checker.invalidNodes(ctx, nodeIDs)
+	if err != nil {
+		return Error.New("error getting invalid nodes %s", err)
+	}
+
+	// pieces sharing a network with another piece of the segment aren't counted as healthy
+	clumpedNodes, err := checker.overlay.ClumpedNodes(ctx, nodeIDs)
 	if err != nil {
-		return err
+		return Error.New("error getting clumped nodes %s", err)
+	}
+
+	missingIndices := combineMissing(offlineNodes, invalidNodes, clumpedNodes)
+	var missingPieces []int32
+	for _, i := range missingIndices {
+		missingPieces = append(missingPieces, pieces[i].GetPieceNum())
+	}
+
+	observer.remoteSegmentsChecked++
+	numHealthy := len(nodeIDs) - len(missingPieces)
+	if (int32(numHealthy) >= pointer.Remote.Redundancy.MinReq) && (int32(numHealthy) <= pointer.Remote.Redundancy.RepairThreshold) {
+		observer.remoteSegmentsNeedingRepair++
+		err = checker.repairQueue.Insert(ctx, &pb.InjuredSegment{
+			Path:       path,
+			LostPieces: missingPieces,
+		})
+		if err != nil {
+			return Error.New("error adding injured segment to queue %s", err)
+		}
+	} else if int32(numHealthy) < pointer.Remote.Redundancy.MinReq {
+		pathElements := storj.SplitPath(path)
+		// check to make sure there are at least *4* path elements. the first three
+		// are project, segment, and bucket name, but we want to make sure we're talking
+		// about an actual object, and that there's an object name specified
+		if len(pathElements) >= 4 {
+			project, bucketName, segmentpath := pathElements[0], pathElements[2], pathElements[3]
+			lostSegInfo := storj.JoinPaths(project, bucketName, segmentpath)
+			if contains(observer.remoteSegmentInfo, lostSegInfo) == false {
+				observer.remoteSegmentInfo = append(observer.remoteSegmentInfo, lostSegInfo)
+			}
+		}
+
+		// TODO: irreparable segment should be using storj.NodeID or something, since at the point of repair
+		//       it may have been already repaired once.
+
+		observer.remoteSegmentsLost++
+		// make an entry in to the irreparable table
+		segmentInfo := &pb.IrreparableSegment{
+			Path:               []byte(path),
+			SegmentDetail:      pointer,
+			LostPieces:         int32(len(missingPieces)),
+			LastRepairAttempt:  time.Now().Unix(),
+			RepairAttemptCount: int64(1),
+		}
+
+		//add the entry if new or update attempt count if already exists
+		err := checker.irrdb.IncrementRepairAttempts(ctx, segmentInfo)
+		if err != nil {
+			return Error.New("error handling irreparable segment to queue %s", err)
+		}
 	}
-	mon.IntVal("remote_segments_checked").Observe(remoteSegmentsChecked)
-	mon.IntVal("remote_segments_needing_repair").Observe(remoteSegmentsNeedingRepair)
-	mon.IntVal("remote_segments_lost").Observe(remoteSegmentsLost)
-	mon.IntVal("remote_files_lost").Observe(int64(len(remoteSegmentInfo)))
+	return nil
+}
+
+// RemoteObject is called for the last segment of every remote object, the checker doesn't need it
+func (observer *checkerObserver) RemoteObject(ctx context.Context, path storj.Path, pointer *pb.Pointer) (err error) {
+	return nil
+}
 
+// InlineSegment is called for every inline segment, inline segments don't need repair
+func (observer *checkerObserver) InlineSegment(ctx context.Context, path storj.Path, pointer *pb.Pointer) (err error) {
 	return nil
 }
 
-// Find invalidNodes by checking the audit results that are place in overlay
+// Find invalidNodes by checking the audit results that are place in overlay, disqualified nodes are always invalid
 func (checker *Checker) invalidNodes(ctx context.Context, nodeIDs storj.NodeIDList) (invalidNodes []int, err error) {
 	// filter if nodeIDs have invalid pieces from auditing results
-	maxStats := &overlay.NodeStats{
-		AuditSuccessRatio: 0, // TODO: update when we have stats added to overlay
-		UptimeRatio:       0, // TODO: update when we have stats added to overlay
+	criteria := &overlay.NodeCriteria{
+		MinimumAuditReputation:  0, // TODO: update when we have stats added to overlay
+		MinimumUptimeReputation: 0, // TODO: update when we have stats added to overlay
 	}
 
-	invalidIDs, err := checker.overlay.FindInvalidNodes(ctx, nodeIDs, maxStats)
+	invalidIDs, err := checker.overlay.FindInvalidNodes(ctx, nodeIDs, criteria)
 	if err != nil {
 		return nil, Error.New("error getting valid nodes from overlay %s", err)
 	}
@@ -214,19 +229,15 @@ func (checker *Checker) invalidNodes(ctx context.Context, nodeIDs storj.NodeIDLi
 	return invalidNodes, nil
 }
 
-// combine the offline nodes with nodes marked invalid by overlay
-func combineOfflineWithInvalid(offlineNodes []int, invalidNodes []int) (missingPieces []int32) {
-	for _, offline := range offlineNodes {
-		missingPieces = append(missingPieces, int32(offline))
-	}
-
-	offlineMap := make(map[int]bool)
-	for _, i := range offlineNodes {
-		offlineMap[i] = true
-	}
-	for _, i := range invalidNodes {
-		if !offlineMap[i] {
-			missingPieces = append(missingPieces, int32(i))
+// combine the offline nodes with nodes marked invalid or clumped by overlay
+func combineMissing(offlineNodes, invalidNodes, clumpedNodes []int) (missingPieces []int32) {
+	missingMap := make(map[int]bool)
+	for _, nodes := range [][]int{offlineNodes, invalidNodes, clumpedNodes} {
+		for _, i := range nodes {
+			if !missingMap[i] {
+				missingMap[i] = true
+				missingPieces = append(missingPieces, int32(i))
+			}
 		}
 	}
 
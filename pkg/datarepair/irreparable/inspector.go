@@ -11,12 +11,13 @@ import (
 
 // Inspector is a gRPC service for inspecting irreparable internals
 type Inspector struct {
-	irrdb DB
+	irrdb   DB
+	service *Service
 }
 
 // NewInspector creates an Inspector
-func NewInspector(irrdb DB) *Inspector {
-	return &Inspector{irrdb: irrdb}
+func NewInspector(irrdb DB, service *Service) *Inspector {
+	return &Inspector{irrdb: irrdb, service: service}
 }
 
 // ListIrreparableSegments returns a number of irreparable segments by limit and offset
@@ -28,3 +29,13 @@ func (srv *Inspector) ListIrreparableSegments(ctx context.Context, req *pb.ListI
 
 	return &pb.ListIrreparableSegmentsResponse{Segments: segments}, err
 }
+
+// MarkSegmentLost marks an irreparable segment as permanently lost and notifies the owning project
+func (srv *Inspector) MarkSegmentLost(ctx context.Context, req *pb.MarkSegmentLostRequest) (*pb.MarkSegmentLostResponse, error) {
+	notified, err := srv.service.MarkLost(ctx, req.GetPath())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.MarkSegmentLostResponse{NotifiedMembers: int32(notified)}, nil
+}
@@ -0,0 +1,377 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package irreparable
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/internal/post"
+	"storj.io/storj/internal/sync2"
+	"storj.io/storj/pkg/datarepair/queue"
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/pointerdb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/mailservice"
+	"storj.io/storj/storage"
+)
+
+var (
+	// Error is a standard error class for this package.
+	Error = errs.Class("irreparable error")
+	// ErrPartiallyLost is returned by MarkLost when the segment has been deleted,
+	// but the remaining steps failed and MarkLost needs to be retried.
+	ErrPartiallyLost = errs.Class("segment partially marked lost")
+	mon              = monkit.Package()
+)
+
+// Config contains configurable values for the irreparable segment re-checker
+type Config struct {
+	Interval   time.Duration `help:"how frequently irreparable segments are re-checked after nodes come back online" default:"1h"`
+	BatchSize  int           `help:"number of irreparable segments fetched from the database at once" default:"100"`
+	RecheckAge time.Duration `help:"how long after the last repair attempt irreparable segments are re-checked even when none of their nodes came back online, 0 disables it" default:"24h"`
+}
+
+// Service re-evaluates irreparable segments when previously offline nodes return
+// and moves the segments that became repairable back to the repair queue.
+// The returned nodes are only kept in memory, so segments which haven't been
+// attempted within the recheck age are re-evaluated regardless.
+type Service struct {
+	log         *zap.Logger
+	irrdb       DB
+	pointerdb   *pointerdb.Service
+	overlay     *overlay.Cache
+	repairQueue queue.RepairQueue
+	console     console.DB
+	mail        *mailservice.Service
+	batchSize   int
+	recheckAge  time.Duration
+
+	mu       sync.Mutex
+	returned map[storj.NodeID]struct{}
+
+	Loop sync2.Cycle
+}
+
+// NewService creates a new irreparable segment re-checker
+func NewService(log *zap.Logger, config Config, irrdb DB, pointerdb *pointerdb.Service, overlay *overlay.Cache, repairQueue queue.RepairQueue, console console.DB, mail *mailservice.Service) *Service {
+	batchSize := config.BatchSize
+	if batchSize <= 0 {
+		batchSize = 100
+	}
+
+	return &Service{
+		log:         log,
+		irrdb:       irrdb,
+		pointerdb:   pointerdb,
+		overlay:     overlay,
+		repairQueue: repairQueue,
+		console:     console,
+		mail:        mail,
+		batchSize:   batchSize,
+		recheckAge:  config.RecheckAge,
+		returned:    make(map[storj.NodeID]struct{}),
+		Loop:        *sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run runs the re-check loop
+func (service *Service) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return service.Loop.Run(ctx, func(ctx context.Context) error {
+		err := service.Recheck(ctx)
+		if err != nil {
+			service.log.Error("error re-checking irreparable segments", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Close halts the re-check loop
+func (service *Service) Close() error {
+	service.Loop.Close()
+	return nil
+}
+
+// NodeReturned implements overlay.ReturnObserver.
+//
+// The node is remembered and segments with pieces on it are re-evaluated during the next cycle.
+func (service *Service) NodeReturned(ctx context.Context, nodeID storj.NodeID) {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	service.returned[nodeID] = struct{}{}
+}
+
+// takeReturned returns nodes that have come back online since the last call.
+func (service *Service) takeReturned() map[storj.NodeID]struct{} {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	returned := service.returned
+	service.returned = make(map[storj.NodeID]struct{})
+	return returned
+}
+
+// Recheck re-evaluates irreparable segments that have pieces on nodes which came back online
+// and segments whose last repair attempt is older than the recheck age.
+func (service *Service) Recheck(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	returned := service.takeReturned()
+	if len(returned) == 0 && service.recheckAge <= 0 {
+		return nil
+	}
+	now := time.Now()
+	staleBefore := now.Add(-service.recheckAge).Unix()
+
+	var recovered, deleted int64
+	var offset int64
+	for {
+		segments, err := service.irrdb.GetLimited(ctx, service.batchSize, offset)
+		if err != nil {
+			// retry the nodes during the next cycle
+			service.restoreReturned(returned)
+			return Error.Wrap(err)
+		}
+
+		for _, segment := range segments {
+			stale := service.recheckAge > 0 && segment.LastRepairAttempt < staleBefore
+			if !stale && !hasPieceOn(segment.SegmentDetail, returned) {
+				offset++
+				continue
+			}
+
+			result, err := service.recheckSegment(ctx, segment.Path)
+			if err != nil {
+				service.log.Error("unable to re-check irreparable segment", zap.Binary("Segment", segment.Path), zap.Error(err))
+				offset++
+				continue
+			}
+
+			switch result {
+			case segmentRecovered:
+				recovered++
+			case segmentDeleted:
+				deleted++
+			default:
+				offset++
+				if stale {
+					// count the re-check as a repair attempt, so the segment isn't re-checked every cycle
+					segment.LastRepairAttempt = now.Unix()
+					if err := service.irrdb.IncrementRepairAttempts(ctx, segment); err != nil {
+						service.log.Error("unable to update irreparable segment", zap.Binary("Segment", segment.Path), zap.Error(err))
+					}
+				}
+			}
+		}
+
+		if len(segments) < service.batchSize {
+			break
+		}
+	}
+
+	mon.IntVal("irreparable_segments_recovered").Observe(recovered)
+	mon.IntVal("irreparable_segments_deleted").Observe(deleted)
+	return nil
+}
+
+// restoreReturned puts back the nodes that could not be processed.
+func (service *Service) restoreReturned(returned map[storj.NodeID]struct{}) {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	for nodeID := range returned {
+		service.returned[nodeID] = struct{}{}
+	}
+}
+
+type recheckResult int
+
+const (
+	segmentStillIrreparable recheckResult = iota
+	segmentRecovered
+	segmentDeleted
+)
+
+// recheckSegment checks the current state of a single irreparable segment.
+func (service *Service) recheckSegment(ctx context.Context, path []byte) (_ recheckResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	pointer, err := service.pointerdb.Get(string(path))
+	if err != nil {
+		if storage.ErrKeyNotFound.Has(err) {
+			// the segment has been deleted in the meantime
+			return segmentDeleted, Error.Wrap(service.irrdb.Delete(ctx, path))
+		}
+		return segmentStillIrreparable, Error.Wrap(err)
+	}
+
+	remote := pointer.GetRemote()
+	if remote == nil || len(remote.GetRemotePieces()) == 0 {
+		return segmentDeleted, Error.Wrap(service.irrdb.Delete(ctx, path))
+	}
+
+	pieces := remote.GetRemotePieces()
+	var nodeIDs storj.NodeIDList
+	for _, piece := range pieces {
+		nodeIDs = append(nodeIDs, piece.NodeId)
+	}
+
+	offline, err := service.overlay.OfflineNodes(ctx, nodeIDs)
+	if err != nil {
+		return segmentStillIrreparable, Error.Wrap(err)
+	}
+
+	numHealthy := int32(len(pieces) - len(offline))
+	if numHealthy < remote.Redundancy.MinReq {
+		return segmentStillIrreparable, nil
+	}
+
+	if numHealthy <= remote.Redundancy.RepairThreshold {
+		var lostPieces []int32
+		for _, i := range offline {
+			lostPieces = append(lostPieces, pieces[i].GetPieceNum())
+		}
+
+		err = service.repairQueue.Insert(ctx, &pb.InjuredSegment{
+			Path:       string(path),
+			LostPieces: lostPieces,
+		})
+		if err != nil {
+			return segmentStillIrreparable, Error.Wrap(err)
+		}
+	}
+
+	return segmentRecovered, Error.Wrap(service.irrdb.Delete(ctx, path))
+}
+
+// MarkLost marks the irreparable segment as permanently lost.
+//
+// The segment is removed from pointerdb, the members of the owning project are
+// notified by email and only then is the segment removed from the irreparable
+// database. When notifying fails, the segment stays in the irreparable database,
+// so MarkLost can be called again to finish the job. The returned error then
+// reports that the segment data has already been deleted.
+func (service *Service) MarkLost(ctx context.Context, path []byte) (notified int, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if _, err := service.irrdb.Get(ctx, path); err != nil {
+		return 0, Error.Wrap(err)
+	}
+
+	err = service.pointerdb.Delete(string(path))
+	if err != nil && !storage.ErrKeyNotFound.Has(err) {
+		return 0, Error.Wrap(err)
+	}
+	mon.Meter("irreparable_segments_marked_lost").Mark(1)
+
+	notified, err = service.notifyLost(ctx, storj.Path(path))
+	if err != nil {
+		return notified, ErrPartiallyLost.New("segment deleted, but notified only %d members: %v", notified, err)
+	}
+
+	err = service.irrdb.Delete(ctx, path)
+	if err != nil {
+		return notified, ErrPartiallyLost.New("segment deleted and %d members notified, but removing it from irreparable db failed: %v", notified, err)
+	}
+
+	return notified, nil
+}
+
+// notifyLost sends the lost segment email to all members of the project owning the segment.
+func (service *Service) notifyLost(ctx context.Context, path storj.Path) (notified int, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// path is in the format project-id/segment-index/bucket/encrypted-path
+	pathElements := storj.SplitPath(path)
+	if len(pathElements) < 4 {
+		return 0, Error.New("invalid segment path %q", path)
+	}
+
+	projectID, err := uuid.Parse(pathElements[0])
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+
+	project, err := service.console.Projects().Get(ctx, *projectID)
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+
+	members, err := service.console.ProjectMembers().GetByProjectID(ctx, *projectID, console.Pagination{
+		Limit:  1000,
+		Offset: 0,
+		Order:  console.Email,
+	})
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+
+	var errlist errs.Group
+	for _, member := range members {
+		user, err := service.console.Users().Get(ctx, member.MemberID)
+		if err != nil {
+			errlist.Add(err)
+			continue
+		}
+
+		userName := user.ShortName
+		if user.ShortName == "" {
+			userName = user.FullName
+		}
+
+		err = service.mail.SendRendered(
+			ctx,
+			[]post.Address{{Address: user.Email, Name: userName}},
+			&LostSegmentEmail{
+				UserName:    userName,
+				ProjectName: project.Name,
+				Bucket:      pathElements[2],
+				Path:        storj.JoinPaths(pathElements[3:]...),
+				Segment:     pathElements[1],
+			},
+		)
+		if err != nil {
+			errlist.Add(err)
+			continue
+		}
+		notified++
+	}
+
+	return notified, Error.Wrap(errlist.Err())
+}
+
+// hasPieceOn checks whether pointer has any piece on the specified nodes.
+func hasPieceOn(pointer *pb.Pointer, nodes map[storj.NodeID]struct{}) bool {
+	for _, piece := range pointer.GetRemote().GetRemotePieces() {
+		if _, ok := nodes[piece.NodeId]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// LostSegmentEmail is mailservice template for notifying project members about a lost segment
+type LostSegmentEmail struct {
+	UserName    string
+	ProjectName string
+	Bucket      string
+	Path        string
+	Segment     string
+}
+
+// Template returns email template name
+func (*LostSegmentEmail) Template() string { return "SegmentLost" }
+
+// Subject gets email subject
+func (email *LostSegmentEmail) Subject() string {
+	return "Data loss in the Project " + email.ProjectName
+}
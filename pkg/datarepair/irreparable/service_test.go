@@ -0,0 +1,257 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package irreparable_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/internal/teststorj"
+	"storj.io/storj/pkg/datarepair/irreparable"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/storage"
+)
+
+func TestRecheckReturnedNodes(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		service := satellite.Repair.Irreparable
+		service.Loop.Pause()
+
+		irrdb := satellite.DB.Irreparable()
+		pointerdb := satellite.Metainfo.Service
+
+		makeIrreparable := func(path string, minReq int32) {
+			var pieces []*pb.RemotePiece
+			// pieces on online nodes
+			for i, node := range planet.StorageNodes {
+				pieces = append(pieces, &pb.RemotePiece{PieceNum: int32(i), NodeId: node.ID()})
+			}
+			// pieces on unknown nodes
+			for i := len(pieces); i < 10; i++ {
+				pieces = append(pieces, &pb.RemotePiece{PieceNum: int32(i), NodeId: storj.NodeID{byte(i)}})
+			}
+
+			pointer := &pb.Pointer{
+				Remote: &pb.RemoteSegment{
+					Redundancy: &pb.RedundancyScheme{
+						MinReq:          minReq,
+						RepairThreshold: 8,
+					},
+					RootPieceId:  teststorj.PieceIDFromString(path),
+					RemotePieces: pieces,
+				},
+			}
+			require.NoError(t, pointerdb.Put(path, pointer))
+
+			err := irrdb.IncrementRepairAttempts(ctx, &pb.IrreparableSegment{
+				Path:               []byte(path),
+				SegmentDetail:      pointer,
+				LostPieces:         int32(10 - len(planet.StorageNodes)),
+				LastRepairAttempt:  time.Now().Unix(),
+				RepairAttemptCount: 1,
+			})
+			require.NoError(t, err)
+		}
+
+		makeIrreparable("repairable", 4)
+		makeIrreparable("lost", 6)
+
+		// nothing happens until a node returns
+		require.NoError(t, service.Recheck(ctx))
+		_, err := irrdb.Get(ctx, []byte("repairable"))
+		require.NoError(t, err)
+
+		// simulate node going offline and coming back
+		nodeID := planet.StorageNodes[0].ID()
+		_, err = satellite.Overlay.Service.UpdateUptime(ctx, nodeID, false)
+		require.NoError(t, err)
+		_, err = satellite.Overlay.Service.UpdateUptime(ctx, nodeID, true)
+		require.NoError(t, err)
+
+		require.NoError(t, service.Recheck(ctx))
+
+		// segment with enough healthy pieces is moved to the repair queue
+		_, err = irrdb.Get(ctx, []byte("repairable"))
+		require.Error(t, err)
+
+		injured, err := satellite.DB.RepairQueue().Select(ctx)
+		require.NoError(t, err)
+		require.Equal(t, "repairable", injured.Path)
+		require.Len(t, injured.LostPieces, 10-len(planet.StorageNodes))
+
+		// segment without enough healthy pieces stays irreparable
+		_, err = irrdb.Get(ctx, []byte("lost"))
+		require.NoError(t, err)
+
+		// segment deleted from pointerdb is removed from irreparable db
+		require.NoError(t, pointerdb.Delete("lost"))
+		service.NodeReturned(ctx, nodeID)
+		require.NoError(t, service.Recheck(ctx))
+
+		_, err = irrdb.Get(ctx, []byte("lost"))
+		require.Error(t, err)
+
+		_, err = pointerdb.Get("lost")
+		require.True(t, storage.ErrKeyNotFound.Has(err))
+	})
+}
+
+func TestRecheckStaleSegments(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		service := satellite.Repair.Irreparable
+		service.Loop.Pause()
+
+		irrdb := satellite.DB.Irreparable()
+		pointerdb := satellite.Metainfo.Service
+
+		makeIrreparable := func(path string, minReq int32, lastRepairAttempt time.Time) {
+			var pieces []*pb.RemotePiece
+			for i, node := range planet.StorageNodes {
+				pieces = append(pieces, &pb.RemotePiece{PieceNum: int32(i), NodeId: node.ID()})
+			}
+
+			pointer := &pb.Pointer{
+				Remote: &pb.RemoteSegment{
+					Redundancy: &pb.RedundancyScheme{
+						MinReq:          minReq,
+						RepairThreshold: 8,
+					},
+					RootPieceId:  teststorj.PieceIDFromString(path),
+					RemotePieces: pieces,
+				},
+			}
+			require.NoError(t, pointerdb.Put(path, pointer))
+
+			err := irrdb.IncrementRepairAttempts(ctx, &pb.IrreparableSegment{
+				Path:               []byte(path),
+				SegmentDetail:      pointer,
+				LostPieces:         1,
+				LastRepairAttempt:  lastRepairAttempt.Unix(),
+				RepairAttemptCount: 1,
+			})
+			require.NoError(t, err)
+		}
+
+		now := time.Now()
+		makeIrreparable("stale", 4, now.Add(-48*time.Hour))
+		makeIrreparable("stale-lost", 6, now.Add(-48*time.Hour))
+		makeIrreparable("recent", 4, now)
+
+		// no node returned, only the stale segments are re-checked
+		require.NoError(t, service.Recheck(ctx))
+
+		_, err := irrdb.Get(ctx, []byte("stale"))
+		require.Error(t, err)
+
+		injured, err := satellite.DB.RepairQueue().Select(ctx)
+		require.NoError(t, err)
+		require.Equal(t, "stale", injured.Path)
+
+		_, err = irrdb.Get(ctx, []byte("recent"))
+		require.NoError(t, err)
+
+		// the segment which is still irreparable waits for the next recheck age
+		lost, err := irrdb.Get(ctx, []byte("stale-lost"))
+		require.NoError(t, err)
+		require.EqualValues(t, 2, lost.RepairAttemptCount)
+		require.True(t, lost.LastRepairAttempt >= now.Unix())
+
+		require.NoError(t, service.Recheck(ctx))
+		lost, err = irrdb.Get(ctx, []byte("stale-lost"))
+		require.NoError(t, err)
+		require.EqualValues(t, 2, lost.RepairAttemptCount)
+	})
+}
+
+func TestMarkLost(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		service := satellite.Repair.Irreparable
+		service.Loop.Pause()
+
+		irrdb := satellite.DB.Irreparable()
+		pointerdb := satellite.Metainfo.Service
+
+		project, err := satellite.DB.Console().Projects().Insert(ctx, &console.Project{
+			Name: "testProject",
+		})
+		require.NoError(t, err)
+
+		makeIrreparable := func(path string) {
+			pointer := &pb.Pointer{
+				Remote: &pb.RemoteSegment{
+					Redundancy: &pb.RedundancyScheme{
+						MinReq:          4,
+						RepairThreshold: 8,
+					},
+					RootPieceId: teststorj.PieceIDFromString(path),
+					RemotePieces: []*pb.RemotePiece{
+						{PieceNum: 0, NodeId: storj.NodeID{1}},
+					},
+				},
+			}
+			require.NoError(t, pointerdb.Put(path, pointer))
+
+			err := irrdb.IncrementRepairAttempts(ctx, &pb.IrreparableSegment{
+				Path:               []byte(path),
+				SegmentDetail:      pointer,
+				LostPieces:         9,
+				LastRepairAttempt:  time.Now().Unix(),
+				RepairAttemptCount: 1,
+			})
+			require.NoError(t, err)
+		}
+
+		{ // segment of an existing project is removed everywhere
+			path := storj.JoinPaths(project.ID.String(), "l", "bucket", "object")
+			makeIrreparable(path)
+
+			notified, err := service.MarkLost(ctx, []byte(path))
+			require.NoError(t, err)
+			require.Equal(t, 0, notified)
+
+			_, err = pointerdb.Get(path)
+			require.True(t, storage.ErrKeyNotFound.Has(err))
+			_, err = irrdb.Get(ctx, []byte(path))
+			require.Error(t, err)
+		}
+
+		{ // failing notification keeps the segment in irreparable db
+			path := "invalid-path"
+			makeIrreparable(path)
+
+			_, err := service.MarkLost(ctx, []byte(path))
+			require.True(t, irreparable.ErrPartiallyLost.Has(err))
+
+			_, err = pointerdb.Get(path)
+			require.True(t, storage.ErrKeyNotFound.Has(err))
+			_, err = irrdb.Get(ctx, []byte(path))
+			require.NoError(t, err)
+
+			// retrying doesn't fail on the already deleted pointer
+			_, err = service.MarkLost(ctx, []byte(path))
+			require.True(t, irreparable.ErrPartiallyLost.Has(err))
+		}
+
+		{ // unknown segment is not touched
+			_, err := service.MarkLost(ctx, []byte("unknown"))
+			require.Error(t, err)
+			require.False(t, irreparable.ErrPartiallyLost.Has(err))
+		}
+	})
+}
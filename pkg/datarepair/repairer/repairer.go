@@ -26,28 +26,30 @@ type SegmentRepairer interface {
 
 // Service contains the information needed to run the repair service
 type Service struct {
-	queue     queue.RepairQueue
-	config    *Config
-	Limiter   *sync2.Limiter
-	Loop      sync2.Cycle
-	transport transport.Client
-	pointerdb *pointerdb.Service
-	orders    *orders.Service
-	cache     *overlay.Cache
-	repairer  SegmentRepairer
+	queue      queue.RepairQueue
+	config     *Config
+	Limiter    *sync2.Limiter
+	Loop       sync2.Cycle
+	transport  transport.Client
+	pointerdb  *pointerdb.Service
+	orders     *orders.Service
+	cache      *overlay.Cache
+	placements overlay.BucketPlacements
+	repairer   SegmentRepairer
 }
 
 // NewService creates repairing service
-func NewService(queue queue.RepairQueue, config *Config, interval time.Duration, concurrency int, transport transport.Client, pointerdb *pointerdb.Service, orders *orders.Service, cache *overlay.Cache) *Service {
+func NewService(queue queue.RepairQueue, config *Config, interval time.Duration, concurrency int, transport transport.Client, pointerdb *pointerdb.Service, orders *orders.Service, cache *overlay.Cache, placements overlay.BucketPlacements) *Service {
 	return &Service{
-		queue:     queue,
-		config:    config,
-		Limiter:   sync2.NewLimiter(concurrency),
-		Loop:      *sync2.NewCycle(interval),
-		transport: transport,
-		pointerdb: pointerdb,
-		orders:    orders,
-		cache:     cache,
+		queue:      queue,
+		config:     config,
+		Limiter:    sync2.NewLimiter(concurrency),
+		Loop:       *sync2.NewCycle(interval),
+		transport:  transport,
+		pointerdb:  pointerdb,
+		orders:     orders,
+		cache:      cache,
+		placements: placements,
 	}
 }
 
@@ -65,6 +67,7 @@ func (service *Service) Run(ctx context.Context) (err error) {
 		service.pointerdb,
 		service.orders,
 		service.cache,
+		service.placements,
 		service.transport.Identity(),
 	)
 	if err != nil {
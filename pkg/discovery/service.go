@@ -31,6 +31,7 @@ type Config struct {
 	GraveyardInterval time.Duration `help:"the interval at which the the graveyard tries to resurrect nodes" default:"30s"`
 	DiscoveryInterval time.Duration `help:"the interval at which the satellite attempts to find new nodes via random node ID lookups" default:"1s"`
 	RefreshLimit      int           `help:"the amount of nodes refreshed at each interval" default:"100"`
+	ProbeAfter        time.Duration `help:"how long a node can go without being contacted before the satellite pings it" default:"45m0s"`
 }
 
 // Discovery struct loads on cache, kad
@@ -42,6 +43,7 @@ type Discovery struct {
 	// refreshOffset tracks the offset of the current refresh cycle
 	refreshOffset int64
 	refreshLimit  int
+	probeAfter    time.Duration
 
 	Refresh   sync2.Cycle
 	Graveyard sync2.Cycle
@@ -57,6 +59,7 @@ func New(logger *zap.Logger, ol *overlay.Cache, kad *kademlia.Kademlia, config C
 
 		refreshOffset: 0,
 		refreshLimit:  config.RefreshLimit,
+		probeAfter:    config.ProbeAfter,
 	}
 
 	discovery.Refresh.SetInterval(config.RefreshInterval)
@@ -101,12 +104,18 @@ func (discovery *Discovery) Run(ctx context.Context) error {
 	return group.Wait()
 }
 
-// refresh updates the cache db with the current DHT.
-// We currently do not penalize nodes that are unresponsive,
-// but should in the future.
+// refresh adds nodes seen in the DHT to the cache db and pings known nodes
+// that haven't been contacted for a while.
+//
+// Nodes that check in with the satellite are not pinged, the pings only detect
+// nodes that stopped checking in, so their uptime and downtime are still recorded.
 func (discovery *Discovery) refresh(ctx context.Context) error {
 	nodes := discovery.kad.Seen()
 	for _, v := range nodes {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
 		if err := discovery.cache.Put(ctx, v.Id, *v); err != nil {
 			return err
 		}
@@ -118,10 +127,10 @@ func (discovery *Discovery) refresh(ctx context.Context) error {
 	}
 
 	// more means there are more rows to page through in the cache
-	if more == false {
+	if !more {
 		discovery.refreshOffset = 0
 	} else {
-		discovery.refreshOffset = discovery.refreshOffset + int64(len(list))
+		discovery.refreshOffset += int64(len(list))
 	}
 
 	for _, node := range list {
@@ -129,6 +138,10 @@ func (discovery *Discovery) refresh(ctx context.Context) error {
 			return ctx.Err()
 		}
 
+		if !discovery.needsProbe(node) {
+			continue
+		}
+
 		ping, err := discovery.kad.Ping(ctx, node.Node)
 		if err != nil {
 			discovery.log.Info("could not ping node", zap.String("ID", node.Id.String()), zap.Error(err))
@@ -147,25 +160,22 @@ func (discovery *Discovery) refresh(ctx context.Context) error {
 		if err != nil {
 			discovery.log.Error("could not update node uptime in cache", zap.String("ID", ping.Id.String()), zap.Error(err))
 		}
-		err = discovery.cache.Put(ctx, ping.Id, ping)
-		if err != nil {
-			discovery.log.Error("could not put node into cache", zap.String("ID", ping.Id.String()), zap.Error(err))
-		}
+	}
 
-		// update wallet with correct info
-		info, err := discovery.kad.FetchInfo(ctx, node.Node)
-		if err != nil {
-			discovery.log.Warn("could not fetch node info", zap.String("ID", ping.GetAddress().String()))
-			continue
-		}
+	return nil
+}
 
-		_, err = discovery.cache.UpdateNodeInfo(ctx, ping.Id, info)
-		if err != nil {
-			discovery.log.Warn("could not update node operator", zap.String("ID", ping.GetAddress().String()))
-		}
+// needsProbe checks whether the node has gone without any contact for longer than probeAfter.
+func (discovery *Discovery) needsProbe(node *overlay.NodeDossier) bool {
+	if node.Disqualified() || node.ExitStatus.Finished() {
+		return false
 	}
 
-	return nil
+	lastContact := node.Reputation.LastContactSuccess
+	if node.Reputation.LastContactFailure.After(lastContact) {
+		lastContact = node.Reputation.LastContactFailure
+	}
+	return time.Since(lastContact) >= discovery.probeAfter
 }
 
 // graveyard attempts to ping all nodes in the Seen() map from Kademlia and adds them to the cache
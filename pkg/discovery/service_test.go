@@ -5,12 +5,17 @@ package discovery_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
 
 	"storj.io/storj/internal/testcontext"
 	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite"
 )
 
 func TestCache_Refresh(t *testing.T) {
@@ -53,3 +58,42 @@ func TestCache_Graveyard(t *testing.T) {
 		assert.True(t, found.Online())
 	})
 }
+
+func TestCache_RefreshProbesSilentNodes(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Discovery.ProbeAfter = 0
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		satellite.Discovery.Service.Refresh.Pause()
+
+		// a node which was online, but stopped checking in
+		silentID := storj.NodeID{1, 2, 3}
+		err := satellite.Overlay.Service.Put(ctx, silentID, pb.Node{
+			Id:      silentID,
+			Type:    pb.NodeType_STORAGE,
+			Address: &pb.NodeAddress{Transport: pb.NodeTransport_TCP_TLS_GRPC, Address: "127.0.0.1:1"},
+		})
+		require.NoError(t, err)
+		_, err = satellite.Overlay.Service.UpdateUptime(ctx, silentID, true)
+		require.NoError(t, err)
+
+		before := time.Now()
+		satellite.Discovery.Service.Refresh.TriggerWait()
+
+		silent, err := satellite.Overlay.Service.Get(ctx, silentID)
+		require.NoError(t, err)
+		assert.True(t, silent.Reputation.LastContactFailure.After(before))
+		assert.False(t, silent.Online())
+
+		for _, storageNode := range planet.StorageNodes {
+			node, err := satellite.Overlay.Service.Get(ctx, storageNode.ID())
+			require.NoError(t, err)
+			assert.True(t, node.Online())
+		}
+	})
+}
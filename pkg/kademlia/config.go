@@ -9,6 +9,7 @@ import (
 
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
 
 	"storj.io/storj/pkg/pb"
 )
@@ -16,7 +17,8 @@ import (
 var (
 	// Error defines a Kademlia error
 	Error = errs.Class("kademlia error")
-	// mon   = monkit.Package() // TODO: figure out whether this is needed
+
+	mon = monkit.Package()
 )
 
 // Config defines all of the things that are needed to start up Kademlia
@@ -54,8 +56,9 @@ func (c Config) Verify(log *zap.Logger) error {
 
 // OperatorConfig defines properties related to storage node operator metadata
 type OperatorConfig struct {
-	Email  string `user:"true" help:"operator email address" default:""`
-	Wallet string `user:"true" help:"operator wallet adress" default:""`
+	Email   string `user:"true" help:"operator email address" default:""`
+	Wallet  string `user:"true" help:"operator wallet adress" default:""`
+	Country string `user:"true" help:"operator declared ISO 3166-1 alpha-2 country code of the node location" default:""`
 }
 
 // Verify verifies whether operator config is valid.
@@ -66,6 +69,9 @@ func (c OperatorConfig) Verify(log *zap.Logger) error {
 	if err := isOperatorWalletValid(log, c.Wallet); err != nil {
 		return err
 	}
+	if err := isOperatorCountryValid(log, c.Country); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -90,3 +96,16 @@ func isOperatorWalletValid(log *zap.Logger, wallet string) error {
 	log.Sugar().Info("Operator wallet: ", wallet)
 	return nil
 }
+
+func isOperatorCountryValid(log *zap.Logger, country string) error {
+	if country == "" {
+		return nil
+	}
+	r := regexp.MustCompile("^[a-zA-Z]{2}$")
+	if match := r.MatchString(country); !match {
+		return fmt.Errorf("Operator country code isn't valid")
+	}
+
+	log.Sugar().Info("Operator country: ", country)
+	return nil
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
+	"storj.io/storj/pkg/identity"
 	"storj.io/storj/pkg/pb"
 )
 
@@ -38,7 +39,11 @@ func (endpoint *Endpoint) Query(ctx context.Context, req *pb.QueryRequest) (*pb.
 	endpoint.service.Queried()
 
 	if req.GetPingback() {
-		endpoint.pingback(ctx, req.Sender)
+		if err := endpoint.verifySender(ctx, req.Sender); err != nil {
+			endpoint.log.Debug("not adding sender to routing table", zap.Error(err))
+		} else {
+			endpoint.pingback(ctx, req.Sender)
+		}
 	}
 
 	nodes, err := endpoint.routingTable.FindNear(req.Target.Id, int(req.Limit))
@@ -49,6 +54,20 @@ func (endpoint *Endpoint) Query(ctx context.Context, req *pb.QueryRequest) (*pb.
 	return &pb.QueryResponse{Sender: req.Sender, Response: nodes}, nil
 }
 
+// verifySender checks that the sender is the peer that made the request and it has the minimum difficulty.
+// The CA whitelist is enforced by the TLS handshake of the pingback, the sender is only added to the
+// routing table when that connection succeeds.
+func (endpoint *Endpoint) verifySender(ctx context.Context, sender *pb.Node) error {
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return ErrNodeVerification.Wrap(err)
+	}
+	if sender == nil || sender.Id != peer.ID {
+		return ErrNodeVerification.New("sender doesn't match peer %s", peer.ID)
+	}
+	return verifyDifficulty(peer.ID, endpoint.routingTable.MinimumDifficulty())
+}
+
 // pingback implements pingback for queries
 func (endpoint *Endpoint) pingback(ctx context.Context, target *pb.Node) {
 	_, err := endpoint.service.Ping(ctx, *target)
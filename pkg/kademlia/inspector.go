@@ -6,6 +6,8 @@ package kademlia
 import (
 	"context"
 
+	"github.com/golang/protobuf/ptypes"
+
 	"storj.io/storj/pkg/identity"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/storj"
@@ -136,3 +138,58 @@ func (srv *Inspector) NodeInfo(ctx context.Context, req *pb.NodeInfoRequest) (*p
 		Version:  info.GetVersion(),
 	}, nil
 }
+
+// GetBucketList returns all buckets of the routing table with their nodes, replacement cache and time of the last lookup.
+func (srv *Inspector) GetBucketList(ctx context.Context, req *pb.GetBucketListRequest) (*pb.GetBucketListResponse, error) {
+	buckets, err := srv.dht.GetBuckets()
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	res := &pb.GetBucketListResponse{
+		BucketSize:           int64(srv.dht.routingTable.K()),
+		ReplacementCacheSize: int64(srv.dht.routingTable.CacheSize()),
+	}
+	for _, bucket := range buckets {
+		lastUpdated, err := ptypes.TimestampProto(bucket.LastUpdated)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		res.Buckets = append(res.Buckets, &pb.GetBucketListResponse_Bucket{
+			BucketId:     bucket.ID,
+			RoutingNodes: bucket.Nodes,
+			CachedNodes:  bucket.Cached,
+			LastUpdated:  lastUpdated,
+		})
+	}
+	return res, nil
+}
+
+// TraceLookup triggers a Kademlia lookup and returns every node that was queried on the way.
+// A failed lookup is reported in the response, so the hops are available in that case as well.
+func (srv *Inspector) TraceLookup(ctx context.Context, req *pb.TraceLookupRequest) (*pb.TraceLookupResponse, error) {
+	trace, err := srv.dht.TraceLookup(ctx, req.Id)
+	if trace == nil {
+		return nil, Error.Wrap(err)
+	}
+
+	res := &pb.TraceLookupResponse{
+		Node:     trace.Node,
+		Duration: ptypes.DurationProto(trace.Duration),
+	}
+	if err != nil {
+		res.Error = err.Error()
+	}
+	for _, hop := range trace.Hops {
+		pbHop := &pb.LookupHop{
+			Node:         hop.Node,
+			ResponseTime: ptypes.DurationProto(hop.ResponseTime),
+			ReturnedIds:  hop.Returned,
+		}
+		if hop.Err != nil {
+			pbHop.Error = hop.Err.Error()
+		}
+		res.Hops = append(res.Hops, pbHop)
+	}
+	return res, nil
+}
@@ -38,6 +38,9 @@ type discoveryOptions struct {
 	retries        int
 	bootstrap      bool
 	bootstrapNodes []pb.Node
+	observe        func(LookupHop)
+
+	minimumDifficulty uint16
 }
 
 // Kademlia is an implementation of kademlia adhering to the DHT interface.
@@ -120,6 +123,11 @@ func (k *Kademlia) GetBucketIds() (storage.Keys, error) {
 	return k.routingTable.GetBucketIds()
 }
 
+// GetBuckets returns all k-buckets of the routing table
+func (k *Kademlia) GetBuckets() ([]BucketInfo, error) {
+	return k.routingTable.GetBuckets()
+}
+
 // Local returns the local nodes ID
 func (k *Kademlia) Local() pb.Node {
 	return k.routingTable.Local()
@@ -184,7 +192,7 @@ func (k *Kademlia) Bootstrap(ctx context.Context) error {
 	k.routingTable.mutex.Lock()
 	id := k.routingTable.self.Id
 	k.routingTable.mutex.Unlock()
-	_, err := k.lookup(ctx, id, true)
+	_, err := k.lookup(ctx, id, true, nil)
 
 	// TODO(dylan): We do not currently handle this last bit of behavior.
 	// ```
@@ -253,11 +261,33 @@ func (k *Kademlia) FindNode(ctx context.Context, ID storj.NodeID) (pb.Node, erro
 	}
 	defer k.lookups.Done()
 
-	return k.lookup(ctx, ID, false)
+	return k.lookup(ctx, ID, false, nil)
+}
+
+// TraceLookup looks up the provided NodeID like FindNode and records every node queried on the way
+func (k *Kademlia) TraceLookup(ctx context.Context, ID storj.NodeID) (*LookupTrace, error) {
+	if !k.lookups.Start() {
+		return nil, context.Canceled
+	}
+	defer k.lookups.Done()
+
+	trace := &LookupTrace{Target: ID}
+	var mu sync.Mutex
+	start := time.Now()
+	node, err := k.lookup(ctx, ID, false, func(hop LookupHop) {
+		mu.Lock()
+		defer mu.Unlock()
+		trace.Hops = append(trace.Hops, hop)
+	})
+	trace.Duration = time.Since(start)
+	if err == nil {
+		trace.Node = &node
+	}
+	return trace, err
 }
 
-//lookup initiates a kadmelia node lookup
-func (k *Kademlia) lookup(ctx context.Context, ID storj.NodeID, isBootstrap bool) (pb.Node, error) {
+// lookup initiates a kadmelia node lookup, observe is called for every queried node when it isn't nil
+func (k *Kademlia) lookup(ctx context.Context, ID storj.NodeID, isBootstrap bool, observe func(LookupHop)) (pb.Node, error) {
 	if !k.lookups.Start() {
 		return pb.Node{}, context.Canceled
 	}
@@ -278,6 +308,7 @@ func (k *Kademlia) lookup(ctx context.Context, ID storj.NodeID, isBootstrap bool
 	}
 	lookup := newPeerDiscovery(k.log, k.routingTable.Local(), nodes, k.dialer, ID, discoveryOptions{
 		concurrency: k.alpha, retries: defaultRetries, bootstrap: isBootstrap, bootstrapNodes: k.bootstrapNodes,
+		observe: observe, minimumDifficulty: k.routingTable.MinimumDifficulty(),
 	})
 	target, err := lookup.Run(ctx)
 	if err != nil {
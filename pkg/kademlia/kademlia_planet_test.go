@@ -93,3 +93,39 @@ func TestPingTimeout(t *testing.T) {
 
 	})
 }
+
+func TestInspectorBucketsAndTrace(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		inspector := sat.Kademlia.Inspector
+
+		buckets, err := inspector.GetBucketList(ctx, &pb.GetBucketListRequest{})
+		require.NoError(t, err)
+		require.NotEmpty(t, buckets.Buckets)
+		require.EqualValues(t, sat.Kademlia.RoutingTable.K(), buckets.BucketSize)
+
+		var routingNodes int
+		for _, bucket := range buckets.Buckets {
+			require.NotNil(t, bucket.LastUpdated)
+			routingNodes += len(bucket.RoutingNodes)
+		}
+		dumped, err := sat.Kademlia.Service.DumpNodes(ctx)
+		require.NoError(t, err)
+		require.Equal(t, len(dumped), routingNodes)
+
+		// a lookup for an unknown node has to query the network
+		target := planet.StorageNodes[0].ID()
+		target[len(target)-1]++
+		trace, err := inspector.TraceLookup(ctx, &pb.TraceLookupRequest{Id: target})
+		require.NoError(t, err)
+		require.NotEmpty(t, trace.Error)
+		require.Nil(t, trace.Node)
+		require.NotEmpty(t, trace.Hops)
+		for _, hop := range trace.Hops {
+			require.NotNil(t, hop.Node)
+			require.NotNil(t, hop.ResponseTime)
+		}
+	})
+}
@@ -117,7 +117,7 @@ func TestPeerDiscovery(t *testing.T) {
 		},
 	}
 	for _, v := range cases {
-		_, err := k.lookup(ctx, v.target, true)
+		_, err := k.lookup(ctx, v.target, true, nil)
 		assert.Equal(t, v.expectedErr, err)
 	}
 }
@@ -7,6 +7,7 @@ import (
 	"context"
 	"sort"
 	"sync"
+	"time"
 
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
@@ -30,6 +31,22 @@ type peerDiscovery struct {
 // ErrMaxRetries is used when a lookup has been retried the max number of times
 var ErrMaxRetries = errs.Class("max retries exceeded for id:")
 
+// LookupHop is a single node queried during a lookup
+type LookupHop struct {
+	Node         *pb.Node
+	ResponseTime time.Duration
+	Returned     storj.NodeIDList
+	Err          error
+}
+
+// LookupTrace contains the nodes queried during a lookup in the order they responded
+type LookupTrace struct {
+	Target   storj.NodeID
+	Node     *pb.Node
+	Hops     []LookupHop
+	Duration time.Duration
+}
+
 func newPeerDiscovery(log *zap.Logger, self pb.Node, nodes []*pb.Node, dialer *Dialer, target storj.NodeID, opts discoveryOptions) *peerDiscovery {
 	discovery := &peerDiscovery{
 		log:    log,
@@ -51,9 +68,16 @@ func (lookup *peerDiscovery) Run(ctx context.Context) (target *pb.Node, err erro
 
 	// protected by `lookup.cond.L`
 	working := 0
+	maxWorking := 0
+	queried := 0
 	allDone := false
 	target = nil
 
+	defer func() {
+		mon.IntVal("lookup_queried_nodes").Observe(int64(queried))
+		mon.IntVal("lookup_max_parallel_queries").Observe(int64(maxWorking))
+	}()
+
 	wg := sync.WaitGroup{}
 	wg.Add(lookup.opts.concurrency)
 	defer wg.Wait()
@@ -82,6 +106,10 @@ func (lookup *peerDiscovery) Run(ctx context.Context) (target *pb.Node, err erro
 
 					if next != nil {
 						working++
+						queried++
+						if working > maxWorking {
+							maxWorking = working
+						}
 						break
 					}
 					// no work, wait until some other routine inserts into the queue
@@ -94,7 +122,18 @@ func (lookup *peerDiscovery) Run(ctx context.Context) (target *pb.Node, err erro
 					nodeType.DPanicOnInvalid("Peer Discovery Run")
 				}
 				next.Type.DPanicOnInvalid("next")
+				start := time.Now()
 				neighbors, err := lookup.dialer.Lookup(ctx, lookup.self, *next, pb.Node{Id: lookup.target, Type: nodeType})
+				responseTime := time.Since(start)
+				mon.FloatVal("lookup_query_seconds").Observe(responseTime.Seconds())
+
+				if lookup.opts.observe != nil {
+					hop := LookupHop{Node: next, ResponseTime: responseTime, Err: err}
+					for _, neighbor := range neighbors {
+						hop.Returned = append(hop.Returned, neighbor.Id)
+					}
+					lookup.opts.observe(hop)
+				}
 
 				if err != nil && !isDone(ctx) {
 					// TODO: reenable retry after fixing logic
@@ -110,7 +149,7 @@ func (lookup *peerDiscovery) Run(ctx context.Context) (target *pb.Node, err erro
 					}
 				}
 
-				lookup.queue.Insert(lookup.target, neighbors...)
+				lookup.queue.Insert(lookup.target, lookup.verified(neighbors)...)
 
 				lookup.cond.L.Lock()
 				working--
@@ -128,6 +167,23 @@ func (lookup *peerDiscovery) Run(ctx context.Context) (target *pb.Node, err erro
 	return target, err
 }
 
+// verified returns the nodes that have the minimum difficulty, so that cheap identities aren't followed
+func (lookup *peerDiscovery) verified(nodes []*pb.Node) []*pb.Node {
+	if lookup.opts.minimumDifficulty == 0 {
+		return nodes
+	}
+
+	result := nodes[:0:0]
+	for _, node := range nodes {
+		if err := verifyDifficulty(node.Id, lookup.opts.minimumDifficulty); err != nil {
+			mon.Meter("lookup_rejected_nodes").Mark(1)
+			continue
+		}
+		result = append(result, node)
+	}
+	return result
+}
+
 func isDone(ctx context.Context) bool {
 	select {
 	case <-ctx.Done():
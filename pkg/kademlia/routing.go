@@ -48,8 +48,9 @@ var emptyBucketID = bucketID{}
 
 // RoutingTableConfig configures the routing table
 type RoutingTableConfig struct {
-	BucketSize           int `help:"size of each Kademlia bucket" default:"20"`
-	ReplacementCacheSize int `help:"size of Kademlia replacement cache" default:"5"`
+	BucketSize           int  `help:"size of each Kademlia bucket" default:"20"`
+	ReplacementCacheSize int  `help:"size of Kademlia replacement cache" default:"5"`
+	MinimumDifficulty    uint `help:"minimum identity difficulty of nodes added to the routing table, returned from queries and followed in lookups" default:"30" devDefault:"8"`
 }
 
 // RoutingTable implements the RoutingTable interface
@@ -63,8 +64,9 @@ type RoutingTable struct {
 	rcMutex          *sync.Mutex
 	seen             map[storj.NodeID]*pb.Node
 	replacementCache map[bucketID][]*pb.Node
-	bucketSize       int // max number of nodes stored in a kbucket = 20 (k)
-	rcBucketSize     int // replacementCache bucket max length
+	bucketSize       int    // max number of nodes stored in a kbucket = 20 (k)
+	rcBucketSize     int    // replacementCache bucket max length
+	minDifficulty    uint16 // minimum difficulty of node ids accepted into the routing table
 }
 
 // NewRoutingTable returns a newly configured instance of a RoutingTable
@@ -73,9 +75,14 @@ func NewRoutingTable(logger *zap.Logger, localNode pb.Node, kdb, ndb storage.Key
 
 	if config == nil || config.BucketSize == 0 || config.ReplacementCacheSize == 0 {
 		// TODO: handle this more nicely
+		minimumDifficulty := uint(0)
+		if config != nil {
+			minimumDifficulty = config.MinimumDifficulty
+		}
 		config = &RoutingTableConfig{
 			BucketSize:           20,
 			ReplacementCacheSize: 5,
+			MinimumDifficulty:    minimumDifficulty,
 		}
 	}
 
@@ -91,8 +98,9 @@ func NewRoutingTable(logger *zap.Logger, localNode pb.Node, kdb, ndb storage.Key
 		seen:             make(map[storj.NodeID]*pb.Node),
 		replacementCache: make(map[bucketID][]*pb.Node),
 
-		bucketSize:   config.BucketSize,
-		rcBucketSize: config.ReplacementCacheSize,
+		bucketSize:    config.BucketSize,
+		rcBucketSize:  config.ReplacementCacheSize,
+		minDifficulty: uint16(config.MinimumDifficulty),
 	}
 	ok, err := rt.addNode(&localNode)
 	if !ok || err != nil {
@@ -113,6 +121,11 @@ func (rt *RoutingTable) Local() pb.Node {
 	return rt.self
 }
 
+// MinimumDifficulty returns the minimum identity difficulty of nodes in the routing table
+func (rt *RoutingTable) MinimumDifficulty() uint16 {
+	return rt.minDifficulty
+}
+
 // K returns the currently configured maximum of nodes to store in a bucket
 func (rt *RoutingTable) K() int {
 	return rt.bucketSize
@@ -176,6 +189,10 @@ func (rt *RoutingTable) DumpNodes() ([]*pb.Node, error) {
 func (rt *RoutingTable) FindNear(target storj.NodeID, limit int, restrictions ...pb.Restriction) ([]*pb.Node, error) {
 	closestNodes := make([]*pb.Node, 0, limit+1)
 	err := rt.iterateNodes(storj.NodeID{}, func(newID storj.NodeID, protoNode []byte) error {
+		// skip nodes added before the minimum difficulty was raised
+		if verifyDifficulty(newID, rt.minDifficulty) != nil {
+			return nil
+		}
 		newPos := len(closestNodes)
 		for ; newPos > 0 && compareByXor(closestNodes[newPos-1].Id, newID, target) > 0; newPos-- {
 		}
@@ -230,6 +247,13 @@ func (rt *RoutingTable) ConnectionSuccess(node *pb.Node) error {
 
 	node.Type.DPanicOnInvalid("connection success")
 
+	// cheap identities are valid to connect to, but shouldn't be able to flood the routing table
+	if err := verifyDifficulty(node.Id, rt.minDifficulty); err != nil {
+		mon.Meter("routing_table_rejected_nodes").Mark(1)
+		rt.log.Debug("not adding node to routing table", zap.Stringer("node", node.Id), zap.Error(err))
+		return nil
+	}
+
 	rt.mutex.Lock()
 	rt.seen[node.Id] = node
 	rt.mutex.Unlock()
@@ -284,6 +308,53 @@ func (rt *RoutingTable) GetBucketTimestamp(bIDBytes []byte) (time.Time, error) {
 	return time.Unix(0, timestamp).UTC(), nil
 }
 
+// BucketInfo describes a k-bucket of the routing table
+type BucketInfo struct {
+	ID          storj.NodeID
+	Nodes       []*pb.Node
+	Cached      []*pb.Node
+	LastUpdated time.Time
+}
+
+// GetBuckets returns all k-buckets with their nodes, replacement cache and time of the last lookup
+func (rt *RoutingTable) GetBuckets() ([]BucketInfo, error) {
+	rt.mutex.Lock()
+	defer rt.mutex.Unlock()
+
+	keys, err := rt.GetBucketIds()
+	if err != nil {
+		return nil, RoutingErr.Wrap(err)
+	}
+
+	buckets := make([]BucketInfo, 0, len(keys))
+	for _, key := range keys {
+		bID := keyToBucketID(key)
+		nodes, err := rt.getUnmarshaledNodesFromBucket(bID)
+		if err != nil {
+			return nil, err
+		}
+		lastUpdated, err := rt.GetBucketTimestamp(key)
+		if err != nil {
+			return nil, err
+		}
+
+		rt.rcMutex.Lock()
+		cached := make([]*pb.Node, 0, len(rt.replacementCache[bID]))
+		for _, node := range rt.replacementCache[bID] {
+			cached = append(cached, pb.CopyNode(node))
+		}
+		rt.rcMutex.Unlock()
+
+		buckets = append(buckets, BucketInfo{
+			ID:          bID,
+			Nodes:       nodes,
+			Cached:      cached,
+			LastUpdated: lastUpdated,
+		})
+	}
+	return buckets, nil
+}
+
 func (rt *RoutingTable) iterateNodes(start storj.NodeID, f func(storj.NodeID, []byte) error, skipSelf bool) error {
 	return rt.nodeBucketDB.Iterate(storage.IterateOptions{First: storage.Key(start.Bytes()), Recurse: true},
 		func(it storage.Iterator) error {
@@ -24,8 +24,9 @@ import (
 )
 
 type routingTableOpts struct {
-	bucketSize int
-	cacheSize  int
+	bucketSize    int
+	cacheSize     int
+	minDifficulty uint16
 }
 
 // newTestRoutingTable returns a newly configured instance of a RoutingTable
@@ -37,6 +38,7 @@ func newTestRoutingTable(localNode pb.Node, opts routingTableOpts) (*RoutingTabl
 		opts.cacheSize = 2
 	}
 	rt := &RoutingTable{
+		log:          zap.L(),
 		self:         localNode,
 		kadBucketDB:  storelogger.New(zap.L().Named("rt.kad"), teststore.New()),
 		nodeBucketDB: storelogger.New(zap.L().Named("rt.node"), teststore.New()),
@@ -47,8 +49,9 @@ func newTestRoutingTable(localNode pb.Node, opts routingTableOpts) (*RoutingTabl
 		seen:             make(map[storj.NodeID]*pb.Node),
 		replacementCache: make(map[bucketID][]*pb.Node),
 
-		bucketSize:   opts.bucketSize,
-		rcBucketSize: opts.cacheSize,
+		bucketSize:    opts.bucketSize,
+		rcBucketSize:  opts.cacheSize,
+		minDifficulty: opts.minDifficulty,
 	}
 	ok, err := rt.addNode(&localNode)
 	if !ok || err != nil {
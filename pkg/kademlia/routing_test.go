@@ -177,6 +177,53 @@ func TestConnectionSuccess(t *testing.T) {
 	}
 }
 
+func TestMinimumDifficulty(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	id := teststorj.NodeIDFromString("AA")
+	rt := createRoutingTableWith(id, routingTableOpts{minDifficulty: 16})
+	defer ctx.Check(rt.Close)
+
+	// the last byte is the version, difficulty is counted from the trailing zero bits before it
+	cheapID, strongID := teststorj.NodeIDFromString("BB"), teststorj.NodeIDFromString("CC")
+	cheapID[len(cheapID)-2] = 0x01
+	strongID[len(strongID)-2], strongID[len(strongID)-3] = 0x00, 0x10
+
+	difficulty, err := cheapID.Difficulty()
+	require.NoError(t, err)
+	require.True(t, difficulty < 16)
+	difficulty, err = strongID.Difficulty()
+	require.NoError(t, err)
+	require.True(t, difficulty >= 16)
+
+	cheap := &pb.Node{Id: cheapID, Address: &pb.NodeAddress{Address: "a"}, Type: pb.NodeType_STORAGE}
+	strong := &pb.Node{Id: strongID, Address: &pb.NodeAddress{Address: "b"}, Type: pb.NodeType_STORAGE}
+
+	require.NoError(t, rt.ConnectionSuccess(cheap))
+	require.NoError(t, rt.ConnectionSuccess(strong))
+
+	_, err = rt.nodeBucketDB.Get(cheapID.Bytes())
+	assert.True(t, storage.ErrKeyNotFound.Has(err))
+
+	nodes, err := rt.FindNear(id, 10)
+	require.NoError(t, err)
+	require.Len(t, nodes, 1)
+	assert.Equal(t, strongID, nodes[0].Id)
+
+	// nodes stored before the minimum was raised aren't returned
+	require.NoError(t, rt.putNode(cheap))
+	nodes, err = rt.FindNear(id, 10)
+	require.NoError(t, err)
+	require.Len(t, nodes, 1)
+
+	// lookups don't follow cheap nodes returned by other nodes
+	lookup := &peerDiscovery{opts: discoveryOptions{minimumDifficulty: 16}}
+	verified := lookup.verified([]*pb.Node{cheap, strong})
+	require.Len(t, verified, 1)
+	assert.Equal(t, strongID, verified[0].Id)
+}
+
 func TestUpdateSelf(t *testing.T) {
 	ctx := testcontext.New(t)
 	defer ctx.Cleanup()
@@ -0,0 +1,28 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package kademlia
+
+import (
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/storj"
+)
+
+// ErrNodeVerification is returned when a node identity doesn't meet the requirements of the network
+var ErrNodeVerification = errs.Class("node verification error")
+
+// verifyDifficulty checks that the node id has at least the minimum difficulty
+func verifyDifficulty(id storj.NodeID, minimum uint16) error {
+	if minimum == 0 {
+		return nil
+	}
+	difficulty, err := id.Difficulty()
+	if err != nil {
+		return ErrNodeVerification.Wrap(err)
+	}
+	if difficulty < minimum {
+		return ErrNodeVerification.New("difficulty %d is less than the minimum %d", difficulty, minimum)
+	}
+	return nil
+}
@@ -0,0 +1,25 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package kademlia
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testidentity"
+	"storj.io/storj/pkg/storj"
+)
+
+func TestVerifyDifficulty(t *testing.T) {
+	id := testidentity.MustPregeneratedSignedIdentity(0, storj.LatestIDVersion()).ID
+
+	difficulty, err := id.Difficulty()
+	require.NoError(t, err)
+
+	assert.NoError(t, verifyDifficulty(id, 0))
+	assert.NoError(t, verifyDifficulty(id, difficulty))
+	assert.True(t, ErrNodeVerification.Has(verifyDifficulty(id, difficulty+1)))
+}
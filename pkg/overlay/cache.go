@@ -6,6 +6,8 @@ package overlay
 import (
 	"context"
 	"errors"
+	"net"
+	"sync"
 	"time"
 
 	"github.com/zeebo/errs"
@@ -42,6 +44,8 @@ type DB interface {
 	SelectStorageNodes(ctx context.Context, count int, criteria *NodeCriteria) ([]*pb.Node, error)
 	// SelectNewStorageNodes looks up nodes based on new node criteria
 	SelectNewStorageNodes(ctx context.Context, count int, criteria *NewNodeCriteria) ([]*pb.Node, error)
+	// SelectAllStorageNodesUpload returns all nodes that are eligible for uploads, separated into reputable and new nodes
+	SelectAllStorageNodesUpload(ctx context.Context, preferences NodeSelectionConfig) (reputable, new []*SelectedNode, err error)
 
 	// Get looks up the node by nodeID
 	Get(ctx context.Context, nodeID storj.NodeID) (*NodeDossier, error)
@@ -51,19 +55,36 @@ type DB interface {
 	List(ctx context.Context, cursor storj.NodeID, limit int) ([]*NodeDossier, error)
 	// Paginate will page through the database nodes
 	Paginate(ctx context.Context, offset int64, limit int) ([]*NodeDossier, bool, error)
-	// Update updates node information
-	Update(ctx context.Context, value *pb.Node) error
+	// Update updates node information, lastNet is the network the node was last seen in,
+	// countryCode is the country it is located in and defaults contains the initial reputation
+	// values for new nodes. Empty lastNet and countryCode keep the previous values.
+	Update(ctx context.Context, value *pb.Node, lastNet, countryCode string, defaults NodeSelectionConfig) error
 
 	// CreateStats initializes the stats for node.
 	CreateStats(ctx context.Context, nodeID storj.NodeID, initial *NodeStats) (stats *NodeStats, err error)
-	// FindInvalidNodes finds a subset of storagenodes that have stats below provided reputation requirements.
-	FindInvalidNodes(ctx context.Context, nodeIDs storj.NodeIDList, maxStats *NodeStats) (invalid storj.NodeIDList, err error)
+	// FindInvalidNodes finds a subset of storagenodes that are disqualified or have a reputation below the criteria.
+	FindInvalidNodes(ctx context.Context, nodeIDs storj.NodeIDList, criteria *NodeCriteria) (invalid storj.NodeIDList, err error)
 	// UpdateStats all parts of single storagenode's stats.
 	UpdateStats(ctx context.Context, request *UpdateRequest) (stats *NodeStats, err error)
 	// UpdateNodeInfo updates node dossier with info requested from the node itself like node type, email, wallet, capacity, and version.
 	UpdateNodeInfo(ctx context.Context, node storj.NodeID, nodeInfo *pb.InfoResponse) (stats *NodeDossier, err error)
 	// UpdateUptime updates a single storagenode's uptime stats.
-	UpdateUptime(ctx context.Context, nodeID storj.NodeID, isUp bool) (stats *NodeStats, err error)
+	UpdateUptime(ctx context.Context, nodeID storj.NodeID, isUp bool, lambda, weight float64) (stats *NodeStats, err error)
+
+	// GetExitingNodes returns the exit status of nodes that have initiated a graceful exit, but have not finished it.
+	GetExitingNodes(ctx context.Context) (exiting []*ExitStatus, err error)
+	// UpdateExitStatus updates the graceful exit status of a node.
+	UpdateExitStatus(ctx context.Context, request *ExitStatusRequest) (stats *NodeDossier, err error)
+
+	// GetContactAttempts returns the contact attempts of the nodes since the given time, sorted by time.
+	// The last attempt before since is included to know whether the node was offline at that time.
+	GetContactAttempts(ctx context.Context, nodeIDs storj.NodeIDList, since time.Time) (attempts map[storj.NodeID][]ContactAttempt, err error)
+	// DeleteContactAttemptsBefore deletes the contact attempts older than before.
+	DeleteContactAttemptsBefore(ctx context.Context, before time.Time) (deleted int64, err error)
+	// UpdateSuspended suspends the node from new uploads since suspended, nil unsuspends the node.
+	UpdateSuspended(ctx context.Context, nodeID storj.NodeID, suspended *time.Time) (stats *NodeDossier, err error)
+	// DisqualifyNode disqualifies the node permanently.
+	DisqualifyNode(ctx context.Context, nodeID storj.NodeID) (stats *NodeDossier, err error)
 }
 
 // FindStorageNodesRequest defines easy request parameters.
@@ -77,6 +98,8 @@ type FindStorageNodesRequest struct {
 	ExcludedNodes []storj.NodeID
 
 	MinimumVersion string // semver or empty
+
+	Placement Placement // restricts the countries of the selected nodes
 }
 
 // NodeCriteria are the requirements for selecting nodes
@@ -84,14 +107,19 @@ type NodeCriteria struct {
 	FreeBandwidth int64
 	FreeDisk      int64
 
-	AuditCount         int64
-	AuditSuccessRatio  float64
-	UptimeCount        int64
-	UptimeSuccessRatio float64
+	AuditCount  int64
+	UptimeCount int64
+
+	MinimumAuditReputation  float64
+	MinimumUptimeReputation float64
 
 	Excluded []storj.NodeID
 
 	MinimumVersion string // semver or empty
+
+	DistinctIP bool // select at most one node per network, excluding the networks of excluded nodes
+
+	CountryCodes []string // select only nodes in these countries, empty allows any country
 }
 
 // NewNodeCriteria are the requirement for selecting new nodes
@@ -104,6 +132,10 @@ type NewNodeCriteria struct {
 	Excluded []storj.NodeID
 
 	MinimumVersion string // semver or empty
+
+	DistinctIP bool // select at most one node per network, excluding the networks of excluded nodes
+
+	CountryCodes []string // select only nodes in these countries, empty allows any country
 }
 
 // UpdateRequest is used to update a node status.
@@ -111,6 +143,14 @@ type UpdateRequest struct {
 	NodeID       storj.NodeID
 	AuditSuccess bool
 	IsUp         bool
+
+	// n.b. these are set by Cache.UpdateStats from the satellite's node selection config,
+	// values set by the caller are only used when updating the database directly
+	AuditLambda  float64
+	AuditWeight  float64
+	AuditDQ      float64
+	UptimeLambda float64
+	UptimeWeight float64
 }
 
 // NodeDossier is the complete info that the satellite tracks for a storage node
@@ -121,6 +161,44 @@ type NodeDossier struct {
 	Capacity   pb.NodeCapacity
 	Reputation NodeStats
 	Version    pb.NodeVersion
+	LastNet    string
+
+	CountryCode string
+	ExitStatus  ExitStatus
+	// Suspended is when the node was suspended for being offline too long, nil when it isn't suspended.
+	Suspended *time.Time
+	// Quarantined is when an operator excluded the node from new uploads, nil when it isn't quarantined.
+	Quarantined *time.Time
+}
+
+// ExitStatus contains the progress of a node's graceful exit.
+type ExitStatus struct {
+	NodeID              storj.NodeID
+	ExitInitiatedAt     *time.Time
+	ExitLoopCompletedAt *time.Time
+	ExitFinishedAt      *time.Time
+	ExitSuccess         bool
+}
+
+// Exiting returns whether the node has initiated a graceful exit.
+func (status *ExitStatus) Exiting() bool {
+	return status.ExitInitiatedAt != nil
+}
+
+// Finished returns whether the node has finished its graceful exit.
+func (status *ExitStatus) Finished() bool {
+	return status.ExitFinishedAt != nil
+}
+
+// ExitStatusRequest is used to update a node's graceful exit status, zero timestamps are left unchanged.
+type ExitStatusRequest struct {
+	NodeID storj.NodeID
+
+	ExitInitiatedAt     time.Time
+	ExitLoopCompletedAt time.Time
+	ExitFinishedAt      time.Time
+	// ExitSuccess is only updated together with ExitFinishedAt.
+	ExitSuccess bool
 }
 
 // Online checks if a node is online based on the collected statistics.
@@ -132,6 +210,23 @@ func (node *NodeDossier) Online() bool {
 		node.Reputation.LastContactSuccess.After(node.Reputation.LastContactFailure)
 }
 
+// Disqualified checks whether the node has been permanently disqualified.
+//
+// Pieces stored on a disqualified node are considered lost.
+func (node *NodeDossier) Disqualified() bool {
+	return node.Reputation.Disqualified != nil
+}
+
+// IsSuspended checks whether the node is suspended from receiving new data.
+func (node *NodeDossier) IsSuspended() bool {
+	return node.Suspended != nil
+}
+
+// IsQuarantined checks whether an operator excluded the node from receiving new data.
+func (node *NodeDossier) IsQuarantined() bool {
+	return node.Quarantined != nil
+}
+
 // NodeStats contains statistics about a node.
 type NodeStats struct {
 	Latency90          int64
@@ -143,6 +238,52 @@ type NodeStats struct {
 	UptimeCount        int64
 	LastContactSuccess time.Time
 	LastContactFailure time.Time
+	Disqualified       *time.Time
+	// Returned is set by UpdateUptime when a successful contact
+	// brings back a node that was offline before.
+	Returned bool
+
+	AuditReputationAlpha  float64
+	AuditReputationBeta   float64
+	UptimeReputationAlpha float64
+	UptimeReputationBeta  float64
+}
+
+// AuditReputation returns the audit reputation score of the node between 0 and 1.
+func (stats *NodeStats) AuditReputation() float64 {
+	return reputation(stats.AuditReputationAlpha, stats.AuditReputationBeta)
+}
+
+// UptimeReputation returns the uptime reputation score of the node between 0 and 1.
+func (stats *NodeStats) UptimeReputation() float64 {
+	return reputation(stats.UptimeReputationAlpha, stats.UptimeReputationBeta)
+}
+
+// reputation returns the expected value of the beta distribution with the given alpha and beta.
+func reputation(alpha, beta float64) float64 {
+	if alpha+beta <= 0 {
+		return 0
+	}
+	return alpha / (alpha + beta)
+}
+
+// UpdateReputation updates the alpha and beta values of a reputation with the result of a single check.
+//
+// lambda is the forgetting factor applied to the previous values and
+// weight is the normalization weight of the new result.
+func UpdateReputation(isSuccess bool, alpha, beta, lambda, weight float64) (newAlpha, newBeta float64) {
+	v := -1.0
+	if isSuccess {
+		v = 1.0
+	}
+	newAlpha = lambda*alpha + weight*(1+v)/2
+	newBeta = lambda*beta + weight*(1-v)/2
+	return newAlpha, newBeta
+}
+
+// ReturnObserver is notified when a node that was considered offline is contacted successfully again.
+type ReturnObserver interface {
+	NodeReturned(ctx context.Context, nodeID storj.NodeID)
 }
 
 // Cache is used to store and handle node information
@@ -150,19 +291,64 @@ type Cache struct {
 	log         *zap.Logger
 	db          DB
 	preferences NodeSelectionConfig
+
+	// selection is nil when the upload selection cache is disabled
+	selection *NodeSelectionCache
+	// geoip is nil when node locations are only declared by the operators
+	geoip *GeoIP
+
+	returnObservers []ReturnObserver
+
+	// resolved contains the last address of every node that was resolved into its network
+	resolvedMu sync.Mutex
+	resolved   map[storj.NodeID]string
 }
 
 // NewCache returns a new Cache
 func NewCache(log *zap.Logger, db DB, preferences NodeSelectionConfig) *Cache {
-	return &Cache{
+	cache := &Cache{
 		log:         log,
 		db:          db,
 		preferences: preferences,
+		resolved:    make(map[storj.NodeID]string),
+	}
+	if preferences.SelectionCacheStaleness > 0 {
+		cache.selection = NewNodeSelectionCache(log.Named("selection"), db, preferences, preferences.SelectionCacheStaleness)
+	}
+	return cache
+}
+
+// Run runs the background refresh of the upload selection cache, when it's enabled
+func (cache *Cache) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if cache.selection == nil {
+		return nil
 	}
+	return cache.selection.Run(ctx)
 }
 
 // Close closes resources
-func (cache *Cache) Close() error { return nil }
+func (cache *Cache) Close() error {
+	if cache.selection == nil {
+		return nil
+	}
+	return cache.selection.Close()
+}
+
+// AddReturnObserver registers observer to be notified about nodes coming back online.
+//
+// It must be called before the cache is used.
+func (cache *Cache) AddReturnObserver(observer ReturnObserver) {
+	cache.returnObservers = append(cache.returnObservers, observer)
+}
+
+// SetGeoIP sets the database used to resolve the country of nodes from their address.
+// The resolved country takes precedence over the country declared by the node operator.
+//
+// It must be called before the cache is used.
+func (cache *Cache) SetGeoIP(geoip *GeoIP) {
+	cache.geoip = geoip
+}
 
 // Inspect lists limited number of items in the cache
 func (cache *Cache) Inspect(ctx context.Context) (storage.Keys, error) {
@@ -211,11 +397,70 @@ func (cache *Cache) OfflineNodes(ctx context.Context, nodes []storj.NodeID) (off
 	return offline, nil
 }
 
+// DisqualifiedNodes returns indices of the nodes that are disqualified
+func (cache *Cache) DisqualifiedNodes(ctx context.Context, nodes []storj.NodeID) (disqualified []int, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	results, err := cache.GetAll(ctx, nodes)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, r := range results {
+		if r != nil && r.Disqualified() {
+			disqualified = append(disqualified, i)
+		}
+	}
+
+	return disqualified, nil
+}
+
+// ClumpedNodes returns indices of the nodes that are in the same network as a preceding node.
+//
+// Nodes are only considered clumped when distinct networks are required by the node selection config.
+func (cache *Cache) ClumpedNodes(ctx context.Context, nodes []storj.NodeID) (clumped []int, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !cache.preferences.DistinctIP || len(nodes) == 0 {
+		return nil, nil
+	}
+
+	results, err := cache.GetAll(ctx, nodes)
+	if err != nil {
+		return nil, err
+	}
+
+	networks := make(map[string]struct{}, len(results))
+	for i, r := range results {
+		if r == nil || r.LastNet == "" {
+			continue
+		}
+		if _, ok := networks[r.LastNet]; ok {
+			clumped = append(clumped, i)
+			continue
+		}
+		networks[r.LastNet] = struct{}{}
+	}
+
+	return clumped, nil
+}
+
 // FindStorageNodes searches the overlay network for nodes that meet the provided requirements
 func (cache *Cache) FindStorageNodes(ctx context.Context, req FindStorageNodesRequest) ([]*pb.Node, error) {
 	return cache.FindStorageNodesWithPreferences(ctx, req, &cache.preferences)
 }
 
+// FindStorageNodesForUpload searches for nodes to store a new segment on.
+// The nodes are selected from the in-memory selection cache, unless it has been disabled.
+func (cache *Cache) FindStorageNodesForUpload(ctx context.Context, req FindStorageNodesRequest) (_ []*pb.Node, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if cache.selection == nil {
+		return cache.FindStorageNodes(ctx, req)
+	}
+	return cache.selection.GetNodes(ctx, req)
+}
+
 // FindStorageNodesWithPreferences searches the overlay network for nodes that meet the provided criteria
 func (cache *Cache) FindStorageNodesWithPreferences(ctx context.Context, req FindStorageNodesRequest, preferences *NodeSelectionConfig) (_ []*pb.Node, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -238,19 +483,32 @@ func (cache *Cache) FindStorageNodesWithPreferences(ctx context.Context, req Fin
 		FreeBandwidth: req.FreeBandwidth,
 		FreeDisk:      req.FreeDisk,
 
-		AuditCount:         auditCount,
-		AuditSuccessRatio:  preferences.AuditSuccessRatio,
-		UptimeCount:        preferences.UptimeCount,
-		UptimeSuccessRatio: preferences.UptimeRatio,
+		AuditCount:  auditCount,
+		UptimeCount: preferences.UptimeCount,
+
+		MinimumAuditReputation:  preferences.MinimumAuditReputation,
+		MinimumUptimeReputation: preferences.MinimumUptimeReputation,
 
 		Excluded: req.ExcludedNodes,
 
 		MinimumVersion: preferences.MinimumVersion,
+		DistinctIP:     preferences.DistinctIP,
+
+		CountryCodes: req.Placement.CountryCodes(),
 	})
 	if err != nil {
 		return nil, err
 	}
 
+	excludedNodes := req.ExcludedNodes
+	if preferences.DistinctIP {
+		// new nodes must not share a network with the reputable nodes either
+		excludedNodes = append([]storj.NodeID{}, req.ExcludedNodes...)
+		for _, node := range reputableNodes {
+			excludedNodes = append(excludedNodes, node.Id)
+		}
+	}
+
 	newNodeCount := int64(float64(reputableNodeCount) * preferences.NewNodePercentage)
 	newNodes, err := cache.db.SelectNewStorageNodes(ctx, int(newNodeCount), &NewNodeCriteria{
 		FreeBandwidth: req.FreeBandwidth,
@@ -258,9 +516,12 @@ func (cache *Cache) FindStorageNodesWithPreferences(ctx context.Context, req Fin
 
 		AuditThreshold: preferences.NewNodeAuditThreshold,
 
-		Excluded: req.ExcludedNodes,
+		Excluded: excludedNodes,
 
 		MinimumVersion: preferences.MinimumVersion,
+		DistinctIP:     preferences.DistinctIP,
+
+		CountryCodes: req.Placement.CountryCodes(),
 	})
 	if err != nil {
 		return nil, err
@@ -300,7 +561,84 @@ func (cache *Cache) Put(ctx context.Context, nodeID storj.NodeID, value pb.Node)
 	if nodeID != value.Id {
 		return errors.New("invalid request")
 	}
-	return cache.db.Update(ctx, &value)
+
+	// the address is only resolved when it changed since it was last resolved,
+	// otherwise the database keeps the previous network and country
+	var lastNet, countryCode string
+	resolved := false
+	if value.Address != nil && cache.needsResolve(nodeID, value.Address.Address) {
+		ip, err := resolveIP(ctx, value.Address.Address)
+		if err != nil {
+			cache.log.Debug("unable to resolve node network", zap.Stringer("node ID", nodeID), zap.Error(err))
+		} else {
+			lastNet = networkOf(ip)
+			countryCode = cache.geoip.CountryCode(ip)
+			resolved = true
+		}
+	}
+
+	err = cache.db.Update(ctx, &value, lastNet, countryCode, cache.preferences)
+	if err != nil {
+		return err
+	}
+	if resolved {
+		cache.resolvedMu.Lock()
+		cache.resolved[nodeID] = value.Address.Address
+		cache.resolvedMu.Unlock()
+	}
+	return nil
+}
+
+// needsResolve checks whether the network of the node has to be resolved from address.
+func (cache *Cache) needsResolve(nodeID storj.NodeID, address string) bool {
+	cache.resolvedMu.Lock()
+	defer cache.resolvedMu.Unlock()
+	previous, ok := cache.resolved[nodeID]
+	return !ok || previous != address
+}
+
+// GetNetwork resolves the host of address and returns the network it belongs to,
+// which is the /24 subnet for IPv4 and the /64 subnet for IPv6 addresses.
+func GetNetwork(ctx context.Context, address string) (_ string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	ip, err := resolveIP(ctx, address)
+	if err != nil {
+		return "", err
+	}
+	return networkOf(ip), nil
+}
+
+// resolveIP resolves the host of address to an IP address
+func resolveIP(ctx context.Context, address string) (_ net.IP, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		// address may not contain a port
+		host = address
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil {
+		addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+		if err != nil {
+			return nil, OverlayError.Wrap(err)
+		}
+		if len(addrs) == 0 {
+			return nil, OverlayError.New("no addresses found for %q", host)
+		}
+		ip = addrs[0].IP
+	}
+	return ip, nil
+}
+
+// networkOf returns the /24 subnet for IPv4 and the /64 subnet for IPv6 addresses
+func networkOf(ip net.IP) string {
+	if ipv4 := ip.To4(); ipv4 != nil {
+		return ipv4.Mask(net.CIDRMask(24, 32)).String()
+	}
+	return ip.Mask(net.CIDRMask(64, 128)).String()
 }
 
 // Create adds a new stats entry for node.
@@ -309,28 +647,131 @@ func (cache *Cache) Create(ctx context.Context, nodeID storj.NodeID, initial *No
 	return cache.db.CreateStats(ctx, nodeID, initial)
 }
 
-// FindInvalidNodes finds a subset of storagenodes that have stats below provided reputation requirements.
-func (cache *Cache) FindInvalidNodes(ctx context.Context, nodeIDs storj.NodeIDList, maxStats *NodeStats) (invalid storj.NodeIDList, err error) {
+// FindInvalidNodes finds a subset of storagenodes that are disqualified or have a reputation below the criteria.
+func (cache *Cache) FindInvalidNodes(ctx context.Context, nodeIDs storj.NodeIDList, criteria *NodeCriteria) (invalid storj.NodeIDList, err error) {
 	defer mon.Task()(&ctx)(&err)
-	return cache.db.FindInvalidNodes(ctx, nodeIDs, maxStats)
+	return cache.db.FindInvalidNodes(ctx, nodeIDs, criteria)
 }
 
 // UpdateStats all parts of single storagenode's stats.
 func (cache *Cache) UpdateStats(ctx context.Context, request *UpdateRequest) (stats *NodeStats, err error) {
 	defer mon.Task()(&ctx)(&err)
-	return cache.db.UpdateStats(ctx, request)
+
+	// the reputation parameters are filled into a copy, so the caller's request stays untouched
+	update := *request
+	update.AuditLambda = cache.preferences.AuditReputationLambda
+	update.AuditWeight = cache.preferences.AuditReputationWeight
+	update.AuditDQ = cache.preferences.AuditReputationDQ
+	update.UptimeLambda = cache.preferences.UptimeReputationLambda
+	update.UptimeWeight = cache.preferences.UptimeReputationWeight
+
+	return cache.db.UpdateStats(ctx, &update)
 }
 
 // UpdateNodeInfo updates node dossier with info requested from the node itself like node type, email, wallet, capacity, and version.
 func (cache *Cache) UpdateNodeInfo(ctx context.Context, node storj.NodeID, nodeInfo *pb.InfoResponse) (stats *NodeDossier, err error) {
 	defer mon.Task()(&ctx)(&err)
+
+	if cache.geoip != nil && nodeInfo.GetOperator().GetCountryCode() != "" {
+		// the country resolved from the address takes precedence over the declared one
+		info := *nodeInfo
+		operator := *nodeInfo.Operator
+		operator.CountryCode = ""
+		info.Operator = &operator
+		nodeInfo = &info
+	}
+
 	return cache.db.UpdateNodeInfo(ctx, node, nodeInfo)
 }
 
 // UpdateUptime updates a single storagenode's uptime stats.
 func (cache *Cache) UpdateUptime(ctx context.Context, nodeID storj.NodeID, isUp bool) (stats *NodeStats, err error) {
 	defer mon.Task()(&ctx)(&err)
-	return cache.db.UpdateUptime(ctx, nodeID, isUp)
+
+	stats, err = cache.db.UpdateUptime(ctx, nodeID, isUp, cache.preferences.UptimeReputationLambda, cache.preferences.UptimeReputationWeight)
+	if err != nil {
+		return stats, err
+	}
+
+	if stats.Returned {
+		for _, observer := range cache.returnObservers {
+			observer.NodeReturned(ctx, nodeID)
+		}
+	}
+	return stats, nil
+}
+
+// GetExitingNodes returns the exit status of nodes that have initiated a graceful exit, but have not finished it.
+func (cache *Cache) GetExitingNodes(ctx context.Context) (exiting []*ExitStatus, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return cache.db.GetExitingNodes(ctx)
+}
+
+// UpdateExitStatus updates the graceful exit status of a node.
+func (cache *Cache) UpdateExitStatus(ctx context.Context, request *ExitStatusRequest) (stats *NodeDossier, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return cache.db.UpdateExitStatus(ctx, request)
+}
+
+// UptimeHistory returns when the node was offline during the window ending now.
+func (cache *Cache) UptimeHistory(ctx context.Context, node *NodeDossier, window time.Duration) (_ *UptimeHistory, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	histories, err := cache.UptimeHistories(ctx, []*NodeDossier{node}, window)
+	if err != nil {
+		return nil, err
+	}
+	return histories[node.Id], nil
+}
+
+// UptimeHistories returns when the nodes were offline during the window ending now.
+func (cache *Cache) UptimeHistories(ctx context.Context, nodes []*NodeDossier, window time.Duration) (_ map[storj.NodeID]*UptimeHistory, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	until := time.Now().UTC()
+	since := until.Add(-window)
+
+	nodeIDs := make(storj.NodeIDList, 0, len(nodes))
+	for _, node := range nodes {
+		nodeIDs = append(nodeIDs, node.Id)
+	}
+
+	attempts, err := cache.db.GetContactAttempts(ctx, nodeIDs, since)
+	if err != nil {
+		return nil, err
+	}
+
+	histories := make(map[storj.NodeID]*UptimeHistory, len(nodes))
+	for _, node := range nodes {
+		nodeAttempts := attempts[node.Id]
+
+		// the attempts of nodes that stopped checking in long ago may already be deleted
+		lastSuccess := node.Reputation.LastContactSuccess
+		if !lastSuccess.IsZero() && lastSuccess.Before(since) && (len(nodeAttempts) == 0 || lastSuccess.Before(nodeAttempts[0].AttemptedAt)) {
+			nodeAttempts = append([]ContactAttempt{{AttemptedAt: lastSuccess, Success: true}}, nodeAttempts...)
+		}
+
+		histories[node.Id] = ComputeUptimeHistory(node.Id, nodeAttempts, since, until, OnlineWindow)
+	}
+	return histories, nil
+}
+
+// DeleteContactAttemptsBefore deletes the contact attempts older than before.
+func (cache *Cache) DeleteContactAttemptsBefore(ctx context.Context, before time.Time) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return cache.db.DeleteContactAttemptsBefore(ctx, before)
+}
+
+// UpdateSuspended suspends the node from new uploads since suspended, nil unsuspends the node.
+func (cache *Cache) UpdateSuspended(ctx context.Context, nodeID storj.NodeID, suspended *time.Time) (_ *NodeDossier, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return cache.db.UpdateSuspended(ctx, nodeID, suspended)
+}
+
+// DisqualifyNode disqualifies the node permanently.
+func (cache *Cache) DisqualifyNode(ctx context.Context, nodeID storj.NodeID) (_ *NodeDossier, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return cache.db.DisqualifyNode(ctx, nodeID)
 }
 
 // ConnFailure implements the Transport Observer `ConnFailure` function
@@ -341,7 +782,7 @@ func (cache *Cache) ConnFailure(ctx context.Context, node *pb.Node, failureError
 	// TODO: Kademlia paper specifies 5 unsuccessful PINGs before removing the node
 	// from our routing table, but this is the cache so maybe we want to treat
 	// it differently.
-	_, err = cache.db.UpdateUptime(ctx, node.Id, false)
+	_, err = cache.db.UpdateUptime(ctx, node.Id, false, cache.preferences.UptimeReputationLambda, cache.preferences.UptimeReputationWeight)
 	if err != nil {
 		zap.L().Debug("error updating uptime for node", zap.Error(err))
 	}
@@ -356,7 +797,7 @@ func (cache *Cache) ConnSuccess(ctx context.Context, node *pb.Node) {
 	if err != nil {
 		zap.L().Debug("error updating uptime for node", zap.Error(err))
 	}
-	_, err = cache.db.UpdateUptime(ctx, node.Id, true)
+	_, err = cache.UpdateUptime(ctx, node.Id, true)
 	if err != nil {
 		zap.L().Debug("error updating node connection info", zap.Error(err))
 	}
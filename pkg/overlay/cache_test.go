@@ -5,6 +5,7 @@ package overlay_test
 
 import (
 	"context"
+	"fmt"
 	"math/rand"
 	"testing"
 
@@ -100,6 +101,15 @@ func testCache(ctx context.Context, t *testing.T, store overlay.DB) {
 		// TODO: add erroring database test
 	}
 
+	{ // UpdateStats doesn't modify the request
+		request := &overlay.UpdateRequest{NodeID: valid1ID, IsUp: true, AuditSuccess: true}
+		expected := *request
+
+		_, err := cache.UpdateStats(ctx, request)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, *request)
+	}
+
 	{ // List
 		list, err := cache.List(ctx, storj.NodeID{}, 3)
 		assert.NoError(t, err)
@@ -151,9 +161,9 @@ func testRandomizedSelection(t *testing.T, reputable bool) {
 				Type:         pb.NodeType_STORAGE,
 				Restrictions: &pb.NodeRestrictions{},
 				Reputation:   &pb.NodeStats{},
-			})
+			}, "", "", overlay.NodeSelectionConfig{})
 			require.NoError(t, err)
-			_, err = cache.UpdateUptime(ctx, newID, true)
+			_, err = cache.UpdateUptime(ctx, newID, true, 1, 1)
 			require.NoError(t, err)
 			allIDs[i] = newID
 			nodeCounts[newID] = 0
@@ -204,3 +214,174 @@ func testRandomizedSelection(t *testing.T, reputable bool) {
 		}
 	})
 }
+
+func TestGetNetwork(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	for _, tt := range []struct {
+		address string
+		network string
+	}{
+		{"127.0.0.1:7777", "127.0.0.0"},
+		{"10.11.12.13:28967", "10.11.12.0"},
+		{"10.11.12.13", "10.11.12.0"},
+		{"[2001:db8:1:2:3:4:5:6]:7777", "2001:db8:1:2::"},
+	} {
+		network, err := overlay.GetNetwork(ctx, tt.address)
+		require.NoError(t, err, tt.address)
+		assert.Equal(t, tt.network, network, tt.address)
+	}
+}
+
+// countingDB counts the reads of nodes
+type countingDB struct {
+	overlay.DB
+	gets int
+}
+
+func (db *countingDB) Get(ctx context.Context, nodeID storj.NodeID) (*overlay.NodeDossier, error) {
+	db.gets++
+	return db.DB.Get(ctx, nodeID)
+}
+
+func TestPutResolvesNetwork(t *testing.T) {
+	t.Parallel()
+
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		store := &countingDB{DB: db.OverlayCache()}
+		cache := overlay.NewCache(zaptest.NewLogger(t), store, overlay.NodeSelectionConfig{})
+
+		id := storj.NodeID{1, 2, 3}
+		put := func(address string) {
+			require.NoError(t, cache.Put(ctx, id, pb.Node{
+				Id:      id,
+				Address: &pb.NodeAddress{Address: address},
+			}))
+		}
+
+		put("10.1.2.3:7777")
+		put("10.1.2.3:7777")
+		// putting nodes doesn't read them back
+		assert.Zero(t, store.gets)
+
+		node, err := cache.Get(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "10.1.2.0", node.LastNet)
+
+		put("10.4.5.6:7777")
+		node, err = cache.Get(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "10.4.5.0", node.LastNet)
+	})
+}
+
+func TestDistinctIPs(t *testing.T) {
+	t.Parallel()
+
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		store := db.OverlayCache()
+
+		// every network contains two nodes
+		var ids storj.NodeIDList
+		networks := map[storj.NodeID]string{}
+		for i := 0; i < 10; i++ {
+			id := storj.NodeID{}
+			_, _ = rand.Read(id[:])
+			network := fmt.Sprintf("10.0.%d.0", i/2)
+
+			err := store.Update(ctx, &pb.Node{
+				Id:           id,
+				Type:         pb.NodeType_STORAGE,
+				Address:      &pb.NodeAddress{Address: fmt.Sprintf("10.0.%d.%d:7777", i/2, i)},
+				Restrictions: &pb.NodeRestrictions{},
+				Reputation:   &pb.NodeStats{},
+			}, network, "", overlay.NodeSelectionConfig{})
+			require.NoError(t, err)
+			_, err = store.UpdateUptime(ctx, id, true, 1, 1)
+			require.NoError(t, err)
+
+			ids = append(ids, id)
+			networks[id] = network
+		}
+
+		nodes, err := store.SelectStorageNodes(ctx, 10, &overlay.NodeCriteria{DistinctIP: true})
+		require.NoError(t, err)
+		require.Len(t, nodes, 5)
+		requireDistinct(t, nodes, networks)
+
+		// nodes in the network of an excluded node are excluded
+		nodes, err = store.SelectNewStorageNodes(ctx, 10, &overlay.NewNodeCriteria{
+			AuditThreshold: 1,
+			Excluded:       ids[:1],
+			DistinctIP:     true,
+		})
+		require.NoError(t, err)
+		require.Len(t, nodes, 4)
+		requireDistinct(t, nodes, networks)
+		for _, node := range nodes {
+			require.NotEqual(t, networks[ids[0]], networks[node.Id])
+		}
+
+		// without the constraint all nodes can be selected
+		nodes, err = store.SelectStorageNodes(ctx, 10, &overlay.NodeCriteria{})
+		require.NoError(t, err)
+		require.Len(t, nodes, 10)
+
+		cache := overlay.NewCache(zaptest.NewLogger(t), store, overlay.NodeSelectionConfig{DistinctIP: true})
+		clumped, err := cache.ClumpedNodes(ctx, storj.NodeIDList{ids[0], ids[2], ids[1], ids[4], ids[3]})
+		require.NoError(t, err)
+		require.Equal(t, []int{2, 4}, clumped)
+
+		// nodes with an unknown network are not grouped together
+		var unknown storj.NodeIDList
+		for i := 0; i < 3; i++ {
+			id := storj.NodeID{}
+			_, _ = rand.Read(id[:])
+
+			err := store.Update(ctx, &pb.Node{
+				Id:           id,
+				Type:         pb.NodeType_STORAGE,
+				Address:      &pb.NodeAddress{Address: fmt.Sprintf("unknown%d.example.test:7777", i)},
+				Restrictions: &pb.NodeRestrictions{},
+				Reputation:   &pb.NodeStats{},
+			}, "", "", overlay.NodeSelectionConfig{})
+			require.NoError(t, err)
+			_, err = store.UpdateUptime(ctx, id, true, 1, 1)
+			require.NoError(t, err)
+
+			unknown = append(unknown, id)
+		}
+
+		nodes, err = store.SelectStorageNodes(ctx, 20, &overlay.NodeCriteria{DistinctIP: true})
+		require.NoError(t, err)
+		require.Len(t, nodes, 8)
+		requireDistinct(t, nodes, networks)
+
+		nodes, err = store.SelectNewStorageNodes(ctx, 20, &overlay.NewNodeCriteria{
+			AuditThreshold: 1,
+			Excluded:       unknown[:1],
+			DistinctIP:     true,
+		})
+		require.NoError(t, err)
+		require.Len(t, nodes, 7)
+	})
+}
+
+func requireDistinct(t *testing.T, nodes []*pb.Node, networks map[storj.NodeID]string) {
+	seen := map[string]bool{}
+	for _, node := range nodes {
+		network := networks[node.Id]
+		if network == "" {
+			continue
+		}
+		require.False(t, seen[network], "network %s selected twice", network)
+		seen[network] = true
+	}
+}
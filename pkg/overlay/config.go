@@ -5,6 +5,7 @@ package overlay
 
 import (
 	"strings"
+	"time"
 
 	"github.com/zeebo/errs"
 	monkit "gopkg.in/spacemonkeygo/monkit.v2"
@@ -22,6 +23,8 @@ var (
 // Overlay cache responsibility.
 type Config struct {
 	Node NodeSelectionConfig
+
+	GeoIPDatabase string `help:"path to a file with network,country_code lines used to resolve the country of nodes, when empty the country declared by the operator is used" default:""`
 }
 
 // LookupConfig is a configuration struct for querying the overlay cache with one or more node IDs
@@ -33,15 +36,30 @@ type LookupConfig struct {
 // NodeSelectionConfig is a configuration struct to determine the minimum
 // values for nodes to select
 type NodeSelectionConfig struct {
-	UptimeRatio       float64 `help:"a node's ratio of being up/online vs. down/offline" default:"0"`
-	UptimeCount       int64   `help:"the number of times a node's uptime has been checked" default:"0"`
-	AuditSuccessRatio float64 `help:"a node's ratio of successful audits" default:"0"`
-	AuditCount        int64   `help:"the number of times a node has been audited" default:"0"`
+	UptimeCount int64 `help:"the number of times a node's uptime has been checked" default:"0"`
+	AuditCount  int64 `help:"the number of times a node has been audited" default:"0"`
+
+	MinimumUptimeReputation float64 `help:"the minimum uptime reputation score of a node, between 0 and 1" default:"0"`
+	MinimumAuditReputation  float64 `help:"the minimum audit reputation score of a node, between 0 and 1" default:"0"`
+
+	AuditReputationAlpha0 float64 `help:"the initial alpha value of the audit reputation of a new node" default:"20"`
+	AuditReputationBeta0  float64 `help:"the initial beta value of the audit reputation of a new node" default:"0"`
+	AuditReputationLambda float64 `help:"the forgetting factor applied to the audit reputation on each audit" default:"0.95"`
+	AuditReputationWeight float64 `help:"the normalization weight of a single audit result" default:"1"`
+	AuditReputationDQ     float64 `help:"the audit reputation score below which a node is disqualified" default:"0.6"`
+
+	UptimeReputationAlpha0 float64 `help:"the initial alpha value of the uptime reputation of a new node" default:"100"`
+	UptimeReputationBeta0  float64 `help:"the initial beta value of the uptime reputation of a new node" default:"0"`
+	UptimeReputationLambda float64 `help:"the forgetting factor applied to the uptime reputation on each uptime check" default:"0.99"`
+	UptimeReputationWeight float64 `help:"the normalization weight of a single uptime check result" default:"1"`
 
 	NewNodeAuditThreshold int64   `help:"the number of audits a node must have to not be considered a New Node" default:"0"`
 	NewNodePercentage     float64 `help:"the percentage of new nodes allowed per request" default:"0.05"` // TODO: fix, this is not percentage, it's ratio
 
 	MinimumVersion string `help:"the minimum node software version for node selection queries" default:""`
+	DistinctIP     bool   `help:"require distinct networks (/24 for IPv4, /64 for IPv6) when choosing nodes for upload and repair" default:"true" devDefault:"false"`
+
+	SelectionCacheStaleness time.Duration `help:"how long the in-memory snapshot of nodes used for uploads is kept before reloading it, 0 disables the cache" default:"3m0s" devDefault:"0s"`
 }
 
 // ParseIDs converts the base58check encoded node ID strings from the config into node IDs
@@ -0,0 +1,120 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay
+
+import (
+	"time"
+
+	"storj.io/storj/pkg/storj"
+)
+
+// ContactAttempt is a single attempt of the satellite to contact a node.
+type ContactAttempt struct {
+	AttemptedAt time.Time
+	Success     bool
+}
+
+// DowntimeWindow is a period during which a node could not be contacted.
+type DowntimeWindow struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Duration returns the length of the window.
+func (window DowntimeWindow) Duration() time.Duration {
+	return window.End.Sub(window.Start)
+}
+
+// UptimeHistory describes when a node was offline between Since and Until.
+type UptimeHistory struct {
+	NodeID storj.NodeID
+	Since  time.Time
+	Until  time.Time
+
+	Attempts       int64
+	FailedAttempts int64
+
+	Windows  []DowntimeWindow
+	Downtime time.Duration
+}
+
+// ComputeUptimeHistory computes the downtime windows between since and until from the
+// contact attempts, which must be sorted by the time of the attempt.
+//
+// A node is considered offline from a failed attempt until the next successful one.
+// A node that isn't contacted successfully within the online window is considered
+// offline from the end of the online window, so nodes that stop checking in accumulate
+// downtime even without failed attempts. A zero online window disables this.
+// The last attempt before since determines whether the node was offline at the start.
+func ComputeUptimeHistory(nodeID storj.NodeID, attempts []ContactAttempt, since, until time.Time, onlineWindow time.Duration) *UptimeHistory {
+	history := &UptimeHistory{
+		NodeID: nodeID,
+		Since:  since,
+		Until:  until,
+	}
+
+	var offline, lastSuccess *time.Time
+	missedCheckIn := func(at time.Time) {
+		if offline != nil || lastSuccess == nil || onlineWindow <= 0 {
+			return
+		}
+		deadline := lastSuccess.Add(onlineWindow)
+		if !deadline.Before(at) {
+			return
+		}
+		if deadline.Before(since) {
+			deadline = since
+		}
+		offline = &deadline
+	}
+
+	for _, attempt := range attempts {
+		if attempt.AttemptedAt.After(until) {
+			break
+		}
+
+		if attempt.AttemptedAt.Before(since) {
+			offline = nil
+			if !attempt.Success {
+				offline = &since
+				continue
+			}
+			attemptedAt := attempt.AttemptedAt
+			lastSuccess = &attemptedAt
+			continue
+		}
+
+		missedCheckIn(attempt.AttemptedAt)
+
+		history.Attempts++
+		if attempt.Success {
+			if offline != nil {
+				history.addWindow(*offline, attempt.AttemptedAt)
+				offline = nil
+			}
+			attemptedAt := attempt.AttemptedAt
+			lastSuccess = &attemptedAt
+			continue
+		}
+
+		history.FailedAttempts++
+		if offline == nil {
+			attemptedAt := attempt.AttemptedAt
+			offline = &attemptedAt
+		}
+	}
+
+	missedCheckIn(until)
+	if offline != nil {
+		history.addWindow(*offline, until)
+	}
+	return history
+}
+
+// addWindow adds a downtime window to the history.
+func (history *UptimeHistory) addWindow(start, end time.Time) {
+	window := DowntimeWindow{Start: start, End: end}
+	history.Windows = append(history.Windows, window)
+	history.Downtime += window.Duration()
+}
@@ -0,0 +1,192 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestComputeUptimeHistory(t *testing.T) {
+	nodeID := storj.NodeID{1}
+	since := time.Date(2019, 3, 1, 0, 0, 0, 0, time.UTC)
+	until := since.Add(24 * time.Hour)
+	at := func(hours int) time.Time { return since.Add(time.Duration(hours) * time.Hour) }
+
+	for _, tt := range []struct {
+		name         string
+		attempts     []overlay.ContactAttempt
+		onlineWindow time.Duration
+		windows      []overlay.DowntimeWindow
+		failed       int64
+	}{
+		{
+			name: "no attempts",
+		},
+		{
+			name: "always online",
+			attempts: []overlay.ContactAttempt{
+				{AttemptedAt: at(-1), Success: true},
+				{AttemptedAt: at(2), Success: true},
+			},
+		},
+		{
+			name: "offline until a successful contact",
+			attempts: []overlay.ContactAttempt{
+				{AttemptedAt: at(1), Success: true},
+				{AttemptedAt: at(2), Success: false},
+				{AttemptedAt: at(3), Success: false},
+				{AttemptedAt: at(5), Success: true},
+				{AttemptedAt: at(6), Success: false},
+				{AttemptedAt: at(8), Success: true},
+			},
+			windows: []overlay.DowntimeWindow{
+				{Start: at(2), End: at(5)},
+				{Start: at(6), End: at(8)},
+			},
+			failed: 3,
+		},
+		{
+			name: "offline before the window",
+			attempts: []overlay.ContactAttempt{
+				{AttemptedAt: at(-2), Success: false},
+				{AttemptedAt: at(4), Success: true},
+			},
+			windows: []overlay.DowntimeWindow{
+				{Start: since, End: at(4)},
+			},
+		},
+		{
+			name: "still offline",
+			attempts: []overlay.ContactAttempt{
+				{AttemptedAt: at(20), Success: false},
+				{AttemptedAt: at(30), Success: true},
+			},
+			windows: []overlay.DowntimeWindow{
+				{Start: at(20), End: until},
+			},
+			failed: 1,
+		},
+		{
+			name: "missed check-ins between successful contacts",
+			attempts: []overlay.ContactAttempt{
+				{AttemptedAt: at(1), Success: true},
+				{AttemptedAt: at(2), Success: true},
+				{AttemptedAt: at(6), Success: true},
+				{AttemptedAt: at(7), Success: false},
+				{AttemptedAt: at(8), Success: true},
+				{AttemptedAt: at(23), Success: true},
+			},
+			onlineWindow: 2 * time.Hour,
+			windows: []overlay.DowntimeWindow{
+				{Start: at(4), End: at(6)},
+				{Start: at(7), End: at(8)},
+				{Start: at(10), End: at(23)},
+			},
+			failed: 1,
+		},
+		{
+			name: "stopped checking in before the window",
+			attempts: []overlay.ContactAttempt{
+				{AttemptedAt: at(-5), Success: true},
+			},
+			onlineWindow: 2 * time.Hour,
+			windows: []overlay.DowntimeWindow{
+				{Start: since, End: until},
+			},
+		},
+		{
+			name: "stopped checking in",
+			attempts: []overlay.ContactAttempt{
+				{AttemptedAt: at(-1), Success: true},
+				{AttemptedAt: at(1), Success: true},
+			},
+			onlineWindow: 2 * time.Hour,
+			windows: []overlay.DowntimeWindow{
+				{Start: at(3), End: until},
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			history := overlay.ComputeUptimeHistory(nodeID, tt.attempts, since, until, tt.onlineWindow)
+
+			assert.Equal(t, tt.windows, history.Windows)
+			assert.Equal(t, tt.failed, history.FailedAttempts)
+
+			var downtime time.Duration
+			for _, window := range tt.windows {
+				downtime += window.Duration()
+			}
+			assert.Equal(t, downtime, history.Downtime)
+		})
+	}
+}
+
+func TestContactAttempts(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		cache := db.OverlayCache()
+		nodeID, otherID := storj.NodeID{1, 2, 3}, storj.NodeID{4, 5, 6}
+
+		err := cache.Update(ctx, &pb.Node{Id: nodeID}, "", "", overlay.NodeSelectionConfig{})
+		require.NoError(t, err)
+		err = cache.Update(ctx, &pb.Node{Id: otherID}, "", "", overlay.NodeSelectionConfig{})
+		require.NoError(t, err)
+
+		start := time.Now().UTC()
+		for _, isUp := range []bool{true, false, false, true} {
+			_, err = cache.UpdateUptime(ctx, nodeID, isUp, 1, 1)
+			require.NoError(t, err)
+		}
+		_, err = cache.UpdateUptime(ctx, otherID, false, 1, 1)
+		require.NoError(t, err)
+
+		nodeIDs := storj.NodeIDList{nodeID, otherID}
+		attempts, err := cache.GetContactAttempts(ctx, nodeIDs, start.Add(-time.Hour))
+		require.NoError(t, err)
+		require.Len(t, attempts[nodeID], 4)
+		for i, isUp := range []bool{true, false, false, true} {
+			assert.Equal(t, isUp, attempts[nodeID][i].Success)
+		}
+		require.Len(t, attempts[otherID], 1)
+		assert.False(t, attempts[otherID][0].Success)
+
+		// the last attempt before since is included
+		attempts, err = cache.GetContactAttempts(ctx, nodeIDs, time.Now().Add(time.Hour))
+		require.NoError(t, err)
+		require.Len(t, attempts[nodeID], 1)
+		assert.True(t, attempts[nodeID][0].Success)
+		require.Len(t, attempts[otherID], 1)
+		assert.False(t, attempts[otherID][0].Success)
+
+		suspended := time.Now().UTC()
+		node, err := cache.UpdateSuspended(ctx, nodeID, &suspended)
+		require.NoError(t, err)
+		assert.True(t, node.IsSuspended())
+
+		node, err = cache.UpdateSuspended(ctx, nodeID, nil)
+		require.NoError(t, err)
+		assert.False(t, node.IsSuspended())
+
+		deleted, err := cache.DeleteContactAttemptsBefore(ctx, time.Now().Add(time.Hour))
+		require.NoError(t, err)
+		assert.EqualValues(t, 5, deleted)
+
+		attempts, err = cache.GetContactAttempts(ctx, nodeIDs, start.Add(-time.Hour))
+		require.NoError(t, err)
+		assert.Empty(t, attempts)
+	})
+}
@@ -0,0 +1,118 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay
+
+import (
+	"bufio"
+	"io"
+	"net"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/zeebo/errs"
+)
+
+// GeoIPError is the error class for loading GeoIP databases
+var GeoIPError = errs.Class("geoip error")
+
+// GeoIP maps IP addresses to the country they are located in.
+//
+// The database is a text file with one `network,country_code` entry per line,
+// where network is in CIDR notation, e.g. `185.0.0.0/16,DE`. Empty lines and
+// lines starting with `#` are ignored. When networks overlap, the most
+// specific network is used.
+type GeoIP struct {
+	// prefixes contains the distinct prefix lengths, longest first
+	prefixes []int
+	// countries maps the prefix length and masked network to the country code
+	countries map[int]map[string]string
+}
+
+// LoadGeoIP loads a GeoIP database from the specified file
+func LoadGeoIP(path string) (_ *GeoIP, err error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, GeoIPError.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, GeoIPError.Wrap(file.Close())) }()
+
+	return ParseGeoIP(file)
+}
+
+// ParseGeoIP parses a GeoIP database from r
+func ParseGeoIP(r io.Reader) (*GeoIP, error) {
+	geoip := &GeoIP{
+		countries: make(map[int]map[string]string),
+	}
+
+	scanner := bufio.NewScanner(r)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Split(line, ",")
+		if len(fields) != 2 {
+			return nil, GeoIPError.New("line %d: expected network,country_code", lineNumber)
+		}
+
+		_, network, err := net.ParseCIDR(strings.TrimSpace(fields[0]))
+		if err != nil {
+			return nil, GeoIPError.New("line %d: %v", lineNumber, err)
+		}
+
+		countryCode := strings.ToUpper(strings.TrimSpace(fields[1]))
+		if !isCountryCode(countryCode) {
+			return nil, GeoIPError.New("line %d: invalid country code %q", lineNumber, countryCode)
+		}
+
+		geoip.add(network, countryCode)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, GeoIPError.Wrap(err)
+	}
+
+	return geoip, nil
+}
+
+// add adds the network to the lookup tables
+func (geoip *GeoIP) add(network *net.IPNet, countryCode string) {
+	ones, bits := network.Mask.Size()
+	if bits == 32 {
+		// store IPv4 networks in their 16 byte form, so that lookups don't depend on the representation
+		ones += 96
+	}
+
+	networks, ok := geoip.countries[ones]
+	if !ok {
+		networks = make(map[string]string)
+		geoip.countries[ones] = networks
+		geoip.prefixes = append(geoip.prefixes, ones)
+		sort.Sort(sort.Reverse(sort.IntSlice(geoip.prefixes)))
+	}
+	networks[string(network.IP.To16())] = countryCode
+}
+
+// CountryCode returns the country code of the ip, or an empty string when it is unknown
+func (geoip *GeoIP) CountryCode(ip net.IP) string {
+	if geoip == nil {
+		return ""
+	}
+	ip = ip.To16()
+	if ip == nil {
+		return ""
+	}
+
+	for _, ones := range geoip.prefixes {
+		network := ip.Mask(net.CIDRMask(ones, 128))
+		if countryCode, ok := geoip.countries[ones][string(network)]; ok {
+			return countryCode
+		}
+	}
+	return ""
+}
@@ -0,0 +1,55 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay_test
+
+import (
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/pkg/overlay"
+)
+
+func TestGeoIP(t *testing.T) {
+	geoip, err := overlay.ParseGeoIP(strings.NewReader(`
+		# network,country_code
+		10.0.0.0/8,US
+		10.1.0.0/16,de
+		10.1.2.0/24,FR
+
+		2001:db8::/32,NL
+	`))
+	require.NoError(t, err)
+
+	for _, tt := range []struct {
+		ip      string
+		country string
+	}{
+		{"10.0.0.1", "US"},
+		{"10.1.0.1", "DE"},
+		{"10.1.2.3", "FR"},
+		{"10.1.3.3", "DE"},
+		{"11.0.0.1", ""},
+		{"2001:db8::1", "NL"},
+		{"2001:db9::1", ""},
+		{"::ffff:10.1.2.3", "FR"},
+	} {
+		assert.Equal(t, tt.country, geoip.CountryCode(net.ParseIP(tt.ip)), tt.ip)
+	}
+
+	var missing *overlay.GeoIP
+	assert.Equal(t, "", missing.CountryCode(net.ParseIP("10.0.0.1")))
+
+	for _, invalid := range []string{
+		"10.0.0.0/8",
+		"10.0.0.0/8,USA",
+		"10.0.0.0,US",
+	} {
+		_, err := overlay.ParseGeoIP(strings.NewReader(invalid))
+		assert.True(t, overlay.GeoIPError.Has(err), invalid)
+	}
+}
@@ -6,6 +6,7 @@ package overlay
 import (
 	"context"
 
+	"github.com/golang/protobuf/ptypes"
 	"github.com/zeebo/errs"
 
 	"storj.io/storj/pkg/pb"
@@ -50,6 +51,11 @@ func (srv *Inspector) GetStats(ctx context.Context, req *pb.GetStatsRequest) (*p
 		AuditRatio:  node.GetReputation().GetAuditSuccessRatio(),
 		UptimeCount: node.GetReputation().GetUptimeCount(),
 		UptimeRatio: node.GetReputation().GetUptimeRatio(),
+
+		AuditReputationAlpha:  node.Reputation.AuditReputationAlpha,
+		AuditReputationBeta:   node.Reputation.AuditReputationBeta,
+		UptimeReputationAlpha: node.Reputation.UptimeReputationAlpha,
+		UptimeReputationBeta:  node.Reputation.UptimeReputationBeta,
 	}, nil
 }
 
@@ -69,3 +75,61 @@ func (srv *Inspector) CreateStats(ctx context.Context, req *pb.CreateStatsReques
 
 	return &pb.CreateStatsResponse{}, nil
 }
+
+// GetUptimeHistory returns when a node was offline within the window
+func (srv *Inspector) GetUptimeHistory(ctx context.Context, req *pb.GetUptimeHistoryRequest) (_ *pb.GetUptimeHistoryResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	window, err := ptypes.Duration(req.Window)
+	if err != nil {
+		return nil, OverlayError.Wrap(err)
+	}
+
+	node, err := srv.cache.Get(ctx, req.NodeId)
+	if err != nil {
+		return nil, err
+	}
+
+	history, err := srv.cache.UptimeHistory(ctx, node, window)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.GetUptimeHistoryResponse{
+		Attempts:       history.Attempts,
+		FailedAttempts: history.FailedAttempts,
+		Downtime:       ptypes.DurationProto(history.Downtime),
+	}
+	if response.Since, err = ptypes.TimestampProto(history.Since); err != nil {
+		return nil, OverlayError.Wrap(err)
+	}
+	if response.Until, err = ptypes.TimestampProto(history.Until); err != nil {
+		return nil, OverlayError.Wrap(err)
+	}
+	if node.Suspended != nil {
+		if response.Suspended, err = ptypes.TimestampProto(*node.Suspended); err != nil {
+			return nil, OverlayError.Wrap(err)
+		}
+	}
+	if node.Reputation.Disqualified != nil {
+		if response.Disqualified, err = ptypes.TimestampProto(*node.Reputation.Disqualified); err != nil {
+			return nil, OverlayError.Wrap(err)
+		}
+	}
+
+	for _, window := range history.Windows {
+		start, err := ptypes.TimestampProto(window.Start)
+		if err != nil {
+			return nil, OverlayError.Wrap(err)
+		}
+		end, err := ptypes.TimestampProto(window.End)
+		if err != nil {
+			return nil, OverlayError.Wrap(err)
+		}
+		response.Windows = append(response.Windows, &pb.GetUptimeHistoryResponse_DowntimeWindow{
+			Start: start,
+			End:   end,
+		})
+	}
+	return response, nil
+}
@@ -0,0 +1,117 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay
+
+import (
+	"context"
+	"strings"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/storj"
+)
+
+// ErrInvalidPlacement is returned when a placement constraint is not recognized
+var ErrInvalidPlacement = errs.Class("invalid placement")
+
+// BucketPlacements is the store for the placement constraints of buckets
+type BucketPlacements interface {
+	// SetBucketPlacement sets the placement constraint of a bucket, an empty placement removes the constraint
+	SetBucketPlacement(ctx context.Context, projectID uuid.UUID, bucket []byte, placement Placement) error
+	// GetBucketPlacement returns the placement constraint of a bucket, an empty placement when it has none
+	GetBucketPlacement(ctx context.Context, projectID uuid.UUID, bucket []byte) (Placement, error)
+}
+
+// Placement restricts the countries where the pieces of a segment may be stored.
+//
+// The empty placement allows any node, a region name (e.g. "EU") allows the
+// countries of that region and otherwise the placement is a single
+// ISO 3166-1 alpha-2 country code.
+type Placement string
+
+// PlacementEU allows only nodes located in the member states of the European Union
+const PlacementEU Placement = "EU"
+
+// regions contains the country codes for each of the known region placements
+var regions = map[Placement][]string{
+	PlacementEU: {
+		"AT", "BE", "BG", "CY", "CZ", "DE", "DK", "EE", "ES", "FI",
+		"FR", "GR", "HR", "HU", "IE", "IT", "LT", "LU", "LV", "MT",
+		"NL", "PL", "PT", "RO", "SE", "SI", "SK",
+	},
+}
+
+// ParsePlacement parses and validates a placement constraint
+func ParsePlacement(s string) (Placement, error) {
+	placement := Placement(strings.ToUpper(strings.TrimSpace(s)))
+	if err := placement.Validate(); err != nil {
+		return "", err
+	}
+	return placement, nil
+}
+
+// Validate checks whether the placement is a known region or a well-formed country code
+func (placement Placement) Validate() error {
+	if placement == "" {
+		return nil
+	}
+	if _, ok := regions[placement]; ok {
+		return nil
+	}
+	if !isCountryCode(string(placement)) {
+		return ErrInvalidPlacement.New("%q", string(placement))
+	}
+	return nil
+}
+
+// CountryCodes returns the country codes allowed by the placement, nil means any country
+func (placement Placement) CountryCodes() []string {
+	if placement == "" {
+		return nil
+	}
+	if codes, ok := regions[placement]; ok {
+		return codes
+	}
+	return []string{string(placement)}
+}
+
+// Allows checks whether a node in the specified country may be used for the placement
+func (placement Placement) Allows(countryCode string) bool {
+	if placement == "" {
+		return true
+	}
+	for _, code := range placement.CountryCodes() {
+		if code == countryCode {
+			return true
+		}
+	}
+	return false
+}
+
+// isCountryCode checks whether s looks like an upper case ISO 3166-1 alpha-2 code
+func isCountryCode(s string) bool {
+	if len(s) != 2 {
+		return false
+	}
+	for _, r := range s {
+		if r < 'A' || r > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+// SplitBucketPath returns the project ID and the bucket name of a segment path
+func SplitBucketPath(path storj.Path) (projectID uuid.UUID, bucket []byte, err error) {
+	comps := storj.SplitPath(path)
+	if len(comps) < 3 {
+		return projectID, nil, Error.New("no bucket component in path: %s", path)
+	}
+	id, err := uuid.Parse(comps[0])
+	if err != nil {
+		return projectID, nil, Error.New("invalid project id in path: %s", path)
+	}
+	return *id, []byte(comps[2]), nil
+}
@@ -0,0 +1,46 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/pkg/overlay"
+)
+
+func TestPlacement(t *testing.T) {
+	for _, tt := range []struct {
+		input     string
+		placement overlay.Placement
+		allowed   []string
+		denied    []string
+	}{
+		{input: "", placement: "", allowed: []string{"", "DE", "US"}},
+		{input: "eu", placement: overlay.PlacementEU, allowed: []string{"DE", "FR", "NL"}, denied: []string{"", "US", "CH", "GB"}},
+		{input: " us ", placement: "US", allowed: []string{"US"}, denied: []string{"", "DE", "CA"}},
+	} {
+		placement, err := overlay.ParsePlacement(tt.input)
+		require.NoError(t, err, tt.input)
+		assert.Equal(t, tt.placement, placement)
+
+		for _, code := range tt.allowed {
+			assert.True(t, placement.Allows(code), "%q should allow %q", placement, code)
+		}
+		for _, code := range tt.denied {
+			assert.False(t, placement.Allows(code), "%q should deny %q", placement, code)
+		}
+	}
+
+	for _, input := range []string{"mars", "U", "U1", "EUR"} {
+		_, err := overlay.ParsePlacement(input)
+		assert.True(t, overlay.ErrInvalidPlacement.Has(err), input)
+	}
+
+	assert.Nil(t, overlay.Placement("").CountryCodes())
+	assert.Equal(t, []string{"US"}, overlay.Placement("US").CountryCodes())
+	assert.Len(t, overlay.PlacementEU.CountryCodes(), 27)
+}
@@ -0,0 +1,280 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/sync2"
+	"storj.io/storj/internal/version"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+)
+
+// SelectedNode is a storage node that is eligible for uploads
+type SelectedNode struct {
+	Node        *pb.Node
+	LastNet     string
+	CountryCode string
+	Version     version.SemVer
+	Release     bool
+}
+
+// NodeSelectionCache keeps an in-memory snapshot of the storage nodes that are eligible for uploads,
+// separated into reputable and new nodes. The snapshot is reloaded from the database in the background
+// every staleness interval, uploads keep using the previous snapshot while it's being reloaded.
+type NodeSelectionCache struct {
+	log         *zap.Logger
+	db          DB
+	preferences NodeSelectionConfig
+	staleness   time.Duration
+
+	// refreshMu ensures that only a single reload queries the database at a time
+	refreshMu sync.Mutex
+
+	mu    sync.RWMutex
+	state *selectionState
+
+	Loop sync2.Cycle
+}
+
+// selectionState is a single snapshot of the eligible nodes
+type selectionState struct {
+	created   time.Time
+	reputable []*SelectedNode
+	new       []*SelectedNode
+	networks  map[storj.NodeID]string
+}
+
+// NewNodeSelectionCache creates a new node selection cache which is refreshed after staleness has passed
+func NewNodeSelectionCache(log *zap.Logger, db DB, preferences NodeSelectionConfig, staleness time.Duration) *NodeSelectionCache {
+	return &NodeSelectionCache{
+		log:         log,
+		db:          db,
+		preferences: preferences,
+		staleness:   staleness,
+		Loop:        *sync2.NewCycle(staleness),
+	}
+}
+
+// Run periodically reloads the snapshot of eligible nodes
+func (cache *NodeSelectionCache) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return cache.Loop.Run(ctx, func(ctx context.Context) error {
+		err := cache.Refresh(ctx)
+		if err != nil {
+			cache.log.Error("unable to refresh node selection cache", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Close halts the refresh loop
+func (cache *NodeSelectionCache) Close() error {
+	cache.Loop.Close()
+	return nil
+}
+
+// Refresh reloads the snapshot of eligible nodes from the database
+func (cache *NodeSelectionCache) Refresh(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	cache.refreshMu.Lock()
+	defer cache.refreshMu.Unlock()
+
+	_, err = cache.refresh(ctx)
+	return err
+}
+
+// refresh reloads the snapshot, the caller must hold refreshMu
+func (cache *NodeSelectionCache) refresh(ctx context.Context) (_ *selectionState, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	reputable, new, err := cache.db.SelectAllStorageNodesUpload(ctx, cache.preferences)
+	if err != nil {
+		return nil, err
+	}
+
+	state := &selectionState{
+		created:   time.Now(),
+		reputable: reputable,
+		new:       new,
+		networks:  make(map[storj.NodeID]string, len(reputable)+len(new)),
+	}
+	for _, node := range reputable {
+		state.networks[node.Node.Id] = node.LastNet
+	}
+	for _, node := range new {
+		state.networks[node.Node.Id] = node.LastNet
+	}
+
+	mon.IntVal("selection_cache_reputable_nodes").Observe(int64(len(reputable)))
+	mon.IntVal("selection_cache_new_nodes").Observe(int64(len(new)))
+
+	cache.mu.Lock()
+	cache.state = state
+	cache.mu.Unlock()
+	return state, nil
+}
+
+// current returns the current snapshot, it is only loaded on the request path
+// when there is no snapshot yet, afterwards Run keeps it up to date.
+func (cache *NodeSelectionCache) current(ctx context.Context) (_ *selectionState, err error) {
+	if state := cache.loaded(); state != nil {
+		return state, nil
+	}
+
+	cache.refreshMu.Lock()
+	defer cache.refreshMu.Unlock()
+
+	// another request may have loaded the snapshot while we were waiting
+	if state := cache.loaded(); state != nil {
+		return state, nil
+	}
+	return cache.refresh(ctx)
+}
+
+// loaded returns the current snapshot or nil, when it hasn't been loaded yet
+func (cache *NodeSelectionCache) loaded() *selectionState {
+	cache.mu.RLock()
+	defer cache.mu.RUnlock()
+	return cache.state
+}
+
+// GetNodes selects random nodes for an upload from the snapshot
+func (cache *NodeSelectionCache) GetNodes(ctx context.Context, req FindStorageNodesRequest) (_ []*pb.Node, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	state, err := cache.current(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	reputableNodeCount := req.MinimumRequiredNodes
+	if reputableNodeCount <= 0 {
+		reputableNodeCount = req.RequestedCount
+	}
+	newNodeCount := int(float64(reputableNodeCount) * cache.preferences.NewNodePercentage)
+
+	var minimumVersion *version.SemVer
+	if cache.preferences.MinimumVersion != "" {
+		minimumVersion, err = version.NewSemVer(cache.preferences.MinimumVersion)
+		if err != nil {
+			return nil, Error.New("invalid node selection criteria version: %v", err)
+		}
+	}
+
+	excluded := make(map[storj.NodeID]struct{}, len(req.ExcludedNodes))
+	usedNetworks := make(map[string]struct{})
+	for _, id := range req.ExcludedNodes {
+		excluded[id] = struct{}{}
+		if lastNet := state.networks[id]; cache.preferences.DistinctIP && lastNet != "" {
+			usedNetworks[lastNet] = struct{}{}
+		}
+	}
+
+	selector := &nodeSelector{
+		req:            req,
+		minimumVersion: minimumVersion,
+		distinctIP:     cache.preferences.DistinctIP,
+		excluded:       excluded,
+		usedNetworks:   usedNetworks,
+	}
+
+	reputableNodes := selector.pick(state.reputable, reputableNodeCount)
+	newNodes := selector.pick(state.new, newNodeCount)
+
+	nodes := []*pb.Node{}
+	nodes = append(nodes, newNodes...)
+	nodes = append(nodes, reputableNodes...)
+
+	if len(reputableNodes) < reputableNodeCount {
+		return nodes, ErrNotEnoughNodes.New("requested %d found %d", reputableNodeCount, len(reputableNodes))
+	}
+
+	return nodes, nil
+}
+
+// nodeSelector keeps track of the nodes and networks already used by a single selection
+type nodeSelector struct {
+	req            FindStorageNodesRequest
+	minimumVersion *version.SemVer
+	distinctIP     bool
+
+	excluded     map[storj.NodeID]struct{}
+	usedNetworks map[string]struct{}
+}
+
+// pick selects up to count random nodes from candidates that satisfy the request
+func (selector *nodeSelector) pick(candidates []*SelectedNode, count int) []*pb.Node {
+	var nodes []*pb.Node
+	if count <= 0 {
+		return nodes
+	}
+
+	for _, i := range rand.Perm(len(candidates)) {
+		candidate := candidates[i]
+		if !selector.eligible(candidate) {
+			continue
+		}
+
+		selector.excluded[candidate.Node.Id] = struct{}{}
+		if selector.distinctIP && candidate.LastNet != "" {
+			selector.usedNetworks[candidate.LastNet] = struct{}{}
+		}
+
+		node := *candidate.Node
+		nodes = append(nodes, &node)
+		if len(nodes) >= count {
+			break
+		}
+	}
+	return nodes
+}
+
+// eligible checks whether the candidate can be used for the request
+func (selector *nodeSelector) eligible(candidate *SelectedNode) bool {
+	if _, ok := selector.excluded[candidate.Node.Id]; ok {
+		return false
+	}
+	if selector.distinctIP && candidate.LastNet != "" {
+		if _, ok := selector.usedNetworks[candidate.LastNet]; ok {
+			return false
+		}
+	}
+
+	if !selector.req.Placement.Allows(candidate.CountryCode) {
+		return false
+	}
+
+	restrictions := candidate.Node.GetRestrictions()
+	if restrictions.GetFreeDisk() < selector.req.FreeDisk || restrictions.GetFreeBandwidth() < selector.req.FreeBandwidth {
+		return false
+	}
+
+	if selector.minimumVersion != nil {
+		if !candidate.Release || !versionAtLeast(candidate.Version, *selector.minimumVersion) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// versionAtLeast checks whether v is the same or newer than minimum
+func versionAtLeast(v, minimum version.SemVer) bool {
+	if v.Major != minimum.Major {
+		return v.Major > minimum.Major
+	}
+	if v.Minor != minimum.Minor {
+		return v.Minor > minimum.Minor
+	}
+	return v.Patch >= minimum.Patch
+}
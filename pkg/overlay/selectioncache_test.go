@@ -0,0 +1,221 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestNodeSelectionCache(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		store := db.OverlayCache()
+
+		addNode := func(i int, freeDisk int64) storj.NodeID {
+			id := storj.NodeID{}
+			_, _ = rand.Read(id[:])
+			err := store.Update(ctx, &pb.Node{
+				Id:           id,
+				Type:         pb.NodeType_STORAGE,
+				Address:      &pb.NodeAddress{Address: fmt.Sprintf("10.0.%d.%d:7777", i/2, i)},
+				Restrictions: &pb.NodeRestrictions{FreeDisk: freeDisk},
+				Reputation:   &pb.NodeStats{},
+			}, fmt.Sprintf("10.0.%d.0", i/2), "", overlay.NodeSelectionConfig{})
+			require.NoError(t, err)
+			_, err = store.UpdateUptime(ctx, id, true, 1, 1)
+			require.NoError(t, err)
+			return id
+		}
+
+		// every network contains two nodes, the second one with less free disk
+		var ids storj.NodeIDList
+		networks := map[storj.NodeID]string{}
+		for i := 0; i < 10; i++ {
+			id := addNode(i, int64(100-i%2*50))
+			ids = append(ids, id)
+			networks[id] = fmt.Sprintf("10.0.%d.0", i/2)
+		}
+
+		selection := overlay.NewNodeSelectionCache(zaptest.NewLogger(t), store, overlay.NodeSelectionConfig{
+			DistinctIP: true,
+		}, time.Hour)
+
+		{ // nodes are selected from distinct networks
+			nodes, err := selection.GetNodes(ctx, overlay.FindStorageNodesRequest{RequestedCount: 5})
+			require.NoError(t, err)
+			require.Len(t, nodes, 5)
+			requireDistinct(t, nodes, networks)
+
+			_, err = selection.GetNodes(ctx, overlay.FindStorageNodesRequest{RequestedCount: 6})
+			require.True(t, overlay.ErrNotEnoughNodes.Has(err))
+		}
+
+		{ // excluded nodes and their networks are not selected
+			nodes, err := selection.GetNodes(ctx, overlay.FindStorageNodesRequest{
+				RequestedCount: 4,
+				ExcludedNodes:  storj.NodeIDList{ids[0]},
+			})
+			require.NoError(t, err)
+			require.Len(t, nodes, 4)
+			requireDistinct(t, nodes, networks)
+			for _, node := range nodes {
+				require.NotEqual(t, networks[ids[0]], networks[node.Id])
+			}
+		}
+
+		{ // nodes without enough free disk are not selected
+			nodes, err := selection.GetNodes(ctx, overlay.FindStorageNodesRequest{
+				RequestedCount: 5,
+				FreeDisk:       75,
+			})
+			require.NoError(t, err)
+			require.Len(t, nodes, 5)
+			for _, node := range nodes {
+				require.Equal(t, int64(100), node.Restrictions.FreeDisk)
+			}
+		}
+
+		{ // new nodes are only visible after the snapshot is refreshed
+			addNode(10, 100)
+
+			_, err := selection.GetNodes(ctx, overlay.FindStorageNodesRequest{RequestedCount: 6})
+			require.True(t, overlay.ErrNotEnoughNodes.Has(err))
+
+			require.NoError(t, selection.Refresh(ctx))
+
+			nodes, err := selection.GetNodes(ctx, overlay.FindStorageNodesRequest{RequestedCount: 6})
+			require.NoError(t, err)
+			require.Len(t, nodes, 6)
+		}
+
+		{ // the snapshot is reloaded in the background
+			addNode(12, 100)
+
+			// the previous snapshot is served until the reload
+			_, err := selection.GetNodes(ctx, overlay.FindStorageNodesRequest{RequestedCount: 7})
+			require.True(t, overlay.ErrNotEnoughNodes.Has(err))
+
+			ctx.Go(func() error { return selection.Run(ctx) })
+			defer ctx.Check(selection.Close)
+			selection.Loop.TriggerWait()
+
+			nodes, err := selection.GetNodes(ctx, overlay.FindStorageNodesRequest{RequestedCount: 7})
+			require.NoError(t, err)
+			require.Len(t, nodes, 7)
+		}
+	})
+}
+
+func TestNodeSelectionCache_NewNodes(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		store := db.OverlayCache()
+
+		reputable := map[storj.NodeID]bool{}
+		for i := 0; i < 10; i++ {
+			id := storj.NodeID{}
+			_, _ = rand.Read(id[:])
+			err := store.Update(ctx, &pb.Node{
+				Id:           id,
+				Type:         pb.NodeType_STORAGE,
+				Restrictions: &pb.NodeRestrictions{},
+				Reputation:   &pb.NodeStats{},
+			}, "", "", overlay.NodeSelectionConfig{AuditReputationAlpha0: 1, UptimeReputationAlpha0: 1})
+			require.NoError(t, err)
+
+			if i%2 == 0 {
+				_, err = store.CreateStats(ctx, id, &overlay.NodeStats{
+					AuditCount:         5,
+					AuditSuccessCount:  5,
+					UptimeCount:        5,
+					UptimeSuccessCount: 5,
+				})
+				require.NoError(t, err)
+				reputable[id] = true
+			}
+
+			_, err = store.UpdateUptime(ctx, id, true, 1, 1)
+			require.NoError(t, err)
+		}
+
+		selection := overlay.NewNodeSelectionCache(zaptest.NewLogger(t), store, overlay.NodeSelectionConfig{
+			NewNodeAuditThreshold: 5,
+			NewNodePercentage:     0.5,
+		}, time.Hour)
+
+		nodes, err := selection.GetNodes(ctx, overlay.FindStorageNodesRequest{RequestedCount: 4})
+		require.NoError(t, err)
+		require.Len(t, nodes, 6)
+
+		var reputableCount int
+		for _, node := range nodes {
+			if reputable[node.Id] {
+				reputableCount++
+			}
+		}
+		require.Equal(t, 4, reputableCount)
+	})
+}
+
+func TestNodeSelectionCache_Placement(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		store := db.OverlayCache()
+
+		countries := map[storj.NodeID]string{}
+		for i, countryCode := range []string{"DE", "FR", "US", "US", "CA", "NL"} {
+			id := storj.NodeID{}
+			_, _ = rand.Read(id[:])
+			err := store.Update(ctx, &pb.Node{
+				Id:           id,
+				Type:         pb.NodeType_STORAGE,
+				Address:      &pb.NodeAddress{Address: fmt.Sprintf("10.0.%d.1:7777", i)},
+				Restrictions: &pb.NodeRestrictions{},
+				Reputation:   &pb.NodeStats{},
+			}, fmt.Sprintf("10.0.%d.0", i), countryCode, overlay.NodeSelectionConfig{})
+			require.NoError(t, err)
+			_, err = store.UpdateUptime(ctx, id, true, 1, 1)
+			require.NoError(t, err)
+			countries[id] = countryCode
+		}
+
+		selection := overlay.NewNodeSelectionCache(zaptest.NewLogger(t), store, overlay.NodeSelectionConfig{}, time.Hour)
+
+		nodes, err := selection.GetNodes(ctx, overlay.FindStorageNodesRequest{RequestedCount: 3, Placement: overlay.PlacementEU})
+		require.NoError(t, err)
+		require.Len(t, nodes, 3)
+		for _, node := range nodes {
+			require.True(t, overlay.PlacementEU.Allows(countries[node.Id]), countries[node.Id])
+		}
+
+		nodes, err = selection.GetNodes(ctx, overlay.FindStorageNodesRequest{RequestedCount: 2, Placement: "US"})
+		require.NoError(t, err)
+		require.Len(t, nodes, 2)
+		for _, node := range nodes {
+			require.Equal(t, "US", countries[node.Id])
+		}
+
+		_, err = selection.GetNodes(ctx, overlay.FindStorageNodesRequest{RequestedCount: 4, Placement: overlay.PlacementEU})
+		require.True(t, overlay.ErrNotEnoughNodes.Has(err))
+	})
+}
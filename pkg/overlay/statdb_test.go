@@ -52,7 +52,7 @@ func testDatabase(ctx context.Context, t *testing.T, cache overlay.DB) {
 			UptimeSuccessCount: currUptimeSuccess,
 		}
 
-		err := cache.Update(ctx, &pb.Node{Id: nodeID})
+		err := cache.Update(ctx, &pb.Node{Id: nodeID}, "", "", overlay.NodeSelectionConfig{})
 		require.NoError(t, err)
 
 		stats, err := cache.CreateStats(ctx, nodeID, nodeStats)
@@ -103,9 +103,14 @@ func testDatabase(ctx context.Context, t *testing.T, cache overlay.DB) {
 				AuditSuccessCount:  tt.auditSuccessCount,
 				UptimeCount:        tt.uptimeCount,
 				UptimeSuccessCount: tt.uptimeSuccessCount,
+
+				AuditReputationAlpha:  float64(tt.auditSuccessCount),
+				AuditReputationBeta:   float64(tt.auditCount - tt.auditSuccessCount),
+				UptimeReputationAlpha: float64(tt.uptimeSuccessCount),
+				UptimeReputationBeta:  float64(tt.uptimeCount - tt.uptimeSuccessCount),
 			}
 
-			err := cache.Update(ctx, &pb.Node{Id: tt.nodeID})
+			err := cache.Update(ctx, &pb.Node{Id: tt.nodeID}, "", "", overlay.NodeSelectionConfig{})
 			require.NoError(t, err)
 
 			_, err = cache.CreateStats(ctx, tt.nodeID, nodeStats)
@@ -117,12 +122,12 @@ func testDatabase(ctx context.Context, t *testing.T, cache overlay.DB) {
 			storj.NodeID{3}, storj.NodeID{4},
 			storj.NodeID{5}, storj.NodeID{6},
 		}
-		maxStats := &overlay.NodeStats{
-			AuditSuccessRatio: 0.5,
-			UptimeRatio:       0.5,
+		criteria := &overlay.NodeCriteria{
+			MinimumAuditReputation:  0.5,
+			MinimumUptimeReputation: 0.5,
 		}
 
-		invalid, err := cache.FindInvalidNodes(ctx, nodeIds, maxStats)
+		invalid, err := cache.FindInvalidNodes(ctx, nodeIds, criteria)
 		require.NoError(t, err)
 
 		assert.Contains(t, invalid, storj.NodeID{2})
@@ -133,7 +138,7 @@ func testDatabase(ctx context.Context, t *testing.T, cache overlay.DB) {
 
 	{ // TestUpdateOperator
 		nodeID := storj.NodeID{10}
-		err := cache.Update(ctx, &pb.Node{Id: nodeID})
+		err := cache.Update(ctx, &pb.Node{Id: nodeID}, "", "", overlay.NodeSelectionConfig{})
 		require.NoError(t, err)
 
 		update, err := cache.UpdateNodeInfo(ctx, nodeID, &pb.InfoResponse{
@@ -224,7 +229,7 @@ func testDatabase(ctx context.Context, t *testing.T, cache overlay.DB) {
 		assert.EqualValues(t, currUptimeSuccess, node.Reputation.UptimeSuccessCount)
 		assert.EqualValues(t, uptimeRatio, node.Reputation.UptimeRatio)
 
-		stats, err := cache.UpdateUptime(ctx, nodeID, false)
+		stats, err := cache.UpdateUptime(ctx, nodeID, false, 0.5, 1)
 		require.NoError(t, err)
 
 		currUptimeCount++
@@ -232,6 +237,10 @@ func testDatabase(ctx context.Context, t *testing.T, cache overlay.DB) {
 		assert.EqualValues(t, auditSuccessRatio, stats.AuditSuccessRatio)
 		assert.EqualValues(t, currAuditCount, stats.AuditCount)
 		assert.EqualValues(t, newUptimeRatio, stats.UptimeRatio)
+		assert.EqualValues(t, node.Reputation.AuditReputationAlpha, stats.AuditReputationAlpha)
+		assert.EqualValues(t, node.Reputation.AuditReputationBeta, stats.AuditReputationBeta)
+		assert.EqualValues(t, 0.5*node.Reputation.UptimeReputationAlpha, stats.UptimeReputationAlpha)
+		assert.EqualValues(t, 0.5*node.Reputation.UptimeReputationBeta+1, stats.UptimeReputationBeta)
 	}
 
 	{ // TestUpdateStatsExists
@@ -252,9 +261,18 @@ func testDatabase(ctx context.Context, t *testing.T, cache overlay.DB) {
 			NodeID:       nodeID,
 			IsUp:         true,
 			AuditSuccess: false,
+			AuditLambda:  0.5,
+			AuditWeight:  1,
+			UptimeLambda: 0.5,
+			UptimeWeight: 1,
 		})
 		require.NoError(t, err)
 
+		assert.EqualValues(t, 0.5*node.Reputation.AuditReputationAlpha, stats.AuditReputationAlpha)
+		assert.EqualValues(t, 0.5*node.Reputation.AuditReputationBeta+1, stats.AuditReputationBeta)
+		assert.EqualValues(t, 0.5*node.Reputation.UptimeReputationAlpha+1, stats.UptimeReputationAlpha)
+		assert.EqualValues(t, 0.5*node.Reputation.UptimeReputationBeta, stats.UptimeReputationBeta)
+
 		currAuditCount++
 		newAuditRatio := getRatio(stats.AuditSuccessCount, stats.AuditCount)
 		assert.EqualValues(t, newAuditRatio, stats.AuditSuccessRatio)
@@ -262,4 +280,63 @@ func testDatabase(ctx context.Context, t *testing.T, cache overlay.DB) {
 		newUptimeRatio := getRatio(stats.UptimeSuccessCount, stats.UptimeCount)
 		assert.EqualValues(t, newUptimeRatio, stats.UptimeRatio)
 	}
+
+	{ // TestDisqualification
+		dqID := storj.NodeID{9, 8, 7, 6, 5}
+		err := cache.Update(ctx, &pb.Node{
+			Id:           dqID,
+			Type:         pb.NodeType_STORAGE,
+			Restrictions: &pb.NodeRestrictions{},
+			Reputation:   &pb.NodeStats{},
+		}, "", "", overlay.NodeSelectionConfig{
+			AuditReputationAlpha0:  3,
+			UptimeReputationAlpha0: 1,
+		})
+		require.NoError(t, err)
+
+		request := &overlay.UpdateRequest{
+			NodeID:       dqID,
+			IsUp:         true,
+			AuditSuccess: false,
+			AuditLambda:  1,
+			AuditWeight:  1,
+			AuditDQ:      0.6,
+			UptimeLambda: 1,
+			UptimeWeight: 1,
+		}
+
+		// reputation hasn't dropped below the disqualification threshold yet
+		for i := 0; i < 2; i++ {
+			stats, err := cache.UpdateStats(ctx, request)
+			require.NoError(t, err)
+			require.Nil(t, stats.Disqualified)
+		}
+
+		stats, err := cache.UpdateStats(ctx, request)
+		require.NoError(t, err)
+		require.NotNil(t, stats.Disqualified)
+		disqualified := *stats.Disqualified
+
+		// disqualification is permanent
+		request.AuditSuccess = true
+		request.AuditDQ = 0
+		stats, err = cache.UpdateStats(ctx, request)
+		require.NoError(t, err)
+		require.NotNil(t, stats.Disqualified)
+		require.True(t, disqualified.Equal(*stats.Disqualified))
+
+		node, err := cache.Get(ctx, dqID)
+		require.NoError(t, err)
+		require.True(t, node.Disqualified())
+
+		invalid, err := cache.FindInvalidNodes(ctx, storj.NodeIDList{dqID}, &overlay.NodeCriteria{})
+		require.NoError(t, err)
+		require.Equal(t, storj.NodeIDList{dqID}, invalid)
+
+		nodes, err := cache.SelectStorageNodes(ctx, 10, &overlay.NodeCriteria{})
+		require.NoError(t, err)
+		for _, node := range nodes {
+			require.NotEqual(t, dqID, node.Id)
+		}
+	}
 }
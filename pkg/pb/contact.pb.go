@@ -0,0 +1,233 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: contact.proto
+
+package pb
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type CheckInRequest struct {
+	Address              string        `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Capacity             *NodeCapacity `protobuf:"bytes,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Operator             *NodeOperator `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"`
+	Version              *NodeVersion  `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *CheckInRequest) Reset()         { *m = CheckInRequest{} }
+func (m *CheckInRequest) String() string { return proto.CompactTextString(m) }
+func (*CheckInRequest) ProtoMessage()    {}
+func (*CheckInRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5036fff2565fb15, []int{0}
+}
+func (m *CheckInRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckInRequest.Unmarshal(m, b)
+}
+func (m *CheckInRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckInRequest.Marshal(b, m, deterministic)
+}
+func (m *CheckInRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckInRequest.Merge(m, src)
+}
+func (m *CheckInRequest) XXX_Size() int {
+	return xxx_messageInfo_CheckInRequest.Size(m)
+}
+func (m *CheckInRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckInRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckInRequest proto.InternalMessageInfo
+
+func (m *CheckInRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *CheckInRequest) GetCapacity() *NodeCapacity {
+	if m != nil {
+		return m.Capacity
+	}
+	return nil
+}
+
+func (m *CheckInRequest) GetOperator() *NodeOperator {
+	if m != nil {
+		return m.Operator
+	}
+	return nil
+}
+
+func (m *CheckInRequest) GetVersion() *NodeVersion {
+	if m != nil {
+		return m.Version
+	}
+	return nil
+}
+
+type CheckInResponse struct {
+	PingNodeSuccess      bool     `protobuf:"varint,1,opt,name=ping_node_success,json=pingNodeSuccess,proto3" json:"ping_node_success,omitempty"`
+	PingErrorMessage     string   `protobuf:"bytes,2,opt,name=ping_error_message,json=pingErrorMessage,proto3" json:"ping_error_message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CheckInResponse) Reset()         { *m = CheckInResponse{} }
+func (m *CheckInResponse) String() string { return proto.CompactTextString(m) }
+func (*CheckInResponse) ProtoMessage()    {}
+func (*CheckInResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5036fff2565fb15, []int{1}
+}
+func (m *CheckInResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckInResponse.Unmarshal(m, b)
+}
+func (m *CheckInResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckInResponse.Marshal(b, m, deterministic)
+}
+func (m *CheckInResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckInResponse.Merge(m, src)
+}
+func (m *CheckInResponse) XXX_Size() int {
+	return xxx_messageInfo_CheckInResponse.Size(m)
+}
+func (m *CheckInResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckInResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckInResponse proto.InternalMessageInfo
+
+func (m *CheckInResponse) GetPingNodeSuccess() bool {
+	if m != nil {
+		return m.PingNodeSuccess
+	}
+	return false
+}
+
+func (m *CheckInResponse) GetPingErrorMessage() string {
+	if m != nil {
+		return m.PingErrorMessage
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*CheckInRequest)(nil), "contact.CheckInRequest")
+	proto.RegisterType((*CheckInResponse)(nil), "contact.CheckInResponse")
+}
+
+func init() { proto.RegisterFile("contact.proto", fileDescriptor_a5036fff2565fb15) }
+
+var fileDescriptor_a5036fff2565fb15 = []byte{
+	// 263 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x90, 0xbf, 0x4e, 0x03, 0x31,
+	0x0c, 0x87, 0x75, 0xe5, 0xc4, 0xb5, 0x46, 0x50, 0xea, 0x85, 0xa8, 0x53, 0xd5, 0xa9, 0x02, 0x74,
+	0x43, 0x59, 0x99, 0x28, 0x0c, 0x0c, 0x80, 0x14, 0x24, 0x06, 0x96, 0x53, 0x9a, 0xb3, 0xca, 0xa9,
+	0x22, 0x0e, 0x49, 0x8a, 0xc4, 0x93, 0xf1, 0x7a, 0x28, 0xf7, 0x0f, 0x41, 0xc7, 0xf8, 0xfb, 0xec,
+	0xd8, 0x3f, 0x38, 0xd6, 0x6c, 0x82, 0xd2, 0x21, 0xb7, 0x8e, 0x03, 0x63, 0xd6, 0x3e, 0xa7, 0x60,
+	0xb8, 0xa4, 0xa6, 0x38, 0xff, 0x4e, 0xe0, 0x64, 0xf5, 0x46, 0x7a, 0x7b, 0x6f, 0x24, 0x7d, 0xec,
+	0xc8, 0x07, 0x14, 0x90, 0xa9, 0xb2, 0x74, 0xe4, 0xbd, 0x48, 0x66, 0xc9, 0x62, 0x24, 0xbb, 0x27,
+	0xe6, 0x30, 0xd4, 0xca, 0x2a, 0x5d, 0x85, 0x2f, 0x31, 0x98, 0x25, 0x8b, 0xa3, 0x25, 0xe6, 0xf5,
+	0xac, 0x47, 0x2e, 0x69, 0xd5, 0x12, 0xd9, 0x3b, 0xd1, 0x67, 0x4b, 0x4e, 0x05, 0x76, 0xe2, 0xe0,
+	0xbf, 0xff, 0xd4, 0x12, 0xd9, 0x3b, 0x78, 0x01, 0xd9, 0x27, 0x39, 0x5f, 0xb1, 0x11, 0x69, 0xad,
+	0x4f, 0x7e, 0xf5, 0x97, 0x06, 0xc8, 0xce, 0x98, 0x6f, 0x61, 0xdc, 0x2f, 0xee, 0x2d, 0x1b, 0x4f,
+	0x78, 0x0e, 0x13, 0x5b, 0x99, 0x4d, 0x11, 0x9b, 0x0a, 0xbf, 0xd3, 0xba, 0xbb, 0x61, 0x28, 0xc7,
+	0x11, 0xc4, 0x39, 0xcf, 0x4d, 0x19, 0x2f, 0x01, 0x6b, 0x97, 0x9c, 0x63, 0x57, 0xbc, 0x93, 0xf7,
+	0x6a, 0x43, 0xf5, 0x55, 0x23, 0x79, 0x1a, 0xc9, 0x5d, 0x04, 0x0f, 0x4d, 0x7d, 0x79, 0x0b, 0x69,
+	0x6c, 0xc6, 0x6b, 0xc8, 0xda, 0x4f, 0xf1, 0x2c, 0xef, 0xe2, 0xfd, 0x9b, 0xdf, 0x54, 0xec, 0x83,
+	0x66, 0xbf, 0x9b, 0xf4, 0x75, 0x60, 0xd7, 0xeb, 0xc3, 0x3a, 0xf9, 0xab, 0x9f, 0x01, 0x00, 0x37,
+	0x71, 0xe3, 0xf3, 0x9f, 0x01, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// NodeClient is the client API for Node service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type NodeClient interface {
+	// CheckIn reports the current node information to the satellite
+	CheckIn(ctx context.Context, in *CheckInRequest, opts ...grpc.CallOption) (*CheckInResponse, error)
+}
+
+type nodeClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewNodeClient(cc *grpc.ClientConn) NodeClient {
+	return &nodeClient{cc}
+}
+
+func (c *nodeClient) CheckIn(ctx context.Context, in *CheckInRequest, opts ...grpc.CallOption) (*CheckInResponse, error) {
+	out := new(CheckInResponse)
+	err := c.cc.Invoke(ctx, "/contact.Node/CheckIn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NodeServer is the server API for Node service.
+type NodeServer interface {
+	// CheckIn reports the current node information to the satellite
+	CheckIn(context.Context, *CheckInRequest) (*CheckInResponse, error)
+}
+
+func RegisterNodeServer(s *grpc.Server, srv NodeServer) {
+	s.RegisterService(&_Node_serviceDesc, srv)
+}
+
+func _Node_CheckIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).CheckIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/contact.Node/CheckIn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).CheckIn(ctx, req.(*CheckInRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Node_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "contact.Node",
+	HandlerType: (*NodeServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CheckIn",
+			Handler:    _Node_CheckIn_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "contact.proto",
+}
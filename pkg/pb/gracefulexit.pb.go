@@ -0,0 +1,835 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: gracefulexit.proto
+
+package pb
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	grpc "google.golang.org/grpc"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type InitiateExitRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InitiateExitRequest) Reset()         { *m = InitiateExitRequest{} }
+func (m *InitiateExitRequest) String() string { return proto.CompactTextString(m) }
+func (*InitiateExitRequest) ProtoMessage()    {}
+func (*InitiateExitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f0acbf2ce5fa631, []int{0}
+}
+func (m *InitiateExitRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InitiateExitRequest.Unmarshal(m, b)
+}
+func (m *InitiateExitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InitiateExitRequest.Marshal(b, m, deterministic)
+}
+func (m *InitiateExitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InitiateExitRequest.Merge(m, src)
+}
+func (m *InitiateExitRequest) XXX_Size() int {
+	return xxx_messageInfo_InitiateExitRequest.Size(m)
+}
+func (m *InitiateExitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InitiateExitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InitiateExitRequest proto.InternalMessageInfo
+
+type InitiateExitResponse struct {
+	ExitInitiatedAt      *timestamp.Timestamp `protobuf:"bytes,1,opt,name=exit_initiated_at,json=exitInitiatedAt,proto3" json:"exit_initiated_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *InitiateExitResponse) Reset()         { *m = InitiateExitResponse{} }
+func (m *InitiateExitResponse) String() string { return proto.CompactTextString(m) }
+func (*InitiateExitResponse) ProtoMessage()    {}
+func (*InitiateExitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f0acbf2ce5fa631, []int{1}
+}
+func (m *InitiateExitResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InitiateExitResponse.Unmarshal(m, b)
+}
+func (m *InitiateExitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InitiateExitResponse.Marshal(b, m, deterministic)
+}
+func (m *InitiateExitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InitiateExitResponse.Merge(m, src)
+}
+func (m *InitiateExitResponse) XXX_Size() int {
+	return xxx_messageInfo_InitiateExitResponse.Size(m)
+}
+func (m *InitiateExitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_InitiateExitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InitiateExitResponse proto.InternalMessageInfo
+
+func (m *InitiateExitResponse) GetExitInitiatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExitInitiatedAt
+	}
+	return nil
+}
+
+type GetTransfersRequest struct {
+	Limit                int32    `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetTransfersRequest) Reset()         { *m = GetTransfersRequest{} }
+func (m *GetTransfersRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTransfersRequest) ProtoMessage()    {}
+func (*GetTransfersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f0acbf2ce5fa631, []int{2}
+}
+func (m *GetTransfersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTransfersRequest.Unmarshal(m, b)
+}
+func (m *GetTransfersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTransfersRequest.Marshal(b, m, deterministic)
+}
+func (m *GetTransfersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTransfersRequest.Merge(m, src)
+}
+func (m *GetTransfersRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTransfersRequest.Size(m)
+}
+func (m *GetTransfersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTransfersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTransfersRequest proto.InternalMessageInfo
+
+func (m *GetTransfersRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type GetTransfersResponse struct {
+	Transfers []*TransferPiece `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers,omitempty"`
+	// finished is set when the exit has completed, no further transfers will be handed out
+	Finished             bool     `protobuf:"varint,2,opt,name=finished,proto3" json:"finished,omitempty"`
+	Successful           bool     `protobuf:"varint,3,opt,name=successful,proto3" json:"successful,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetTransfersResponse) Reset()         { *m = GetTransfersResponse{} }
+func (m *GetTransfersResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTransfersResponse) ProtoMessage()    {}
+func (*GetTransfersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f0acbf2ce5fa631, []int{3}
+}
+func (m *GetTransfersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTransfersResponse.Unmarshal(m, b)
+}
+func (m *GetTransfersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTransfersResponse.Marshal(b, m, deterministic)
+}
+func (m *GetTransfersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTransfersResponse.Merge(m, src)
+}
+func (m *GetTransfersResponse) XXX_Size() int {
+	return xxx_messageInfo_GetTransfersResponse.Size(m)
+}
+func (m *GetTransfersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTransfersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTransfersResponse proto.InternalMessageInfo
+
+func (m *GetTransfersResponse) GetTransfers() []*TransferPiece {
+	if m != nil {
+		return m.Transfers
+	}
+	return nil
+}
+
+func (m *GetTransfersResponse) GetFinished() bool {
+	if m != nil {
+		return m.Finished
+	}
+	return false
+}
+
+func (m *GetTransfersResponse) GetSuccessful() bool {
+	if m != nil {
+		return m.Successful
+	}
+	return false
+}
+
+// TransferPiece describes a piece the exiting node should upload to the node in the order limit
+type TransferPiece struct {
+	Path                 []byte               `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	PieceNum             int32                `protobuf:"varint,2,opt,name=piece_num,json=pieceNum,proto3" json:"piece_num,omitempty"`
+	OriginalPieceId      PieceID              `protobuf:"bytes,3,opt,name=original_piece_id,json=originalPieceId,proto3,customtype=PieceID" json:"original_piece_id"`
+	AddressedOrderLimit  *AddressedOrderLimit `protobuf:"bytes,4,opt,name=addressed_order_limit,json=addressedOrderLimit,proto3" json:"addressed_order_limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *TransferPiece) Reset()         { *m = TransferPiece{} }
+func (m *TransferPiece) String() string { return proto.CompactTextString(m) }
+func (*TransferPiece) ProtoMessage()    {}
+func (*TransferPiece) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f0acbf2ce5fa631, []int{4}
+}
+func (m *TransferPiece) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransferPiece.Unmarshal(m, b)
+}
+func (m *TransferPiece) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransferPiece.Marshal(b, m, deterministic)
+}
+func (m *TransferPiece) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferPiece.Merge(m, src)
+}
+func (m *TransferPiece) XXX_Size() int {
+	return xxx_messageInfo_TransferPiece.Size(m)
+}
+func (m *TransferPiece) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferPiece.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferPiece proto.InternalMessageInfo
+
+func (m *TransferPiece) GetPath() []byte {
+	if m != nil {
+		return m.Path
+	}
+	return nil
+}
+
+func (m *TransferPiece) GetPieceNum() int32 {
+	if m != nil {
+		return m.PieceNum
+	}
+	return 0
+}
+
+func (m *TransferPiece) GetAddressedOrderLimit() *AddressedOrderLimit {
+	if m != nil {
+		return m.AddressedOrderLimit
+	}
+	return nil
+}
+
+type ReportTransferRequest struct {
+	Path     []byte `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	PieceNum int32  `protobuf:"varint,2,opt,name=piece_num,json=pieceNum,proto3" json:"piece_num,omitempty"`
+	Success  bool   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	Error    string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	// order_limit is the limit the piece was uploaded with
+	OrderLimit *OrderLimit2 `protobuf:"bytes,5,opt,name=order_limit,json=orderLimit,proto3" json:"order_limit,omitempty"`
+	// replacement_piece_hash is the hash signed by the replacement node
+	ReplacementPieceHash *PieceHash `protobuf:"bytes,6,opt,name=replacement_piece_hash,json=replacementPieceHash,proto3" json:"replacement_piece_hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ReportTransferRequest) Reset()         { *m = ReportTransferRequest{} }
+func (m *ReportTransferRequest) String() string { return proto.CompactTextString(m) }
+func (*ReportTransferRequest) ProtoMessage()    {}
+func (*ReportTransferRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f0acbf2ce5fa631, []int{5}
+}
+func (m *ReportTransferRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReportTransferRequest.Unmarshal(m, b)
+}
+func (m *ReportTransferRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReportTransferRequest.Marshal(b, m, deterministic)
+}
+func (m *ReportTransferRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReportTransferRequest.Merge(m, src)
+}
+func (m *ReportTransferRequest) XXX_Size() int {
+	return xxx_messageInfo_ReportTransferRequest.Size(m)
+}
+func (m *ReportTransferRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReportTransferRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReportTransferRequest proto.InternalMessageInfo
+
+func (m *ReportTransferRequest) GetPath() []byte {
+	if m != nil {
+		return m.Path
+	}
+	return nil
+}
+
+func (m *ReportTransferRequest) GetPieceNum() int32 {
+	if m != nil {
+		return m.PieceNum
+	}
+	return 0
+}
+
+func (m *ReportTransferRequest) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *ReportTransferRequest) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *ReportTransferRequest) GetOrderLimit() *OrderLimit2 {
+	if m != nil {
+		return m.OrderLimit
+	}
+	return nil
+}
+
+func (m *ReportTransferRequest) GetReplacementPieceHash() *PieceHash {
+	if m != nil {
+		return m.ReplacementPieceHash
+	}
+	return nil
+}
+
+type ReportTransferResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReportTransferResponse) Reset()         { *m = ReportTransferResponse{} }
+func (m *ReportTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*ReportTransferResponse) ProtoMessage()    {}
+func (*ReportTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f0acbf2ce5fa631, []int{6}
+}
+func (m *ReportTransferResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReportTransferResponse.Unmarshal(m, b)
+}
+func (m *ReportTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReportTransferResponse.Marshal(b, m, deterministic)
+}
+func (m *ReportTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReportTransferResponse.Merge(m, src)
+}
+func (m *ReportTransferResponse) XXX_Size() int {
+	return xxx_messageInfo_ReportTransferResponse.Size(m)
+}
+func (m *ReportTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReportTransferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReportTransferResponse proto.InternalMessageInfo
+
+type InitiateGracefulExitRequest struct {
+	SatelliteId          NodeID   `protobuf:"bytes,1,opt,name=satellite_id,json=satelliteId,proto3,customtype=NodeID" json:"satellite_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InitiateGracefulExitRequest) Reset()         { *m = InitiateGracefulExitRequest{} }
+func (m *InitiateGracefulExitRequest) String() string { return proto.CompactTextString(m) }
+func (*InitiateGracefulExitRequest) ProtoMessage()    {}
+func (*InitiateGracefulExitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f0acbf2ce5fa631, []int{7}
+}
+func (m *InitiateGracefulExitRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InitiateGracefulExitRequest.Unmarshal(m, b)
+}
+func (m *InitiateGracefulExitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InitiateGracefulExitRequest.Marshal(b, m, deterministic)
+}
+func (m *InitiateGracefulExitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InitiateGracefulExitRequest.Merge(m, src)
+}
+func (m *InitiateGracefulExitRequest) XXX_Size() int {
+	return xxx_messageInfo_InitiateGracefulExitRequest.Size(m)
+}
+func (m *InitiateGracefulExitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InitiateGracefulExitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InitiateGracefulExitRequest proto.InternalMessageInfo
+
+type GetExitProgressRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetExitProgressRequest) Reset()         { *m = GetExitProgressRequest{} }
+func (m *GetExitProgressRequest) String() string { return proto.CompactTextString(m) }
+func (*GetExitProgressRequest) ProtoMessage()    {}
+func (*GetExitProgressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f0acbf2ce5fa631, []int{8}
+}
+func (m *GetExitProgressRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetExitProgressRequest.Unmarshal(m, b)
+}
+func (m *GetExitProgressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetExitProgressRequest.Marshal(b, m, deterministic)
+}
+func (m *GetExitProgressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetExitProgressRequest.Merge(m, src)
+}
+func (m *GetExitProgressRequest) XXX_Size() int {
+	return xxx_messageInfo_GetExitProgressRequest.Size(m)
+}
+func (m *GetExitProgressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetExitProgressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetExitProgressRequest proto.InternalMessageInfo
+
+type GetExitProgressResponse struct {
+	Progress             []*ExitProgress `protobuf:"bytes,1,rep,name=progress,proto3" json:"progress,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GetExitProgressResponse) Reset()         { *m = GetExitProgressResponse{} }
+func (m *GetExitProgressResponse) String() string { return proto.CompactTextString(m) }
+func (*GetExitProgressResponse) ProtoMessage()    {}
+func (*GetExitProgressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f0acbf2ce5fa631, []int{9}
+}
+func (m *GetExitProgressResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetExitProgressResponse.Unmarshal(m, b)
+}
+func (m *GetExitProgressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetExitProgressResponse.Marshal(b, m, deterministic)
+}
+func (m *GetExitProgressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetExitProgressResponse.Merge(m, src)
+}
+func (m *GetExitProgressResponse) XXX_Size() int {
+	return xxx_messageInfo_GetExitProgressResponse.Size(m)
+}
+func (m *GetExitProgressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetExitProgressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetExitProgressResponse proto.InternalMessageInfo
+
+func (m *GetExitProgressResponse) GetProgress() []*ExitProgress {
+	if m != nil {
+		return m.Progress
+	}
+	return nil
+}
+
+type ExitProgress struct {
+	SatelliteId          NodeID               `protobuf:"bytes,1,opt,name=satellite_id,json=satelliteId,proto3,customtype=NodeID" json:"satellite_id"`
+	InitiatedAt          *timestamp.Timestamp `protobuf:"bytes,2,opt,name=initiated_at,json=initiatedAt,proto3" json:"initiated_at,omitempty"`
+	FinishedAt           *timestamp.Timestamp `protobuf:"bytes,3,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	Successful           bool                 `protobuf:"varint,4,opt,name=successful,proto3" json:"successful,omitempty"`
+	PiecesTransferred    int64                `protobuf:"varint,5,opt,name=pieces_transferred,json=piecesTransferred,proto3" json:"pieces_transferred,omitempty"`
+	TransfersFailed      int64                `protobuf:"varint,6,opt,name=transfers_failed,json=transfersFailed,proto3" json:"transfers_failed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ExitProgress) Reset()         { *m = ExitProgress{} }
+func (m *ExitProgress) String() string { return proto.CompactTextString(m) }
+func (*ExitProgress) ProtoMessage()    {}
+func (*ExitProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f0acbf2ce5fa631, []int{10}
+}
+func (m *ExitProgress) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExitProgress.Unmarshal(m, b)
+}
+func (m *ExitProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExitProgress.Marshal(b, m, deterministic)
+}
+func (m *ExitProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExitProgress.Merge(m, src)
+}
+func (m *ExitProgress) XXX_Size() int {
+	return xxx_messageInfo_ExitProgress.Size(m)
+}
+func (m *ExitProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExitProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExitProgress proto.InternalMessageInfo
+
+func (m *ExitProgress) GetInitiatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.InitiatedAt
+	}
+	return nil
+}
+
+func (m *ExitProgress) GetFinishedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.FinishedAt
+	}
+	return nil
+}
+
+func (m *ExitProgress) GetSuccessful() bool {
+	if m != nil {
+		return m.Successful
+	}
+	return false
+}
+
+func (m *ExitProgress) GetPiecesTransferred() int64 {
+	if m != nil {
+		return m.PiecesTransferred
+	}
+	return 0
+}
+
+func (m *ExitProgress) GetTransfersFailed() int64 {
+	if m != nil {
+		return m.TransfersFailed
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*InitiateExitRequest)(nil), "gracefulexit.InitiateExitRequest")
+	proto.RegisterType((*InitiateExitResponse)(nil), "gracefulexit.InitiateExitResponse")
+	proto.RegisterType((*GetTransfersRequest)(nil), "gracefulexit.GetTransfersRequest")
+	proto.RegisterType((*GetTransfersResponse)(nil), "gracefulexit.GetTransfersResponse")
+	proto.RegisterType((*TransferPiece)(nil), "gracefulexit.TransferPiece")
+	proto.RegisterType((*ReportTransferRequest)(nil), "gracefulexit.ReportTransferRequest")
+	proto.RegisterType((*ReportTransferResponse)(nil), "gracefulexit.ReportTransferResponse")
+	proto.RegisterType((*InitiateGracefulExitRequest)(nil), "gracefulexit.InitiateGracefulExitRequest")
+	proto.RegisterType((*GetExitProgressRequest)(nil), "gracefulexit.GetExitProgressRequest")
+	proto.RegisterType((*GetExitProgressResponse)(nil), "gracefulexit.GetExitProgressResponse")
+	proto.RegisterType((*ExitProgress)(nil), "gracefulexit.ExitProgress")
+}
+
+func init() { proto.RegisterFile("gracefulexit.proto", fileDescriptor_8f0acbf2ce5fa631) }
+
+var fileDescriptor_8f0acbf2ce5fa631 = []byte{
+	// 765 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xdd, 0x6e, 0xd3, 0x4a,
+	0x10, 0xae, 0xf3, 0xd3, 0xa6, 0x13, 0x9f, 0xa6, 0xd9, 0x24, 0x3d, 0x91, 0xab, 0x73, 0x5a, 0x4c,
+	0x91, 0x5a, 0x21, 0x52, 0x11, 0x10, 0x12, 0xaa, 0xb8, 0x68, 0x05, 0x0d, 0x95, 0x50, 0x69, 0x97,
+	0x4a, 0x48, 0x48, 0x60, 0xb6, 0xf1, 0x26, 0x59, 0xc9, 0xb1, 0xcd, 0xee, 0x46, 0xea, 0x4b, 0xf0,
+	0x1e, 0xbc, 0x01, 0xaf, 0xc0, 0x25, 0x37, 0xdc, 0x70, 0xd1, 0xd7, 0xe0, 0x16, 0x79, 0xed, 0x75,
+	0xec, 0x34, 0x4a, 0x05, 0x77, 0x9e, 0x99, 0x6f, 0x66, 0x67, 0x67, 0xbe, 0x6f, 0x0d, 0x68, 0xc8,
+	0x49, 0x9f, 0x0e, 0x26, 0x1e, 0xbd, 0x62, 0xb2, 0x13, 0xf2, 0x40, 0x06, 0xc8, 0xcc, 0xfa, 0x2c,
+	0x18, 0x06, 0xc3, 0x20, 0x8e, 0x58, 0x5b, 0xc3, 0x20, 0x18, 0x7a, 0x74, 0x5f, 0x59, 0x97, 0x93,
+	0xc1, 0xbe, 0x64, 0x63, 0x2a, 0x24, 0x19, 0x87, 0x09, 0x60, 0x6d, 0x4c, 0x25, 0x61, 0xfe, 0x40,
+	0x27, 0x98, 0x01, 0x77, 0x29, 0x17, 0xb1, 0x65, 0xb7, 0xa0, 0x71, 0xe2, 0x33, 0xc9, 0x88, 0xa4,
+	0x2f, 0xae, 0x98, 0xc4, 0xf4, 0xd3, 0x84, 0x0a, 0x69, 0x7f, 0x80, 0x66, 0xde, 0x2d, 0xc2, 0xc0,
+	0x17, 0x14, 0x1d, 0x43, 0x3d, 0xea, 0xc0, 0x61, 0x49, 0xd0, 0x75, 0x88, 0x6c, 0x1b, 0xdb, 0xc6,
+	0x6e, 0xb5, 0x6b, 0x75, 0xe2, 0x4e, 0x3a, 0xba, 0x93, 0xce, 0x85, 0xee, 0x04, 0xd7, 0xa2, 0x24,
+	0x5d, 0xd0, 0x3d, 0x94, 0xf6, 0x7d, 0x68, 0xf4, 0xa8, 0xbc, 0xe0, 0xc4, 0x17, 0x03, 0xca, 0x45,
+	0x72, 0x2c, 0x6a, 0x42, 0xd9, 0x63, 0x63, 0x16, 0x97, 0x2c, 0xe3, 0xd8, 0xb0, 0x3f, 0x1b, 0xd0,
+	0xcc, 0xa3, 0x93, 0x6e, 0x9e, 0xc2, 0xaa, 0xd4, 0xce, 0xb6, 0xb1, 0x5d, 0xdc, 0xad, 0x76, 0x37,
+	0x3b, 0xb9, 0xe9, 0xe9, 0x9c, 0x33, 0x46, 0xfb, 0x14, 0x4f, 0xd1, 0xc8, 0x82, 0xca, 0x80, 0xf9,
+	0x4c, 0x8c, 0xa8, 0xdb, 0x2e, 0x6c, 0x1b, 0xbb, 0x15, 0x9c, 0xda, 0xe8, 0x7f, 0x00, 0x31, 0xe9,
+	0xf7, 0xa9, 0x10, 0x83, 0x89, 0xd7, 0x2e, 0xaa, 0x68, 0xc6, 0x63, 0x7f, 0x37, 0xe0, 0x9f, 0x5c,
+	0x61, 0x84, 0xa0, 0x14, 0x12, 0x39, 0x52, 0x6d, 0x9b, 0x58, 0x7d, 0xa3, 0x4d, 0x58, 0x0d, 0xa3,
+	0xa0, 0xe3, 0x4f, 0xc6, 0xea, 0x88, 0x32, 0xae, 0x28, 0xc7, 0xe9, 0x64, 0x8c, 0x0e, 0xa0, 0x1e,
+	0x70, 0x36, 0x64, 0x3e, 0xf1, 0x9c, 0x18, 0xc5, 0x5c, 0x75, 0x92, 0x79, 0x54, 0xfb, 0x76, 0xbd,
+	0xb5, 0xf4, 0xf3, 0x7a, 0x6b, 0x45, 0x95, 0x3e, 0x79, 0x8e, 0x6b, 0x1a, 0x19, 0x3b, 0x5c, 0x74,
+	0x0e, 0x2d, 0xe2, 0xba, 0x9c, 0x0a, 0x41, 0x5d, 0x47, 0x6d, 0xd3, 0x89, 0xa7, 0x56, 0x52, 0x8b,
+	0xf8, 0xaf, 0x93, 0x6e, 0xfc, 0x50, 0xc3, 0x5e, 0x47, 0xa8, 0x57, 0x11, 0x08, 0x37, 0xc8, 0x4d,
+	0xa7, 0xfd, 0xcb, 0x80, 0x16, 0xa6, 0x61, 0xc0, 0xd3, 0x29, 0xeb, 0x95, 0xfc, 0xf1, 0xd5, 0xda,
+	0xb0, 0x92, 0xcc, 0x2a, 0x19, 0x9d, 0x36, 0xa3, 0xed, 0x52, 0xce, 0x03, 0xae, 0xfa, 0x5c, 0xc5,
+	0xb1, 0x81, 0x1e, 0x43, 0x35, 0x7b, 0x87, 0xb2, 0xba, 0x43, 0xa3, 0x93, 0xb0, 0x74, 0xda, 0x63,
+	0x17, 0x43, 0x90, 0x1a, 0xa8, 0x07, 0x1b, 0x9c, 0x86, 0x1e, 0xe9, 0xd3, 0x31, 0xf5, 0x65, 0x32,
+	0xc3, 0x11, 0x11, 0xa3, 0xf6, 0xb2, 0x2a, 0x50, 0xd7, 0x05, 0xd4, 0xd0, 0x5e, 0x12, 0x31, 0xc2,
+	0xcd, 0x4c, 0x42, 0xea, 0xb5, 0xdb, 0xb0, 0x31, 0x7b, 0xf1, 0x98, 0x5d, 0xf6, 0x19, 0x6c, 0x6a,
+	0xca, 0xf6, 0x12, 0x4e, 0x65, 0x24, 0x82, 0x1e, 0x82, 0x29, 0x88, 0xa4, 0x9e, 0xc7, 0xa4, 0xda,
+	0x9e, 0x1a, 0xd0, 0xd1, 0x5a, 0xb2, 0xbd, 0xe5, 0xd3, 0xc0, 0x8d, 0x96, 0x57, 0x4d, 0x31, 0x27,
+	0x6e, 0x74, 0x56, 0x8f, 0xca, 0xa8, 0xc8, 0x19, 0x0f, 0x86, 0xd1, 0x12, 0xb4, 0xde, 0xce, 0xe1,
+	0xdf, 0x1b, 0x91, 0x84, 0xe4, 0x4f, 0xa0, 0x12, 0x26, 0xbe, 0x84, 0xe3, 0x56, 0x9e, 0xe3, 0xb9,
+	0xac, 0x14, 0x6b, 0x7f, 0x2d, 0x80, 0x99, 0x0d, 0xfd, 0x45, 0xc3, 0xe8, 0x19, 0x98, 0x39, 0xa5,
+	0x17, 0x6e, 0x55, 0x7a, 0x95, 0x4d, 0x55, 0x8e, 0x0e, 0xa0, 0xaa, 0x45, 0x15, 0x65, 0x17, 0x6f,
+	0xcd, 0x06, 0x0d, 0x3f, 0x94, 0x33, 0x2a, 0x2c, 0xcd, 0xaa, 0x10, 0x3d, 0x00, 0xa4, 0xb6, 0x2e,
+	0x1c, 0xad, 0x6a, 0x4e, 0x5d, 0x45, 0x9f, 0x22, 0xae, 0xc7, 0x91, 0x8b, 0x69, 0x00, 0xed, 0xc1,
+	0xba, 0xc6, 0x09, 0x67, 0x40, 0x98, 0x47, 0x5d, 0x45, 0x95, 0x22, 0xae, 0xa5, 0xfe, 0x63, 0xe5,
+	0xee, 0x7e, 0x29, 0x40, 0xeb, 0x8d, 0x9e, 0x42, 0x76, 0xf5, 0xe8, 0x2d, 0x98, 0xd9, 0x67, 0x11,
+	0xdd, 0xc9, 0x6f, 0x62, 0xce, 0x4b, 0x6a, 0xd9, 0x8b, 0x20, 0x09, 0xd3, 0x96, 0xa2, 0xc2, 0xd9,
+	0x17, 0x6e, 0xb6, 0xf0, 0x9c, 0xb7, 0xd2, 0xb2, 0x17, 0x41, 0xd2, 0xc2, 0xef, 0x61, 0x2d, 0x4f,
+	0x6f, 0x74, 0x37, 0x9f, 0x37, 0x57, 0xf5, 0xd6, 0xce, 0x62, 0x90, 0x2e, 0xdf, 0xfd, 0x61, 0xc0,
+	0x7a, 0x44, 0x9c, 0xdc, 0x94, 0x9c, 0xe9, 0xcf, 0x23, 0xe7, 0xdf, 0x9b, 0x3f, 0x8a, 0x39, 0xe2,
+	0xb2, 0x16, 0x50, 0xdc, 0x5e, 0x42, 0x1f, 0xa1, 0x36, 0xa3, 0x16, 0xb4, 0x73, 0x63, 0x1a, 0x73,
+	0x64, 0x66, 0xdd, 0xbb, 0x05, 0xa5, 0xef, 0x75, 0x54, 0x7a, 0x57, 0x08, 0x2f, 0x2f, 0x97, 0x15,
+	0x45, 0x1f, 0xfd, 0x1e, 0x00, 0x01, 0xf4, 0x06, 0xab, 0x92, 0x07, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// SatelliteGracefulExitClient is the client API for SatelliteGracefulExit service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type SatelliteGracefulExitClient interface {
+	// InitiateExit marks the calling node as exiting
+	InitiateExit(ctx context.Context, in *InitiateExitRequest, opts ...grpc.CallOption) (*InitiateExitResponse, error)
+	// GetTransfers returns the pieces the calling node should transfer to replacement nodes
+	GetTransfers(ctx context.Context, in *GetTransfersRequest, opts ...grpc.CallOption) (*GetTransfersResponse, error)
+	// ReportTransfer reports the result of transferring a single piece
+	ReportTransfer(ctx context.Context, in *ReportTransferRequest, opts ...grpc.CallOption) (*ReportTransferResponse, error)
+}
+
+type satelliteGracefulExitClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewSatelliteGracefulExitClient(cc *grpc.ClientConn) SatelliteGracefulExitClient {
+	return &satelliteGracefulExitClient{cc}
+}
+
+func (c *satelliteGracefulExitClient) InitiateExit(ctx context.Context, in *InitiateExitRequest, opts ...grpc.CallOption) (*InitiateExitResponse, error) {
+	out := new(InitiateExitResponse)
+	err := c.cc.Invoke(ctx, "/gracefulexit.SatelliteGracefulExit/InitiateExit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *satelliteGracefulExitClient) GetTransfers(ctx context.Context, in *GetTransfersRequest, opts ...grpc.CallOption) (*GetTransfersResponse, error) {
+	out := new(GetTransfersResponse)
+	err := c.cc.Invoke(ctx, "/gracefulexit.SatelliteGracefulExit/GetTransfers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *satelliteGracefulExitClient) ReportTransfer(ctx context.Context, in *ReportTransferRequest, opts ...grpc.CallOption) (*ReportTransferResponse, error) {
+	out := new(ReportTransferResponse)
+	err := c.cc.Invoke(ctx, "/gracefulexit.SatelliteGracefulExit/ReportTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SatelliteGracefulExitServer is the server API for SatelliteGracefulExit service.
+type SatelliteGracefulExitServer interface {
+	// InitiateExit marks the calling node as exiting
+	InitiateExit(context.Context, *InitiateExitRequest) (*InitiateExitResponse, error)
+	// GetTransfers returns the pieces the calling node should transfer to replacement nodes
+	GetTransfers(context.Context, *GetTransfersRequest) (*GetTransfersResponse, error)
+	// ReportTransfer reports the result of transferring a single piece
+	ReportTransfer(context.Context, *ReportTransferRequest) (*ReportTransferResponse, error)
+}
+
+func RegisterSatelliteGracefulExitServer(s *grpc.Server, srv SatelliteGracefulExitServer) {
+	s.RegisterService(&_SatelliteGracefulExit_serviceDesc, srv)
+}
+
+func _SatelliteGracefulExit_InitiateExit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InitiateExitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SatelliteGracefulExitServer).InitiateExit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gracefulexit.SatelliteGracefulExit/InitiateExit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SatelliteGracefulExitServer).InitiateExit(ctx, req.(*InitiateExitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SatelliteGracefulExit_GetTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SatelliteGracefulExitServer).GetTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gracefulexit.SatelliteGracefulExit/GetTransfers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SatelliteGracefulExitServer).GetTransfers(ctx, req.(*GetTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SatelliteGracefulExit_ReportTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SatelliteGracefulExitServer).ReportTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gracefulexit.SatelliteGracefulExit/ReportTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SatelliteGracefulExitServer).ReportTransfer(ctx, req.(*ReportTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _SatelliteGracefulExit_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "gracefulexit.SatelliteGracefulExit",
+	HandlerType: (*SatelliteGracefulExitServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "InitiateExit",
+			Handler:    _SatelliteGracefulExit_InitiateExit_Handler,
+		},
+		{
+			MethodName: "GetTransfers",
+			Handler:    _SatelliteGracefulExit_GetTransfers_Handler,
+		},
+		{
+			MethodName: "ReportTransfer",
+			Handler:    _SatelliteGracefulExit_ReportTransfer_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "gracefulexit.proto",
+}
+
+// NodeGracefulExitClient is the client API for NodeGracefulExit service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type NodeGracefulExitClient interface {
+	// InitiateGracefulExit starts the graceful exit from a satellite
+	InitiateGracefulExit(ctx context.Context, in *InitiateGracefulExitRequest, opts ...grpc.CallOption) (*ExitProgress, error)
+	// GetExitProgress returns the progress of all graceful exits
+	GetExitProgress(ctx context.Context, in *GetExitProgressRequest, opts ...grpc.CallOption) (*GetExitProgressResponse, error)
+}
+
+type nodeGracefulExitClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewNodeGracefulExitClient(cc *grpc.ClientConn) NodeGracefulExitClient {
+	return &nodeGracefulExitClient{cc}
+}
+
+func (c *nodeGracefulExitClient) InitiateGracefulExit(ctx context.Context, in *InitiateGracefulExitRequest, opts ...grpc.CallOption) (*ExitProgress, error) {
+	out := new(ExitProgress)
+	err := c.cc.Invoke(ctx, "/gracefulexit.NodeGracefulExit/InitiateGracefulExit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeGracefulExitClient) GetExitProgress(ctx context.Context, in *GetExitProgressRequest, opts ...grpc.CallOption) (*GetExitProgressResponse, error) {
+	out := new(GetExitProgressResponse)
+	err := c.cc.Invoke(ctx, "/gracefulexit.NodeGracefulExit/GetExitProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NodeGracefulExitServer is the server API for NodeGracefulExit service.
+type NodeGracefulExitServer interface {
+	// InitiateGracefulExit starts the graceful exit from a satellite
+	InitiateGracefulExit(context.Context, *InitiateGracefulExitRequest) (*ExitProgress, error)
+	// GetExitProgress returns the progress of all graceful exits
+	GetExitProgress(context.Context, *GetExitProgressRequest) (*GetExitProgressResponse, error)
+}
+
+func RegisterNodeGracefulExitServer(s *grpc.Server, srv NodeGracefulExitServer) {
+	s.RegisterService(&_NodeGracefulExit_serviceDesc, srv)
+}
+
+func _NodeGracefulExit_InitiateGracefulExit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InitiateGracefulExitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeGracefulExitServer).InitiateGracefulExit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gracefulexit.NodeGracefulExit/InitiateGracefulExit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeGracefulExitServer).InitiateGracefulExit(ctx, req.(*InitiateGracefulExitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeGracefulExit_GetExitProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExitProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeGracefulExitServer).GetExitProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gracefulexit.NodeGracefulExit/GetExitProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeGracefulExitServer).GetExitProgress(ctx, req.(*GetExitProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _NodeGracefulExit_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "gracefulexit.NodeGracefulExit",
+	HandlerType: (*NodeGracefulExitServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "InitiateGracefulExit",
+			Handler:    _NodeGracefulExit_InitiateGracefulExit_Handler,
+		},
+		{
+			MethodName: "GetExitProgress",
+			Handler:    _NodeGracefulExit_GetExitProgress_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "gracefulexit.proto",
+}
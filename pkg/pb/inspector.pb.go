@@ -180,6 +180,83 @@ func (m *ListIrreparableSegmentsResponse) GetSegments() []*IrreparableSegment {
 	return nil
 }
 
+// MarkSegmentLost
+type MarkSegmentLostRequest struct {
+	Path                 []byte   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MarkSegmentLostRequest) Reset()         { *m = MarkSegmentLostRequest{} }
+func (m *MarkSegmentLostRequest) String() string { return proto.CompactTextString(m) }
+func (*MarkSegmentLostRequest) ProtoMessage()    {}
+func (*MarkSegmentLostRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{3}
+}
+func (m *MarkSegmentLostRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MarkSegmentLostRequest.Unmarshal(m, b)
+}
+func (m *MarkSegmentLostRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MarkSegmentLostRequest.Marshal(b, m, deterministic)
+}
+func (m *MarkSegmentLostRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarkSegmentLostRequest.Merge(m, src)
+}
+func (m *MarkSegmentLostRequest) XXX_Size() int {
+	return xxx_messageInfo_MarkSegmentLostRequest.Size(m)
+}
+func (m *MarkSegmentLostRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarkSegmentLostRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarkSegmentLostRequest proto.InternalMessageInfo
+
+func (m *MarkSegmentLostRequest) GetPath() []byte {
+	if m != nil {
+		return m.Path
+	}
+	return nil
+}
+
+type MarkSegmentLostResponse struct {
+	NotifiedMembers      int32    `protobuf:"varint,1,opt,name=notified_members,json=notifiedMembers,proto3" json:"notified_members,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MarkSegmentLostResponse) Reset()         { *m = MarkSegmentLostResponse{} }
+func (m *MarkSegmentLostResponse) String() string { return proto.CompactTextString(m) }
+func (*MarkSegmentLostResponse) ProtoMessage()    {}
+func (*MarkSegmentLostResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{4}
+}
+func (m *MarkSegmentLostResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MarkSegmentLostResponse.Unmarshal(m, b)
+}
+func (m *MarkSegmentLostResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MarkSegmentLostResponse.Marshal(b, m, deterministic)
+}
+func (m *MarkSegmentLostResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarkSegmentLostResponse.Merge(m, src)
+}
+func (m *MarkSegmentLostResponse) XXX_Size() int {
+	return xxx_messageInfo_MarkSegmentLostResponse.Size(m)
+}
+func (m *MarkSegmentLostResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarkSegmentLostResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarkSegmentLostResponse proto.InternalMessageInfo
+
+func (m *MarkSegmentLostResponse) GetNotifiedMembers() int32 {
+	if m != nil {
+		return m.NotifiedMembers
+	}
+	return 0
+}
+
 // GetStats
 type GetStatsRequest struct {
 	NodeId               NodeID   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
@@ -192,7 +269,7 @@ func (m *GetStatsRequest) Reset()         { *m = GetStatsRequest{} }
 func (m *GetStatsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetStatsRequest) ProtoMessage()    {}
 func (*GetStatsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{3}
+	return fileDescriptor_a07d9034b2dd9d26, []int{5}
 }
 func (m *GetStatsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetStatsRequest.Unmarshal(m, b)
@@ -213,20 +290,24 @@ func (m *GetStatsRequest) XXX_DiscardUnknown() {
 var xxx_messageInfo_GetStatsRequest proto.InternalMessageInfo
 
 type GetStatsResponse struct {
-	AuditCount           int64    `protobuf:"varint,1,opt,name=audit_count,json=auditCount,proto3" json:"audit_count,omitempty"`
-	AuditRatio           float64  `protobuf:"fixed64,2,opt,name=audit_ratio,json=auditRatio,proto3" json:"audit_ratio,omitempty"`
-	UptimeCount          int64    `protobuf:"varint,3,opt,name=uptime_count,json=uptimeCount,proto3" json:"uptime_count,omitempty"`
-	UptimeRatio          float64  `protobuf:"fixed64,4,opt,name=uptime_ratio,json=uptimeRatio,proto3" json:"uptime_ratio,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	AuditCount            int64    `protobuf:"varint,1,opt,name=audit_count,json=auditCount,proto3" json:"audit_count,omitempty"`
+	AuditRatio            float64  `protobuf:"fixed64,2,opt,name=audit_ratio,json=auditRatio,proto3" json:"audit_ratio,omitempty"`
+	UptimeCount           int64    `protobuf:"varint,3,opt,name=uptime_count,json=uptimeCount,proto3" json:"uptime_count,omitempty"`
+	UptimeRatio           float64  `protobuf:"fixed64,4,opt,name=uptime_ratio,json=uptimeRatio,proto3" json:"uptime_ratio,omitempty"`
+	AuditReputationAlpha  float64  `protobuf:"fixed64,5,opt,name=audit_reputation_alpha,json=auditReputationAlpha,proto3" json:"audit_reputation_alpha,omitempty"`
+	AuditReputationBeta   float64  `protobuf:"fixed64,6,opt,name=audit_reputation_beta,json=auditReputationBeta,proto3" json:"audit_reputation_beta,omitempty"`
+	UptimeReputationAlpha float64  `protobuf:"fixed64,7,opt,name=uptime_reputation_alpha,json=uptimeReputationAlpha,proto3" json:"uptime_reputation_alpha,omitempty"`
+	UptimeReputationBeta  float64  `protobuf:"fixed64,8,opt,name=uptime_reputation_beta,json=uptimeReputationBeta,proto3" json:"uptime_reputation_beta,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
 }
 
 func (m *GetStatsResponse) Reset()         { *m = GetStatsResponse{} }
 func (m *GetStatsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetStatsResponse) ProtoMessage()    {}
 func (*GetStatsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{4}
+	return fileDescriptor_a07d9034b2dd9d26, []int{6}
 }
 func (m *GetStatsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetStatsResponse.Unmarshal(m, b)
@@ -274,6 +355,34 @@ func (m *GetStatsResponse) GetUptimeRatio() float64 {
 	return 0
 }
 
+func (m *GetStatsResponse) GetAuditReputationAlpha() float64 {
+	if m != nil {
+		return m.AuditReputationAlpha
+	}
+	return 0
+}
+
+func (m *GetStatsResponse) GetAuditReputationBeta() float64 {
+	if m != nil {
+		return m.AuditReputationBeta
+	}
+	return 0
+}
+
+func (m *GetStatsResponse) GetUptimeReputationAlpha() float64 {
+	if m != nil {
+		return m.UptimeReputationAlpha
+	}
+	return 0
+}
+
+func (m *GetStatsResponse) GetUptimeReputationBeta() float64 {
+	if m != nil {
+		return m.UptimeReputationBeta
+	}
+	return 0
+}
+
 // CreateStats
 type CreateStatsRequest struct {
 	NodeId               NodeID   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
@@ -286,87 +395,806 @@ type CreateStatsRequest struct {
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateStatsRequest) Reset()         { *m = CreateStatsRequest{} }
-func (m *CreateStatsRequest) String() string { return proto.CompactTextString(m) }
-func (*CreateStatsRequest) ProtoMessage()    {}
-func (*CreateStatsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{5}
+func (m *CreateStatsRequest) Reset()         { *m = CreateStatsRequest{} }
+func (m *CreateStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateStatsRequest) ProtoMessage()    {}
+func (*CreateStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{7}
+}
+func (m *CreateStatsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateStatsRequest.Unmarshal(m, b)
+}
+func (m *CreateStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateStatsRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateStatsRequest.Merge(m, src)
+}
+func (m *CreateStatsRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateStatsRequest.Size(m)
+}
+func (m *CreateStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateStatsRequest proto.InternalMessageInfo
+
+func (m *CreateStatsRequest) GetAuditCount() int64 {
+	if m != nil {
+		return m.AuditCount
+	}
+	return 0
+}
+
+func (m *CreateStatsRequest) GetAuditSuccessCount() int64 {
+	if m != nil {
+		return m.AuditSuccessCount
+	}
+	return 0
+}
+
+func (m *CreateStatsRequest) GetUptimeCount() int64 {
+	if m != nil {
+		return m.UptimeCount
+	}
+	return 0
+}
+
+func (m *CreateStatsRequest) GetUptimeSuccessCount() int64 {
+	if m != nil {
+		return m.UptimeSuccessCount
+	}
+	return 0
+}
+
+type CreateStatsResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateStatsResponse) Reset()         { *m = CreateStatsResponse{} }
+func (m *CreateStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateStatsResponse) ProtoMessage()    {}
+func (*CreateStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{8}
+}
+func (m *CreateStatsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateStatsResponse.Unmarshal(m, b)
+}
+func (m *CreateStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateStatsResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateStatsResponse.Merge(m, src)
+}
+func (m *CreateStatsResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateStatsResponse.Size(m)
+}
+func (m *CreateStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateStatsResponse proto.InternalMessageInfo
+
+// NodeAdmin
+type NodeAdminRequest struct {
+	NodeId NodeID `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	// operator is the person making the change
+	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	// details is the reason for the change or the note
+	Details              string   `protobuf:"bytes,3,opt,name=details,proto3" json:"details,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NodeAdminRequest) Reset()         { *m = NodeAdminRequest{} }
+func (m *NodeAdminRequest) String() string { return proto.CompactTextString(m) }
+func (*NodeAdminRequest) ProtoMessage()    {}
+func (*NodeAdminRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{9}
+}
+func (m *NodeAdminRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeAdminRequest.Unmarshal(m, b)
+}
+func (m *NodeAdminRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeAdminRequest.Marshal(b, m, deterministic)
+}
+func (m *NodeAdminRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeAdminRequest.Merge(m, src)
+}
+func (m *NodeAdminRequest) XXX_Size() int {
+	return xxx_messageInfo_NodeAdminRequest.Size(m)
+}
+func (m *NodeAdminRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeAdminRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeAdminRequest proto.InternalMessageInfo
+
+func (m *NodeAdminRequest) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *NodeAdminRequest) GetDetails() string {
+	if m != nil {
+		return m.Details
+	}
+	return ""
+}
+
+type NodeAdminResponse struct {
+	Disqualified         *timestamp.Timestamp `protobuf:"bytes,1,opt,name=disqualified,proto3" json:"disqualified,omitempty"`
+	Suspended            *timestamp.Timestamp `protobuf:"bytes,2,opt,name=suspended,proto3" json:"suspended,omitempty"`
+	Quarantined          *timestamp.Timestamp `protobuf:"bytes,3,opt,name=quarantined,proto3" json:"quarantined,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *NodeAdminResponse) Reset()         { *m = NodeAdminResponse{} }
+func (m *NodeAdminResponse) String() string { return proto.CompactTextString(m) }
+func (*NodeAdminResponse) ProtoMessage()    {}
+func (*NodeAdminResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{10}
+}
+func (m *NodeAdminResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeAdminResponse.Unmarshal(m, b)
+}
+func (m *NodeAdminResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeAdminResponse.Marshal(b, m, deterministic)
+}
+func (m *NodeAdminResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeAdminResponse.Merge(m, src)
+}
+func (m *NodeAdminResponse) XXX_Size() int {
+	return xxx_messageInfo_NodeAdminResponse.Size(m)
+}
+func (m *NodeAdminResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeAdminResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeAdminResponse proto.InternalMessageInfo
+
+func (m *NodeAdminResponse) GetDisqualified() *timestamp.Timestamp {
+	if m != nil {
+		return m.Disqualified
+	}
+	return nil
+}
+
+func (m *NodeAdminResponse) GetSuspended() *timestamp.Timestamp {
+	if m != nil {
+		return m.Suspended
+	}
+	return nil
+}
+
+func (m *NodeAdminResponse) GetQuarantined() *timestamp.Timestamp {
+	if m != nil {
+		return m.Quarantined
+	}
+	return nil
+}
+
+type NodeAdminLogEntry struct {
+	NodeId   NodeID `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	Action   string `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	Operator string `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"`
+	// operator_id is the identity the change was made with
+	OperatorId           NodeID               `protobuf:"bytes,4,opt,name=operator_id,json=operatorId,proto3,customtype=NodeID" json:"operator_id"`
+	Details              string               `protobuf:"bytes,5,opt,name=details,proto3" json:"details,omitempty"`
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *NodeAdminLogEntry) Reset()         { *m = NodeAdminLogEntry{} }
+func (m *NodeAdminLogEntry) String() string { return proto.CompactTextString(m) }
+func (*NodeAdminLogEntry) ProtoMessage()    {}
+func (*NodeAdminLogEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{11}
+}
+func (m *NodeAdminLogEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeAdminLogEntry.Unmarshal(m, b)
+}
+func (m *NodeAdminLogEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeAdminLogEntry.Marshal(b, m, deterministic)
+}
+func (m *NodeAdminLogEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeAdminLogEntry.Merge(m, src)
+}
+func (m *NodeAdminLogEntry) XXX_Size() int {
+	return xxx_messageInfo_NodeAdminLogEntry.Size(m)
+}
+func (m *NodeAdminLogEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeAdminLogEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeAdminLogEntry proto.InternalMessageInfo
+
+func (m *NodeAdminLogEntry) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *NodeAdminLogEntry) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *NodeAdminLogEntry) GetDetails() string {
+	if m != nil {
+		return m.Details
+	}
+	return ""
+}
+
+func (m *NodeAdminLogEntry) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+type GetNodeLogRequest struct {
+	NodeId               NodeID   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	Limit                int32    `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetNodeLogRequest) Reset()         { *m = GetNodeLogRequest{} }
+func (m *GetNodeLogRequest) String() string { return proto.CompactTextString(m) }
+func (*GetNodeLogRequest) ProtoMessage()    {}
+func (*GetNodeLogRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{12}
+}
+func (m *GetNodeLogRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetNodeLogRequest.Unmarshal(m, b)
+}
+func (m *GetNodeLogRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetNodeLogRequest.Marshal(b, m, deterministic)
+}
+func (m *GetNodeLogRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetNodeLogRequest.Merge(m, src)
+}
+func (m *GetNodeLogRequest) XXX_Size() int {
+	return xxx_messageInfo_GetNodeLogRequest.Size(m)
+}
+func (m *GetNodeLogRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetNodeLogRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetNodeLogRequest proto.InternalMessageInfo
+
+func (m *GetNodeLogRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type GetNodeLogResponse struct {
+	Entries              []*NodeAdminLogEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *GetNodeLogResponse) Reset()         { *m = GetNodeLogResponse{} }
+func (m *GetNodeLogResponse) String() string { return proto.CompactTextString(m) }
+func (*GetNodeLogResponse) ProtoMessage()    {}
+func (*GetNodeLogResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{13}
+}
+func (m *GetNodeLogResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetNodeLogResponse.Unmarshal(m, b)
+}
+func (m *GetNodeLogResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetNodeLogResponse.Marshal(b, m, deterministic)
+}
+func (m *GetNodeLogResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetNodeLogResponse.Merge(m, src)
+}
+func (m *GetNodeLogResponse) XXX_Size() int {
+	return xxx_messageInfo_GetNodeLogResponse.Size(m)
+}
+func (m *GetNodeLogResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetNodeLogResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetNodeLogResponse proto.InternalMessageInfo
+
+func (m *GetNodeLogResponse) GetEntries() []*NodeAdminLogEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+type ExportNodesRequest struct {
+	Offset               int64    `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit                int32    `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportNodesRequest) Reset()         { *m = ExportNodesRequest{} }
+func (m *ExportNodesRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportNodesRequest) ProtoMessage()    {}
+func (*ExportNodesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{14}
+}
+func (m *ExportNodesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportNodesRequest.Unmarshal(m, b)
+}
+func (m *ExportNodesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportNodesRequest.Marshal(b, m, deterministic)
+}
+func (m *ExportNodesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportNodesRequest.Merge(m, src)
+}
+func (m *ExportNodesRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportNodesRequest.Size(m)
+}
+func (m *ExportNodesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportNodesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportNodesRequest proto.InternalMessageInfo
+
+func (m *ExportNodesRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *ExportNodesRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type ExportNodesResponse struct {
+	Nodes                []*ExportNodesResponse_Node `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	More                 bool                        `protobuf:"varint,2,opt,name=more,proto3" json:"more,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *ExportNodesResponse) Reset()         { *m = ExportNodesResponse{} }
+func (m *ExportNodesResponse) String() string { return proto.CompactTextString(m) }
+func (*ExportNodesResponse) ProtoMessage()    {}
+func (*ExportNodesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{15}
+}
+func (m *ExportNodesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportNodesResponse.Unmarshal(m, b)
+}
+func (m *ExportNodesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportNodesResponse.Marshal(b, m, deterministic)
+}
+func (m *ExportNodesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportNodesResponse.Merge(m, src)
+}
+func (m *ExportNodesResponse) XXX_Size() int {
+	return xxx_messageInfo_ExportNodesResponse.Size(m)
+}
+func (m *ExportNodesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportNodesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportNodesResponse proto.InternalMessageInfo
+
+func (m *ExportNodesResponse) GetNodes() []*ExportNodesResponse_Node {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+func (m *ExportNodesResponse) GetMore() bool {
+	if m != nil {
+		return m.More
+	}
+	return false
+}
+
+type ExportNodesResponse_Node struct {
+	NodeId               NodeID               `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	Address              string               `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	LastNet              string               `protobuf:"bytes,3,opt,name=last_net,json=lastNet,proto3" json:"last_net,omitempty"`
+	CountryCode          string               `protobuf:"bytes,4,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"`
+	Email                string               `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
+	Wallet               string               `protobuf:"bytes,6,opt,name=wallet,proto3" json:"wallet,omitempty"`
+	FreeBandwidth        int64                `protobuf:"varint,7,opt,name=free_bandwidth,json=freeBandwidth,proto3" json:"free_bandwidth,omitempty"`
+	FreeDisk             int64                `protobuf:"varint,8,opt,name=free_disk,json=freeDisk,proto3" json:"free_disk,omitempty"`
+	Version              string               `protobuf:"bytes,9,opt,name=version,proto3" json:"version,omitempty"`
+	AuditCount           int64                `protobuf:"varint,10,opt,name=audit_count,json=auditCount,proto3" json:"audit_count,omitempty"`
+	AuditSuccessCount    int64                `protobuf:"varint,11,opt,name=audit_success_count,json=auditSuccessCount,proto3" json:"audit_success_count,omitempty"`
+	UptimeCount          int64                `protobuf:"varint,12,opt,name=uptime_count,json=uptimeCount,proto3" json:"uptime_count,omitempty"`
+	UptimeSuccessCount   int64                `protobuf:"varint,13,opt,name=uptime_success_count,json=uptimeSuccessCount,proto3" json:"uptime_success_count,omitempty"`
+	AuditReputation      float64              `protobuf:"fixed64,14,opt,name=audit_reputation,json=auditReputation,proto3" json:"audit_reputation,omitempty"`
+	UptimeReputation     float64              `protobuf:"fixed64,15,opt,name=uptime_reputation,json=uptimeReputation,proto3" json:"uptime_reputation,omitempty"`
+	LastContactSuccess   *timestamp.Timestamp `protobuf:"bytes,16,opt,name=last_contact_success,json=lastContactSuccess,proto3" json:"last_contact_success,omitempty"`
+	LastContactFailure   *timestamp.Timestamp `protobuf:"bytes,17,opt,name=last_contact_failure,json=lastContactFailure,proto3" json:"last_contact_failure,omitempty"`
+	Disqualified         *timestamp.Timestamp `protobuf:"bytes,18,opt,name=disqualified,proto3" json:"disqualified,omitempty"`
+	Suspended            *timestamp.Timestamp `protobuf:"bytes,19,opt,name=suspended,proto3" json:"suspended,omitempty"`
+	Quarantined          *timestamp.Timestamp `protobuf:"bytes,20,opt,name=quarantined,proto3" json:"quarantined,omitempty"`
+	ExitInitiatedAt      *timestamp.Timestamp `protobuf:"bytes,21,opt,name=exit_initiated_at,json=exitInitiatedAt,proto3" json:"exit_initiated_at,omitempty"`
+	ExitFinishedAt       *timestamp.Timestamp `protobuf:"bytes,22,opt,name=exit_finished_at,json=exitFinishedAt,proto3" json:"exit_finished_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ExportNodesResponse_Node) Reset()         { *m = ExportNodesResponse_Node{} }
+func (m *ExportNodesResponse_Node) String() string { return proto.CompactTextString(m) }
+func (*ExportNodesResponse_Node) ProtoMessage()    {}
+func (*ExportNodesResponse_Node) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{15, 0}
+}
+func (m *ExportNodesResponse_Node) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportNodesResponse_Node.Unmarshal(m, b)
+}
+func (m *ExportNodesResponse_Node) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportNodesResponse_Node.Marshal(b, m, deterministic)
+}
+func (m *ExportNodesResponse_Node) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportNodesResponse_Node.Merge(m, src)
+}
+func (m *ExportNodesResponse_Node) XXX_Size() int {
+	return xxx_messageInfo_ExportNodesResponse_Node.Size(m)
+}
+func (m *ExportNodesResponse_Node) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportNodesResponse_Node.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportNodesResponse_Node proto.InternalMessageInfo
+
+func (m *ExportNodesResponse_Node) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ExportNodesResponse_Node) GetLastNet() string {
+	if m != nil {
+		return m.LastNet
+	}
+	return ""
+}
+
+func (m *ExportNodesResponse_Node) GetCountryCode() string {
+	if m != nil {
+		return m.CountryCode
+	}
+	return ""
+}
+
+func (m *ExportNodesResponse_Node) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func (m *ExportNodesResponse_Node) GetWallet() string {
+	if m != nil {
+		return m.Wallet
+	}
+	return ""
+}
+
+func (m *ExportNodesResponse_Node) GetFreeBandwidth() int64 {
+	if m != nil {
+		return m.FreeBandwidth
+	}
+	return 0
+}
+
+func (m *ExportNodesResponse_Node) GetFreeDisk() int64 {
+	if m != nil {
+		return m.FreeDisk
+	}
+	return 0
+}
+
+func (m *ExportNodesResponse_Node) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *ExportNodesResponse_Node) GetAuditCount() int64 {
+	if m != nil {
+		return m.AuditCount
+	}
+	return 0
+}
+
+func (m *ExportNodesResponse_Node) GetAuditSuccessCount() int64 {
+	if m != nil {
+		return m.AuditSuccessCount
+	}
+	return 0
+}
+
+func (m *ExportNodesResponse_Node) GetUptimeCount() int64 {
+	if m != nil {
+		return m.UptimeCount
+	}
+	return 0
+}
+
+func (m *ExportNodesResponse_Node) GetUptimeSuccessCount() int64 {
+	if m != nil {
+		return m.UptimeSuccessCount
+	}
+	return 0
+}
+
+func (m *ExportNodesResponse_Node) GetAuditReputation() float64 {
+	if m != nil {
+		return m.AuditReputation
+	}
+	return 0
+}
+
+func (m *ExportNodesResponse_Node) GetUptimeReputation() float64 {
+	if m != nil {
+		return m.UptimeReputation
+	}
+	return 0
+}
+
+func (m *ExportNodesResponse_Node) GetLastContactSuccess() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastContactSuccess
+	}
+	return nil
+}
+
+func (m *ExportNodesResponse_Node) GetLastContactFailure() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastContactFailure
+	}
+	return nil
+}
+
+func (m *ExportNodesResponse_Node) GetDisqualified() *timestamp.Timestamp {
+	if m != nil {
+		return m.Disqualified
+	}
+	return nil
+}
+
+func (m *ExportNodesResponse_Node) GetSuspended() *timestamp.Timestamp {
+	if m != nil {
+		return m.Suspended
+	}
+	return nil
+}
+
+func (m *ExportNodesResponse_Node) GetQuarantined() *timestamp.Timestamp {
+	if m != nil {
+		return m.Quarantined
+	}
+	return nil
+}
+
+func (m *ExportNodesResponse_Node) GetExitInitiatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExitInitiatedAt
+	}
+	return nil
+}
+
+func (m *ExportNodesResponse_Node) GetExitFinishedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExitFinishedAt
+	}
+	return nil
+}
+
+// GetUptimeHistory
+type GetUptimeHistoryRequest struct {
+	NodeId               NodeID             `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	Window               *duration.Duration `protobuf:"bytes,2,opt,name=window,proto3" json:"window,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *GetUptimeHistoryRequest) Reset()         { *m = GetUptimeHistoryRequest{} }
+func (m *GetUptimeHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*GetUptimeHistoryRequest) ProtoMessage()    {}
+func (*GetUptimeHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{16}
+}
+func (m *GetUptimeHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetUptimeHistoryRequest.Unmarshal(m, b)
+}
+func (m *GetUptimeHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetUptimeHistoryRequest.Marshal(b, m, deterministic)
+}
+func (m *GetUptimeHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetUptimeHistoryRequest.Merge(m, src)
+}
+func (m *GetUptimeHistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_GetUptimeHistoryRequest.Size(m)
+}
+func (m *GetUptimeHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetUptimeHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetUptimeHistoryRequest proto.InternalMessageInfo
+
+func (m *GetUptimeHistoryRequest) GetWindow() *duration.Duration {
+	if m != nil {
+		return m.Window
+	}
+	return nil
+}
+
+type GetUptimeHistoryResponse struct {
+	Since                *timestamp.Timestamp                       `protobuf:"bytes,1,opt,name=since,proto3" json:"since,omitempty"`
+	Until                *timestamp.Timestamp                       `protobuf:"bytes,2,opt,name=until,proto3" json:"until,omitempty"`
+	Attempts             int64                                      `protobuf:"varint,3,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	FailedAttempts       int64                                      `protobuf:"varint,4,opt,name=failed_attempts,json=failedAttempts,proto3" json:"failed_attempts,omitempty"`
+	Downtime             *duration.Duration                         `protobuf:"bytes,5,opt,name=downtime,proto3" json:"downtime,omitempty"`
+	Windows              []*GetUptimeHistoryResponse_DowntimeWindow `protobuf:"bytes,6,rep,name=windows,proto3" json:"windows,omitempty"`
+	Suspended            *timestamp.Timestamp                       `protobuf:"bytes,7,opt,name=suspended,proto3" json:"suspended,omitempty"`
+	Disqualified         *timestamp.Timestamp                       `protobuf:"bytes,8,opt,name=disqualified,proto3" json:"disqualified,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                   `json:"-"`
+	XXX_unrecognized     []byte                                     `json:"-"`
+	XXX_sizecache        int32                                      `json:"-"`
+}
+
+func (m *GetUptimeHistoryResponse) Reset()         { *m = GetUptimeHistoryResponse{} }
+func (m *GetUptimeHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*GetUptimeHistoryResponse) ProtoMessage()    {}
+func (*GetUptimeHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{17}
 }
-func (m *CreateStatsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateStatsRequest.Unmarshal(m, b)
+func (m *GetUptimeHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetUptimeHistoryResponse.Unmarshal(m, b)
 }
-func (m *CreateStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateStatsRequest.Marshal(b, m, deterministic)
+func (m *GetUptimeHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetUptimeHistoryResponse.Marshal(b, m, deterministic)
 }
-func (m *CreateStatsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateStatsRequest.Merge(m, src)
+func (m *GetUptimeHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetUptimeHistoryResponse.Merge(m, src)
 }
-func (m *CreateStatsRequest) XXX_Size() int {
-	return xxx_messageInfo_CreateStatsRequest.Size(m)
+func (m *GetUptimeHistoryResponse) XXX_Size() int {
+	return xxx_messageInfo_GetUptimeHistoryResponse.Size(m)
 }
-func (m *CreateStatsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateStatsRequest.DiscardUnknown(m)
+func (m *GetUptimeHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetUptimeHistoryResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateStatsRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetUptimeHistoryResponse proto.InternalMessageInfo
 
-func (m *CreateStatsRequest) GetAuditCount() int64 {
+func (m *GetUptimeHistoryResponse) GetSince() *timestamp.Timestamp {
 	if m != nil {
-		return m.AuditCount
+		return m.Since
 	}
-	return 0
+	return nil
 }
 
-func (m *CreateStatsRequest) GetAuditSuccessCount() int64 {
+func (m *GetUptimeHistoryResponse) GetUntil() *timestamp.Timestamp {
 	if m != nil {
-		return m.AuditSuccessCount
+		return m.Until
 	}
-	return 0
+	return nil
 }
 
-func (m *CreateStatsRequest) GetUptimeCount() int64 {
+func (m *GetUptimeHistoryResponse) GetAttempts() int64 {
 	if m != nil {
-		return m.UptimeCount
+		return m.Attempts
 	}
 	return 0
 }
 
-func (m *CreateStatsRequest) GetUptimeSuccessCount() int64 {
+func (m *GetUptimeHistoryResponse) GetFailedAttempts() int64 {
 	if m != nil {
-		return m.UptimeSuccessCount
+		return m.FailedAttempts
 	}
 	return 0
 }
 
-type CreateStatsResponse struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *GetUptimeHistoryResponse) GetDowntime() *duration.Duration {
+	if m != nil {
+		return m.Downtime
+	}
+	return nil
 }
 
-func (m *CreateStatsResponse) Reset()         { *m = CreateStatsResponse{} }
-func (m *CreateStatsResponse) String() string { return proto.CompactTextString(m) }
-func (*CreateStatsResponse) ProtoMessage()    {}
-func (*CreateStatsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{6}
+func (m *GetUptimeHistoryResponse) GetWindows() []*GetUptimeHistoryResponse_DowntimeWindow {
+	if m != nil {
+		return m.Windows
+	}
+	return nil
 }
-func (m *CreateStatsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateStatsResponse.Unmarshal(m, b)
+
+func (m *GetUptimeHistoryResponse) GetSuspended() *timestamp.Timestamp {
+	if m != nil {
+		return m.Suspended
+	}
+	return nil
 }
-func (m *CreateStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateStatsResponse.Marshal(b, m, deterministic)
+
+func (m *GetUptimeHistoryResponse) GetDisqualified() *timestamp.Timestamp {
+	if m != nil {
+		return m.Disqualified
+	}
+	return nil
 }
-func (m *CreateStatsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateStatsResponse.Merge(m, src)
+
+type GetUptimeHistoryResponse_DowntimeWindow struct {
+	Start                *timestamp.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	End                  *timestamp.Timestamp `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
-func (m *CreateStatsResponse) XXX_Size() int {
-	return xxx_messageInfo_CreateStatsResponse.Size(m)
+
+func (m *GetUptimeHistoryResponse_DowntimeWindow) Reset() {
+	*m = GetUptimeHistoryResponse_DowntimeWindow{}
 }
-func (m *CreateStatsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateStatsResponse.DiscardUnknown(m)
+func (m *GetUptimeHistoryResponse_DowntimeWindow) String() string { return proto.CompactTextString(m) }
+func (*GetUptimeHistoryResponse_DowntimeWindow) ProtoMessage()    {}
+func (*GetUptimeHistoryResponse_DowntimeWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{17, 0}
+}
+func (m *GetUptimeHistoryResponse_DowntimeWindow) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetUptimeHistoryResponse_DowntimeWindow.Unmarshal(m, b)
+}
+func (m *GetUptimeHistoryResponse_DowntimeWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetUptimeHistoryResponse_DowntimeWindow.Marshal(b, m, deterministic)
+}
+func (m *GetUptimeHistoryResponse_DowntimeWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetUptimeHistoryResponse_DowntimeWindow.Merge(m, src)
+}
+func (m *GetUptimeHistoryResponse_DowntimeWindow) XXX_Size() int {
+	return xxx_messageInfo_GetUptimeHistoryResponse_DowntimeWindow.Size(m)
+}
+func (m *GetUptimeHistoryResponse_DowntimeWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetUptimeHistoryResponse_DowntimeWindow.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateStatsResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetUptimeHistoryResponse_DowntimeWindow proto.InternalMessageInfo
+
+func (m *GetUptimeHistoryResponse_DowntimeWindow) GetStart() *timestamp.Timestamp {
+	if m != nil {
+		return m.Start
+	}
+	return nil
+}
+
+func (m *GetUptimeHistoryResponse_DowntimeWindow) GetEnd() *timestamp.Timestamp {
+	if m != nil {
+		return m.End
+	}
+	return nil
+}
 
 // CountNodes
 type CountNodesResponse struct {
@@ -380,7 +1208,7 @@ func (m *CountNodesResponse) Reset()         { *m = CountNodesResponse{} }
 func (m *CountNodesResponse) String() string { return proto.CompactTextString(m) }
 func (*CountNodesResponse) ProtoMessage()    {}
 func (*CountNodesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{7}
+	return fileDescriptor_a07d9034b2dd9d26, []int{18}
 }
 func (m *CountNodesResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CountNodesResponse.Unmarshal(m, b)
@@ -417,7 +1245,7 @@ func (m *CountNodesRequest) Reset()         { *m = CountNodesRequest{} }
 func (m *CountNodesRequest) String() string { return proto.CompactTextString(m) }
 func (*CountNodesRequest) ProtoMessage()    {}
 func (*CountNodesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{8}
+	return fileDescriptor_a07d9034b2dd9d26, []int{19}
 }
 func (m *CountNodesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CountNodesRequest.Unmarshal(m, b)
@@ -448,7 +1276,7 @@ func (m *GetBucketsRequest) Reset()         { *m = GetBucketsRequest{} }
 func (m *GetBucketsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetBucketsRequest) ProtoMessage()    {}
 func (*GetBucketsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{9}
+	return fileDescriptor_a07d9034b2dd9d26, []int{20}
 }
 func (m *GetBucketsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetBucketsRequest.Unmarshal(m, b)
@@ -480,7 +1308,7 @@ func (m *GetBucketsResponse) Reset()         { *m = GetBucketsResponse{} }
 func (m *GetBucketsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetBucketsResponse) ProtoMessage()    {}
 func (*GetBucketsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{10}
+	return fileDescriptor_a07d9034b2dd9d26, []int{21}
 }
 func (m *GetBucketsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetBucketsResponse.Unmarshal(m, b)
@@ -519,7 +1347,7 @@ func (m *GetBucketRequest) Reset()         { *m = GetBucketRequest{} }
 func (m *GetBucketRequest) String() string { return proto.CompactTextString(m) }
 func (*GetBucketRequest) ProtoMessage()    {}
 func (*GetBucketRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{11}
+	return fileDescriptor_a07d9034b2dd9d26, []int{22}
 }
 func (m *GetBucketRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetBucketRequest.Unmarshal(m, b)
@@ -551,7 +1379,7 @@ func (m *GetBucketResponse) Reset()         { *m = GetBucketResponse{} }
 func (m *GetBucketResponse) String() string { return proto.CompactTextString(m) }
 func (*GetBucketResponse) ProtoMessage()    {}
 func (*GetBucketResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{12}
+	return fileDescriptor_a07d9034b2dd9d26, []int{23}
 }
 func (m *GetBucketResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetBucketResponse.Unmarshal(m, b)
@@ -589,7 +1417,7 @@ func (m *Bucket) Reset()         { *m = Bucket{} }
 func (m *Bucket) String() string { return proto.CompactTextString(m) }
 func (*Bucket) ProtoMessage()    {}
 func (*Bucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{13}
+	return fileDescriptor_a07d9034b2dd9d26, []int{24}
 }
 func (m *Bucket) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Bucket.Unmarshal(m, b)
@@ -627,7 +1455,7 @@ func (m *BucketList) Reset()         { *m = BucketList{} }
 func (m *BucketList) String() string { return proto.CompactTextString(m) }
 func (*BucketList) ProtoMessage()    {}
 func (*BucketList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{14}
+	return fileDescriptor_a07d9034b2dd9d26, []int{25}
 }
 func (m *BucketList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BucketList.Unmarshal(m, b)
@@ -667,7 +1495,7 @@ func (m *PingNodeRequest) Reset()         { *m = PingNodeRequest{} }
 func (m *PingNodeRequest) String() string { return proto.CompactTextString(m) }
 func (*PingNodeRequest) ProtoMessage()    {}
 func (*PingNodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{15}
+	return fileDescriptor_a07d9034b2dd9d26, []int{26}
 }
 func (m *PingNodeRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PingNodeRequest.Unmarshal(m, b)
@@ -705,7 +1533,7 @@ func (m *PingNodeResponse) Reset()         { *m = PingNodeResponse{} }
 func (m *PingNodeResponse) String() string { return proto.CompactTextString(m) }
 func (*PingNodeResponse) ProtoMessage()    {}
 func (*PingNodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{16}
+	return fileDescriptor_a07d9034b2dd9d26, []int{27}
 }
 func (m *PingNodeResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PingNodeResponse.Unmarshal(m, b)
@@ -744,7 +1572,7 @@ func (m *LookupNodeRequest) Reset()         { *m = LookupNodeRequest{} }
 func (m *LookupNodeRequest) String() string { return proto.CompactTextString(m) }
 func (*LookupNodeRequest) ProtoMessage()    {}
 func (*LookupNodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{17}
+	return fileDescriptor_a07d9034b2dd9d26, []int{28}
 }
 func (m *LookupNodeRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LookupNodeRequest.Unmarshal(m, b)
@@ -790,7 +1618,7 @@ func (m *LookupNodeResponse) Reset()         { *m = LookupNodeResponse{} }
 func (m *LookupNodeResponse) String() string { return proto.CompactTextString(m) }
 func (*LookupNodeResponse) ProtoMessage()    {}
 func (*LookupNodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{18}
+	return fileDescriptor_a07d9034b2dd9d26, []int{29}
 }
 func (m *LookupNodeResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LookupNodeResponse.Unmarshal(m, b)
@@ -836,7 +1664,7 @@ func (m *NodeInfoRequest) Reset()         { *m = NodeInfoRequest{} }
 func (m *NodeInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*NodeInfoRequest) ProtoMessage()    {}
 func (*NodeInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{19}
+	return fileDescriptor_a07d9034b2dd9d26, []int{30}
 }
 func (m *NodeInfoRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NodeInfoRequest.Unmarshal(m, b)
@@ -877,7 +1705,7 @@ func (m *NodeInfoResponse) Reset()         { *m = NodeInfoResponse{} }
 func (m *NodeInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*NodeInfoResponse) ProtoMessage()    {}
 func (*NodeInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{20}
+	return fileDescriptor_a07d9034b2dd9d26, []int{31}
 }
 func (m *NodeInfoResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NodeInfoResponse.Unmarshal(m, b)
@@ -938,7 +1766,7 @@ func (m *FindNearRequest) Reset()         { *m = FindNearRequest{} }
 func (m *FindNearRequest) String() string { return proto.CompactTextString(m) }
 func (*FindNearRequest) ProtoMessage()    {}
 func (*FindNearRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{21}
+	return fileDescriptor_a07d9034b2dd9d26, []int{32}
 }
 func (m *FindNearRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FindNearRequest.Unmarshal(m, b)
@@ -976,7 +1804,7 @@ func (m *FindNearResponse) Reset()         { *m = FindNearResponse{} }
 func (m *FindNearResponse) String() string { return proto.CompactTextString(m) }
 func (*FindNearResponse) ProtoMessage()    {}
 func (*FindNearResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{22}
+	return fileDescriptor_a07d9034b2dd9d26, []int{33}
 }
 func (m *FindNearResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FindNearResponse.Unmarshal(m, b)
@@ -1013,7 +1841,7 @@ func (m *DumpNodesRequest) Reset()         { *m = DumpNodesRequest{} }
 func (m *DumpNodesRequest) String() string { return proto.CompactTextString(m) }
 func (*DumpNodesRequest) ProtoMessage()    {}
 func (*DumpNodesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{23}
+	return fileDescriptor_a07d9034b2dd9d26, []int{34}
 }
 func (m *DumpNodesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DumpNodesRequest.Unmarshal(m, b)
@@ -1044,7 +1872,7 @@ func (m *DumpNodesResponse) Reset()         { *m = DumpNodesResponse{} }
 func (m *DumpNodesResponse) String() string { return proto.CompactTextString(m) }
 func (*DumpNodesResponse) ProtoMessage()    {}
 func (*DumpNodesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{24}
+	return fileDescriptor_a07d9034b2dd9d26, []int{35}
 }
 func (m *DumpNodesResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DumpNodesResponse.Unmarshal(m, b)
@@ -1064,11 +1892,298 @@ func (m *DumpNodesResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DumpNodesResponse proto.InternalMessageInfo
 
-func (m *DumpNodesResponse) GetNodes() []*Node {
+func (m *DumpNodesResponse) GetNodes() []*Node {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+type GetBucketListRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetBucketListRequest) Reset()         { *m = GetBucketListRequest{} }
+func (m *GetBucketListRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBucketListRequest) ProtoMessage()    {}
+func (*GetBucketListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{36}
+}
+func (m *GetBucketListRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBucketListRequest.Unmarshal(m, b)
+}
+func (m *GetBucketListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBucketListRequest.Marshal(b, m, deterministic)
+}
+func (m *GetBucketListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBucketListRequest.Merge(m, src)
+}
+func (m *GetBucketListRequest) XXX_Size() int {
+	return xxx_messageInfo_GetBucketListRequest.Size(m)
+}
+func (m *GetBucketListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBucketListRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBucketListRequest proto.InternalMessageInfo
+
+type GetBucketListResponse struct {
+	Buckets              []*GetBucketListResponse_Bucket `protobuf:"bytes,1,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	BucketSize           int64                           `protobuf:"varint,2,opt,name=bucket_size,json=bucketSize,proto3" json:"bucket_size,omitempty"`
+	ReplacementCacheSize int64                           `protobuf:"varint,3,opt,name=replacement_cache_size,json=replacementCacheSize,proto3" json:"replacement_cache_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_unrecognized     []byte                          `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
+}
+
+func (m *GetBucketListResponse) Reset()         { *m = GetBucketListResponse{} }
+func (m *GetBucketListResponse) String() string { return proto.CompactTextString(m) }
+func (*GetBucketListResponse) ProtoMessage()    {}
+func (*GetBucketListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{37}
+}
+func (m *GetBucketListResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBucketListResponse.Unmarshal(m, b)
+}
+func (m *GetBucketListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBucketListResponse.Marshal(b, m, deterministic)
+}
+func (m *GetBucketListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBucketListResponse.Merge(m, src)
+}
+func (m *GetBucketListResponse) XXX_Size() int {
+	return xxx_messageInfo_GetBucketListResponse.Size(m)
+}
+func (m *GetBucketListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBucketListResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBucketListResponse proto.InternalMessageInfo
+
+func (m *GetBucketListResponse) GetBuckets() []*GetBucketListResponse_Bucket {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
+func (m *GetBucketListResponse) GetBucketSize() int64 {
+	if m != nil {
+		return m.BucketSize
+	}
+	return 0
+}
+
+func (m *GetBucketListResponse) GetReplacementCacheSize() int64 {
+	if m != nil {
+		return m.ReplacementCacheSize
+	}
+	return 0
+}
+
+type GetBucketListResponse_Bucket struct {
+	BucketId             NodeID               `protobuf:"bytes,1,opt,name=bucket_id,json=bucketId,proto3,customtype=NodeID" json:"bucket_id"`
+	RoutingNodes         []*Node              `protobuf:"bytes,2,rep,name=routing_nodes,json=routingNodes,proto3" json:"routing_nodes,omitempty"`
+	CachedNodes          []*Node              `protobuf:"bytes,3,rep,name=cached_nodes,json=cachedNodes,proto3" json:"cached_nodes,omitempty"`
+	LastUpdated          *timestamp.Timestamp `protobuf:"bytes,4,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *GetBucketListResponse_Bucket) Reset()         { *m = GetBucketListResponse_Bucket{} }
+func (m *GetBucketListResponse_Bucket) String() string { return proto.CompactTextString(m) }
+func (*GetBucketListResponse_Bucket) ProtoMessage()    {}
+func (*GetBucketListResponse_Bucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{37, 0}
+}
+func (m *GetBucketListResponse_Bucket) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBucketListResponse_Bucket.Unmarshal(m, b)
+}
+func (m *GetBucketListResponse_Bucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBucketListResponse_Bucket.Marshal(b, m, deterministic)
+}
+func (m *GetBucketListResponse_Bucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBucketListResponse_Bucket.Merge(m, src)
+}
+func (m *GetBucketListResponse_Bucket) XXX_Size() int {
+	return xxx_messageInfo_GetBucketListResponse_Bucket.Size(m)
+}
+func (m *GetBucketListResponse_Bucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBucketListResponse_Bucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBucketListResponse_Bucket proto.InternalMessageInfo
+
+func (m *GetBucketListResponse_Bucket) GetRoutingNodes() []*Node {
+	if m != nil {
+		return m.RoutingNodes
+	}
+	return nil
+}
+
+func (m *GetBucketListResponse_Bucket) GetCachedNodes() []*Node {
+	if m != nil {
+		return m.CachedNodes
+	}
+	return nil
+}
+
+func (m *GetBucketListResponse_Bucket) GetLastUpdated() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastUpdated
+	}
+	return nil
+}
+
+type TraceLookupRequest struct {
+	Id                   NodeID   `protobuf:"bytes,1,opt,name=id,proto3,customtype=NodeID" json:"id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TraceLookupRequest) Reset()         { *m = TraceLookupRequest{} }
+func (m *TraceLookupRequest) String() string { return proto.CompactTextString(m) }
+func (*TraceLookupRequest) ProtoMessage()    {}
+func (*TraceLookupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{38}
+}
+func (m *TraceLookupRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TraceLookupRequest.Unmarshal(m, b)
+}
+func (m *TraceLookupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TraceLookupRequest.Marshal(b, m, deterministic)
+}
+func (m *TraceLookupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TraceLookupRequest.Merge(m, src)
+}
+func (m *TraceLookupRequest) XXX_Size() int {
+	return xxx_messageInfo_TraceLookupRequest.Size(m)
+}
+func (m *TraceLookupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TraceLookupRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TraceLookupRequest proto.InternalMessageInfo
+
+type TraceLookupResponse struct {
+	Node                 *Node              `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Hops                 []*LookupHop       `protobuf:"bytes,2,rep,name=hops,proto3" json:"hops,omitempty"`
+	Duration             *duration.Duration `protobuf:"bytes,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	Error                string             `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *TraceLookupResponse) Reset()         { *m = TraceLookupResponse{} }
+func (m *TraceLookupResponse) String() string { return proto.CompactTextString(m) }
+func (*TraceLookupResponse) ProtoMessage()    {}
+func (*TraceLookupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{39}
+}
+func (m *TraceLookupResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TraceLookupResponse.Unmarshal(m, b)
+}
+func (m *TraceLookupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TraceLookupResponse.Marshal(b, m, deterministic)
+}
+func (m *TraceLookupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TraceLookupResponse.Merge(m, src)
+}
+func (m *TraceLookupResponse) XXX_Size() int {
+	return xxx_messageInfo_TraceLookupResponse.Size(m)
+}
+func (m *TraceLookupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TraceLookupResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TraceLookupResponse proto.InternalMessageInfo
+
+func (m *TraceLookupResponse) GetNode() *Node {
+	if m != nil {
+		return m.Node
+	}
+	return nil
+}
+
+func (m *TraceLookupResponse) GetHops() []*LookupHop {
+	if m != nil {
+		return m.Hops
+	}
+	return nil
+}
+
+func (m *TraceLookupResponse) GetDuration() *duration.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return nil
+}
+
+func (m *TraceLookupResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type LookupHop struct {
+	Node                 *Node              `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	ResponseTime         *duration.Duration `protobuf:"bytes,2,opt,name=response_time,json=responseTime,proto3" json:"response_time,omitempty"`
+	ReturnedIds          []NodeID           `protobuf:"bytes,3,rep,name=returned_ids,json=returnedIds,proto3,customtype=NodeID" json:"returned_ids,omitempty"`
+	Error                string             `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *LookupHop) Reset()         { *m = LookupHop{} }
+func (m *LookupHop) String() string { return proto.CompactTextString(m) }
+func (*LookupHop) ProtoMessage()    {}
+func (*LookupHop) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{40}
+}
+func (m *LookupHop) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LookupHop.Unmarshal(m, b)
+}
+func (m *LookupHop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LookupHop.Marshal(b, m, deterministic)
+}
+func (m *LookupHop) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LookupHop.Merge(m, src)
+}
+func (m *LookupHop) XXX_Size() int {
+	return xxx_messageInfo_LookupHop.Size(m)
+}
+func (m *LookupHop) XXX_DiscardUnknown() {
+	xxx_messageInfo_LookupHop.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LookupHop proto.InternalMessageInfo
+
+func (m *LookupHop) GetNode() *Node {
+	if m != nil {
+		return m.Node
+	}
+	return nil
+}
+
+func (m *LookupHop) GetResponseTime() *duration.Duration {
+	if m != nil {
+		return m.ResponseTime
+	}
+	return nil
+}
+
+func (m *LookupHop) GetError() string {
 	if m != nil {
-		return m.Nodes
+		return m.Error
 	}
-	return nil
+	return ""
 }
 
 type StatsRequest struct {
@@ -1081,7 +2196,7 @@ func (m *StatsRequest) Reset()         { *m = StatsRequest{} }
 func (m *StatsRequest) String() string { return proto.CompactTextString(m) }
 func (*StatsRequest) ProtoMessage()    {}
 func (*StatsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{25}
+	return fileDescriptor_a07d9034b2dd9d26, []int{41}
 }
 func (m *StatsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StatsRequest.Unmarshal(m, b)
@@ -1108,6 +2223,12 @@ type StatSummaryResponse struct {
 	UsedEgress           int64    `protobuf:"varint,4,opt,name=used_egress,json=usedEgress,proto3" json:"used_egress,omitempty"`
 	UsedBandwidth        int64    `protobuf:"varint,5,opt,name=used_bandwidth,json=usedBandwidth,proto3" json:"used_bandwidth,omitempty"`
 	AvailableBandwidth   int64    `protobuf:"varint,6,opt,name=available_bandwidth,json=availableBandwidth,proto3" json:"available_bandwidth,omitempty"`
+	ExpiredPiecesDeleted int64    `protobuf:"varint,7,opt,name=expired_pieces_deleted,json=expiredPiecesDeleted,proto3" json:"expired_pieces_deleted,omitempty"`
+	ExpiredBytesFreed    int64    `protobuf:"varint,8,opt,name=expired_bytes_freed,json=expiredBytesFreed,proto3" json:"expired_bytes_freed,omitempty"`
+	DiskFree             int64    `protobuf:"varint,9,opt,name=disk_free,json=diskFree,proto3" json:"disk_free,omitempty"`
+	DiskUsedEstimate     int64    `protobuf:"varint,10,opt,name=disk_used_estimate,json=diskUsedEstimate,proto3" json:"disk_used_estimate,omitempty"`
+	DiskFull             bool     `protobuf:"varint,11,opt,name=disk_full,json=diskFull,proto3" json:"disk_full,omitempty"`
+	DiskDiscrepancy      bool     `protobuf:"varint,12,opt,name=disk_discrepancy,json=diskDiscrepancy,proto3" json:"disk_discrepancy,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1117,7 +2238,7 @@ func (m *StatSummaryResponse) Reset()         { *m = StatSummaryResponse{} }
 func (m *StatSummaryResponse) String() string { return proto.CompactTextString(m) }
 func (*StatSummaryResponse) ProtoMessage()    {}
 func (*StatSummaryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{26}
+	return fileDescriptor_a07d9034b2dd9d26, []int{42}
 }
 func (m *StatSummaryResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StatSummaryResponse.Unmarshal(m, b)
@@ -1179,6 +2300,402 @@ func (m *StatSummaryResponse) GetAvailableBandwidth() int64 {
 	return 0
 }
 
+func (m *StatSummaryResponse) GetExpiredPiecesDeleted() int64 {
+	if m != nil {
+		return m.ExpiredPiecesDeleted
+	}
+	return 0
+}
+
+func (m *StatSummaryResponse) GetExpiredBytesFreed() int64 {
+	if m != nil {
+		return m.ExpiredBytesFreed
+	}
+	return 0
+}
+
+func (m *StatSummaryResponse) GetDiskFree() int64 {
+	if m != nil {
+		return m.DiskFree
+	}
+	return 0
+}
+
+func (m *StatSummaryResponse) GetDiskUsedEstimate() int64 {
+	if m != nil {
+		return m.DiskUsedEstimate
+	}
+	return 0
+}
+
+func (m *StatSummaryResponse) GetDiskFull() bool {
+	if m != nil {
+		return m.DiskFull
+	}
+	return false
+}
+
+func (m *StatSummaryResponse) GetDiskDiscrepancy() bool {
+	if m != nil {
+		return m.DiskDiscrepancy
+	}
+	return false
+}
+
+type SatelliteStatsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SatelliteStatsRequest) Reset()         { *m = SatelliteStatsRequest{} }
+func (m *SatelliteStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*SatelliteStatsRequest) ProtoMessage()    {}
+func (*SatelliteStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{43}
+}
+func (m *SatelliteStatsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SatelliteStatsRequest.Unmarshal(m, b)
+}
+func (m *SatelliteStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SatelliteStatsRequest.Marshal(b, m, deterministic)
+}
+func (m *SatelliteStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SatelliteStatsRequest.Merge(m, src)
+}
+func (m *SatelliteStatsRequest) XXX_Size() int {
+	return xxx_messageInfo_SatelliteStatsRequest.Size(m)
+}
+func (m *SatelliteStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SatelliteStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SatelliteStatsRequest proto.InternalMessageInfo
+
+type SatelliteStatsResponse struct {
+	Satellites           []*SatelliteStatsResponse_Satellite `protobuf:"bytes,1,rep,name=satellites,proto3" json:"satellites,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                            `json:"-"`
+	XXX_unrecognized     []byte                              `json:"-"`
+	XXX_sizecache        int32                               `json:"-"`
+}
+
+func (m *SatelliteStatsResponse) Reset()         { *m = SatelliteStatsResponse{} }
+func (m *SatelliteStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*SatelliteStatsResponse) ProtoMessage()    {}
+func (*SatelliteStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{44}
+}
+func (m *SatelliteStatsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SatelliteStatsResponse.Unmarshal(m, b)
+}
+func (m *SatelliteStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SatelliteStatsResponse.Marshal(b, m, deterministic)
+}
+func (m *SatelliteStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SatelliteStatsResponse.Merge(m, src)
+}
+func (m *SatelliteStatsResponse) XXX_Size() int {
+	return xxx_messageInfo_SatelliteStatsResponse.Size(m)
+}
+func (m *SatelliteStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SatelliteStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SatelliteStatsResponse proto.InternalMessageInfo
+
+func (m *SatelliteStatsResponse) GetSatellites() []*SatelliteStatsResponse_Satellite {
+	if m != nil {
+		return m.Satellites
+	}
+	return nil
+}
+
+type SatelliteStatsResponse_Satellite struct {
+	SatelliteId          NodeID   `protobuf:"bytes,1,opt,name=satellite_id,json=satelliteId,proto3,customtype=NodeID" json:"satellite_id"`
+	UsedSpace            int64    `protobuf:"varint,2,opt,name=used_space,json=usedSpace,proto3" json:"used_space,omitempty"`
+	AllocatedSpace       int64    `protobuf:"varint,3,opt,name=allocated_space,json=allocatedSpace,proto3" json:"allocated_space,omitempty"`
+	UsedIngress          int64    `protobuf:"varint,4,opt,name=used_ingress,json=usedIngress,proto3" json:"used_ingress,omitempty"`
+	UsedEgress           int64    `protobuf:"varint,5,opt,name=used_egress,json=usedEgress,proto3" json:"used_egress,omitempty"`
+	UsedBandwidth        int64    `protobuf:"varint,6,opt,name=used_bandwidth,json=usedBandwidth,proto3" json:"used_bandwidth,omitempty"`
+	AllocatedBandwidth   int64    `protobuf:"varint,7,opt,name=allocated_bandwidth,json=allocatedBandwidth,proto3" json:"allocated_bandwidth,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SatelliteStatsResponse_Satellite) Reset()         { *m = SatelliteStatsResponse_Satellite{} }
+func (m *SatelliteStatsResponse_Satellite) String() string { return proto.CompactTextString(m) }
+func (*SatelliteStatsResponse_Satellite) ProtoMessage()    {}
+func (*SatelliteStatsResponse_Satellite) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{44, 0}
+}
+func (m *SatelliteStatsResponse_Satellite) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SatelliteStatsResponse_Satellite.Unmarshal(m, b)
+}
+func (m *SatelliteStatsResponse_Satellite) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SatelliteStatsResponse_Satellite.Marshal(b, m, deterministic)
+}
+func (m *SatelliteStatsResponse_Satellite) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SatelliteStatsResponse_Satellite.Merge(m, src)
+}
+func (m *SatelliteStatsResponse_Satellite) XXX_Size() int {
+	return xxx_messageInfo_SatelliteStatsResponse_Satellite.Size(m)
+}
+func (m *SatelliteStatsResponse_Satellite) XXX_DiscardUnknown() {
+	xxx_messageInfo_SatelliteStatsResponse_Satellite.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SatelliteStatsResponse_Satellite proto.InternalMessageInfo
+
+func (m *SatelliteStatsResponse_Satellite) GetUsedSpace() int64 {
+	if m != nil {
+		return m.UsedSpace
+	}
+	return 0
+}
+
+func (m *SatelliteStatsResponse_Satellite) GetAllocatedSpace() int64 {
+	if m != nil {
+		return m.AllocatedSpace
+	}
+	return 0
+}
+
+func (m *SatelliteStatsResponse_Satellite) GetUsedIngress() int64 {
+	if m != nil {
+		return m.UsedIngress
+	}
+	return 0
+}
+
+func (m *SatelliteStatsResponse_Satellite) GetUsedEgress() int64 {
+	if m != nil {
+		return m.UsedEgress
+	}
+	return 0
+}
+
+func (m *SatelliteStatsResponse_Satellite) GetUsedBandwidth() int64 {
+	if m != nil {
+		return m.UsedBandwidth
+	}
+	return 0
+}
+
+func (m *SatelliteStatsResponse_Satellite) GetAllocatedBandwidth() int64 {
+	if m != nil {
+		return m.AllocatedBandwidth
+	}
+	return 0
+}
+
+type EmptyTrashRequest struct {
+	TrashedBefore        *timestamp.Timestamp `protobuf:"bytes,1,opt,name=trashed_before,json=trashedBefore,proto3" json:"trashed_before,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *EmptyTrashRequest) Reset()         { *m = EmptyTrashRequest{} }
+func (m *EmptyTrashRequest) String() string { return proto.CompactTextString(m) }
+func (*EmptyTrashRequest) ProtoMessage()    {}
+func (*EmptyTrashRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{45}
+}
+func (m *EmptyTrashRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EmptyTrashRequest.Unmarshal(m, b)
+}
+func (m *EmptyTrashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EmptyTrashRequest.Marshal(b, m, deterministic)
+}
+func (m *EmptyTrashRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmptyTrashRequest.Merge(m, src)
+}
+func (m *EmptyTrashRequest) XXX_Size() int {
+	return xxx_messageInfo_EmptyTrashRequest.Size(m)
+}
+func (m *EmptyTrashRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmptyTrashRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmptyTrashRequest proto.InternalMessageInfo
+
+func (m *EmptyTrashRequest) GetTrashedBefore() *timestamp.Timestamp {
+	if m != nil {
+		return m.TrashedBefore
+	}
+	return nil
+}
+
+type EmptyTrashResponse struct {
+	PiecesDeleted        int64    `protobuf:"varint,1,opt,name=pieces_deleted,json=piecesDeleted,proto3" json:"pieces_deleted,omitempty"`
+	BytesFreed           int64    `protobuf:"varint,2,opt,name=bytes_freed,json=bytesFreed,proto3" json:"bytes_freed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EmptyTrashResponse) Reset()         { *m = EmptyTrashResponse{} }
+func (m *EmptyTrashResponse) String() string { return proto.CompactTextString(m) }
+func (*EmptyTrashResponse) ProtoMessage()    {}
+func (*EmptyTrashResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{46}
+}
+func (m *EmptyTrashResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EmptyTrashResponse.Unmarshal(m, b)
+}
+func (m *EmptyTrashResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EmptyTrashResponse.Marshal(b, m, deterministic)
+}
+func (m *EmptyTrashResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmptyTrashResponse.Merge(m, src)
+}
+func (m *EmptyTrashResponse) XXX_Size() int {
+	return xxx_messageInfo_EmptyTrashResponse.Size(m)
+}
+func (m *EmptyTrashResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmptyTrashResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmptyTrashResponse proto.InternalMessageInfo
+
+func (m *EmptyTrashResponse) GetPiecesDeleted() int64 {
+	if m != nil {
+		return m.PiecesDeleted
+	}
+	return 0
+}
+
+func (m *EmptyTrashResponse) GetBytesFreed() int64 {
+	if m != nil {
+		return m.BytesFreed
+	}
+	return 0
+}
+
+type OrderQueuesRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OrderQueuesRequest) Reset()         { *m = OrderQueuesRequest{} }
+func (m *OrderQueuesRequest) String() string { return proto.CompactTextString(m) }
+func (*OrderQueuesRequest) ProtoMessage()    {}
+func (*OrderQueuesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{47}
+}
+func (m *OrderQueuesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrderQueuesRequest.Unmarshal(m, b)
+}
+func (m *OrderQueuesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrderQueuesRequest.Marshal(b, m, deterministic)
+}
+func (m *OrderQueuesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderQueuesRequest.Merge(m, src)
+}
+func (m *OrderQueuesRequest) XXX_Size() int {
+	return xxx_messageInfo_OrderQueuesRequest.Size(m)
+}
+func (m *OrderQueuesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderQueuesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderQueuesRequest proto.InternalMessageInfo
+
+type OrderQueuesResponse struct {
+	Queues               []*OrderQueuesResponse_Queue `protobuf:"bytes,1,rep,name=queues,proto3" json:"queues,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *OrderQueuesResponse) Reset()         { *m = OrderQueuesResponse{} }
+func (m *OrderQueuesResponse) String() string { return proto.CompactTextString(m) }
+func (*OrderQueuesResponse) ProtoMessage()    {}
+func (*OrderQueuesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{48}
+}
+func (m *OrderQueuesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrderQueuesResponse.Unmarshal(m, b)
+}
+func (m *OrderQueuesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrderQueuesResponse.Marshal(b, m, deterministic)
+}
+func (m *OrderQueuesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderQueuesResponse.Merge(m, src)
+}
+func (m *OrderQueuesResponse) XXX_Size() int {
+	return xxx_messageInfo_OrderQueuesResponse.Size(m)
+}
+func (m *OrderQueuesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderQueuesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderQueuesResponse proto.InternalMessageInfo
+
+func (m *OrderQueuesResponse) GetQueues() []*OrderQueuesResponse_Queue {
+	if m != nil {
+		return m.Queues
+	}
+	return nil
+}
+
+type OrderQueuesResponse_Queue struct {
+	SatelliteId          NodeID               `protobuf:"bytes,1,opt,name=satellite_id,json=satelliteId,proto3,customtype=NodeID" json:"satellite_id"`
+	Unsent               int64                `protobuf:"varint,2,opt,name=unsent,proto3" json:"unsent,omitempty"`
+	Failures             int32                `protobuf:"varint,3,opt,name=failures,proto3" json:"failures,omitempty"`
+	NextAttempt          *timestamp.Timestamp `protobuf:"bytes,4,opt,name=next_attempt,json=nextAttempt,proto3" json:"next_attempt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *OrderQueuesResponse_Queue) Reset()         { *m = OrderQueuesResponse_Queue{} }
+func (m *OrderQueuesResponse_Queue) String() string { return proto.CompactTextString(m) }
+func (*OrderQueuesResponse_Queue) ProtoMessage()    {}
+func (*OrderQueuesResponse_Queue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{48, 0}
+}
+func (m *OrderQueuesResponse_Queue) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrderQueuesResponse_Queue.Unmarshal(m, b)
+}
+func (m *OrderQueuesResponse_Queue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrderQueuesResponse_Queue.Marshal(b, m, deterministic)
+}
+func (m *OrderQueuesResponse_Queue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderQueuesResponse_Queue.Merge(m, src)
+}
+func (m *OrderQueuesResponse_Queue) XXX_Size() int {
+	return xxx_messageInfo_OrderQueuesResponse_Queue.Size(m)
+}
+func (m *OrderQueuesResponse_Queue) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderQueuesResponse_Queue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderQueuesResponse_Queue proto.InternalMessageInfo
+
+func (m *OrderQueuesResponse_Queue) GetUnsent() int64 {
+	if m != nil {
+		return m.Unsent
+	}
+	return 0
+}
+
+func (m *OrderQueuesResponse_Queue) GetFailures() int32 {
+	if m != nil {
+		return m.Failures
+	}
+	return 0
+}
+
+func (m *OrderQueuesResponse_Queue) GetNextAttempt() *timestamp.Timestamp {
+	if m != nil {
+		return m.NextAttempt
+	}
+	return nil
+}
+
 type DashboardRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -1189,7 +2706,7 @@ func (m *DashboardRequest) Reset()         { *m = DashboardRequest{} }
 func (m *DashboardRequest) String() string { return proto.CompactTextString(m) }
 func (*DashboardRequest) ProtoMessage()    {}
 func (*DashboardRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{27}
+	return fileDescriptor_a07d9034b2dd9d26, []int{49}
 }
 func (m *DashboardRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DashboardRequest.Unmarshal(m, b)
@@ -1228,7 +2745,7 @@ func (m *DashboardResponse) Reset()         { *m = DashboardResponse{} }
 func (m *DashboardResponse) String() string { return proto.CompactTextString(m) }
 func (*DashboardResponse) ProtoMessage()    {}
 func (*DashboardResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{28}
+	return fileDescriptor_a07d9034b2dd9d26, []int{50}
 }
 func (m *DashboardResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DashboardResponse.Unmarshal(m, b)
@@ -1318,7 +2835,7 @@ func (m *SegmentHealthRequest) Reset()         { *m = SegmentHealthRequest{} }
 func (m *SegmentHealthRequest) String() string { return proto.CompactTextString(m) }
 func (*SegmentHealthRequest) ProtoMessage()    {}
 func (*SegmentHealthRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{29}
+	return fileDescriptor_a07d9034b2dd9d26, []int{51}
 }
 func (m *SegmentHealthRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SegmentHealthRequest.Unmarshal(m, b)
@@ -1378,7 +2895,7 @@ func (m *SegmentHealth) Reset()         { *m = SegmentHealth{} }
 func (m *SegmentHealth) String() string { return proto.CompactTextString(m) }
 func (*SegmentHealth) ProtoMessage()    {}
 func (*SegmentHealth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{30}
+	return fileDescriptor_a07d9034b2dd9d26, []int{52}
 }
 func (m *SegmentHealth) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SegmentHealth.Unmarshal(m, b)
@@ -1424,7 +2941,7 @@ func (m *SegmentHealthResponse) Reset()         { *m = SegmentHealthResponse{} }
 func (m *SegmentHealthResponse) String() string { return proto.CompactTextString(m) }
 func (*SegmentHealthResponse) ProtoMessage()    {}
 func (*SegmentHealthResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{31}
+	return fileDescriptor_a07d9034b2dd9d26, []int{53}
 }
 func (m *SegmentHealthResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SegmentHealthResponse.Unmarshal(m, b)
@@ -1474,7 +2991,7 @@ func (m *ObjectHealthRequest) Reset()         { *m = ObjectHealthRequest{} }
 func (m *ObjectHealthRequest) String() string { return proto.CompactTextString(m) }
 func (*ObjectHealthRequest) ProtoMessage()    {}
 func (*ObjectHealthRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{32}
+	return fileDescriptor_a07d9034b2dd9d26, []int{54}
 }
 func (m *ObjectHealthRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ObjectHealthRequest.Unmarshal(m, b)
@@ -1548,7 +3065,7 @@ func (m *ObjectHealthResponse) Reset()         { *m = ObjectHealthResponse{} }
 func (m *ObjectHealthResponse) String() string { return proto.CompactTextString(m) }
 func (*ObjectHealthResponse) ProtoMessage()    {}
 func (*ObjectHealthResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a07d9034b2dd9d26, []int{33}
+	return fileDescriptor_a07d9034b2dd9d26, []int{55}
 }
 func (m *ObjectHealthResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ObjectHealthResponse.Unmarshal(m, b)
@@ -1586,10 +3103,23 @@ func init() {
 	proto.RegisterType((*ListIrreparableSegmentsRequest)(nil), "inspector.ListIrreparableSegmentsRequest")
 	proto.RegisterType((*IrreparableSegment)(nil), "inspector.IrreparableSegment")
 	proto.RegisterType((*ListIrreparableSegmentsResponse)(nil), "inspector.ListIrreparableSegmentsResponse")
+	proto.RegisterType((*MarkSegmentLostRequest)(nil), "inspector.MarkSegmentLostRequest")
+	proto.RegisterType((*MarkSegmentLostResponse)(nil), "inspector.MarkSegmentLostResponse")
 	proto.RegisterType((*GetStatsRequest)(nil), "inspector.GetStatsRequest")
 	proto.RegisterType((*GetStatsResponse)(nil), "inspector.GetStatsResponse")
 	proto.RegisterType((*CreateStatsRequest)(nil), "inspector.CreateStatsRequest")
 	proto.RegisterType((*CreateStatsResponse)(nil), "inspector.CreateStatsResponse")
+	proto.RegisterType((*NodeAdminRequest)(nil), "inspector.NodeAdminRequest")
+	proto.RegisterType((*NodeAdminResponse)(nil), "inspector.NodeAdminResponse")
+	proto.RegisterType((*NodeAdminLogEntry)(nil), "inspector.NodeAdminLogEntry")
+	proto.RegisterType((*GetNodeLogRequest)(nil), "inspector.GetNodeLogRequest")
+	proto.RegisterType((*GetNodeLogResponse)(nil), "inspector.GetNodeLogResponse")
+	proto.RegisterType((*ExportNodesRequest)(nil), "inspector.ExportNodesRequest")
+	proto.RegisterType((*ExportNodesResponse)(nil), "inspector.ExportNodesResponse")
+	proto.RegisterType((*ExportNodesResponse_Node)(nil), "inspector.ExportNodesResponse.Node")
+	proto.RegisterType((*GetUptimeHistoryRequest)(nil), "inspector.GetUptimeHistoryRequest")
+	proto.RegisterType((*GetUptimeHistoryResponse)(nil), "inspector.GetUptimeHistoryResponse")
+	proto.RegisterType((*GetUptimeHistoryResponse_DowntimeWindow)(nil), "inspector.GetUptimeHistoryResponse.DowntimeWindow")
 	proto.RegisterType((*CountNodesResponse)(nil), "inspector.CountNodesResponse")
 	proto.RegisterType((*CountNodesRequest)(nil), "inspector.CountNodesRequest")
 	proto.RegisterType((*GetBucketsRequest)(nil), "inspector.GetBucketsRequest")
@@ -1608,8 +3138,22 @@ func init() {
 	proto.RegisterType((*FindNearResponse)(nil), "inspector.FindNearResponse")
 	proto.RegisterType((*DumpNodesRequest)(nil), "inspector.DumpNodesRequest")
 	proto.RegisterType((*DumpNodesResponse)(nil), "inspector.DumpNodesResponse")
+	proto.RegisterType((*GetBucketListRequest)(nil), "inspector.GetBucketListRequest")
+	proto.RegisterType((*GetBucketListResponse)(nil), "inspector.GetBucketListResponse")
+	proto.RegisterType((*GetBucketListResponse_Bucket)(nil), "inspector.GetBucketListResponse.Bucket")
+	proto.RegisterType((*TraceLookupRequest)(nil), "inspector.TraceLookupRequest")
+	proto.RegisterType((*TraceLookupResponse)(nil), "inspector.TraceLookupResponse")
+	proto.RegisterType((*LookupHop)(nil), "inspector.LookupHop")
 	proto.RegisterType((*StatsRequest)(nil), "inspector.StatsRequest")
 	proto.RegisterType((*StatSummaryResponse)(nil), "inspector.StatSummaryResponse")
+	proto.RegisterType((*SatelliteStatsRequest)(nil), "inspector.SatelliteStatsRequest")
+	proto.RegisterType((*SatelliteStatsResponse)(nil), "inspector.SatelliteStatsResponse")
+	proto.RegisterType((*SatelliteStatsResponse_Satellite)(nil), "inspector.SatelliteStatsResponse.Satellite")
+	proto.RegisterType((*EmptyTrashRequest)(nil), "inspector.EmptyTrashRequest")
+	proto.RegisterType((*EmptyTrashResponse)(nil), "inspector.EmptyTrashResponse")
+	proto.RegisterType((*OrderQueuesRequest)(nil), "inspector.OrderQueuesRequest")
+	proto.RegisterType((*OrderQueuesResponse)(nil), "inspector.OrderQueuesResponse")
+	proto.RegisterType((*OrderQueuesResponse_Queue)(nil), "inspector.OrderQueuesResponse.Queue")
 	proto.RegisterType((*DashboardRequest)(nil), "inspector.DashboardRequest")
 	proto.RegisterType((*DashboardResponse)(nil), "inspector.DashboardResponse")
 	proto.RegisterType((*SegmentHealthRequest)(nil), "inspector.SegmentHealthRequest")
@@ -1622,113 +3166,211 @@ func init() {
 func init() { proto.RegisterFile("inspector.proto", fileDescriptor_a07d9034b2dd9d26) }
 
 var fileDescriptor_a07d9034b2dd9d26 = []byte{
-	// 1688 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4b, 0x73, 0x1b, 0xc7,
-	0x11, 0xd6, 0xe2, 0x25, 0xa2, 0x01, 0xe2, 0x31, 0xa0, 0x24, 0x04, 0x92, 0x08, 0x66, 0xf3, 0x10,
-	0x25, 0xa5, 0x20, 0x05, 0x51, 0x0e, 0x8a, 0x4a, 0x07, 0x91, 0x8c, 0x24, 0x94, 0x18, 0x89, 0x5a,
-	0x2a, 0x39, 0xa4, 0x54, 0x41, 0x0d, 0xb0, 0x43, 0x70, 0x43, 0x60, 0x67, 0xb5, 0x3b, 0x50, 0xc4,
-	0x3f, 0x90, 0xb2, 0x7f, 0x82, 0x7d, 0xf5, 0x9f, 0x70, 0xd9, 0x47, 0x5f, 0x7c, 0xf3, 0xdd, 0x07,
-	0x5d, 0x5c, 0x65, 0xff, 0x06, 0xdf, 0x5c, 0xd3, 0x33, 0xfb, 0x04, 0x60, 0xb2, 0xfc, 0xb8, 0x61,
-	0xbf, 0xfe, 0xa6, 0xa7, 0xfb, 0x9b, 0x99, 0x9e, 0x1e, 0x40, 0xdd, 0x71, 0x03, 0x8f, 0x8d, 0x05,
-	0xf7, 0x7b, 0x9e, 0xcf, 0x05, 0x27, 0xe5, 0x08, 0xe8, 0xc0, 0x84, 0x4f, 0xb8, 0x82, 0x3b, 0xe0,
-	0x72, 0x9b, 0xe9, 0xdf, 0x75, 0x8f, 0x3b, 0xae, 0x60, 0xbe, 0x3d, 0xd2, 0xc0, 0xe6, 0x84, 0xf3,
-	0xc9, 0x94, 0xdd, 0xc1, 0xaf, 0xd1, 0xfc, 0xe8, 0x8e, 0x3d, 0xf7, 0xa9, 0x70, 0xb8, 0xab, 0xed,
-	0xdd, 0xac, 0x5d, 0x38, 0x33, 0x16, 0x08, 0x3a, 0xf3, 0x14, 0xc1, 0x7c, 0x0e, 0x9b, 0xfb, 0x4e,
-	0x20, 0x06, 0xbe, 0xcf, 0x3c, 0xea, 0xd3, 0xd1, 0x94, 0x1d, 0xb2, 0xc9, 0x8c, 0xb9, 0x22, 0xb0,
-	0xd8, 0x9b, 0x39, 0x0b, 0x04, 0xd9, 0x80, 0xe2, 0xd4, 0x99, 0x39, 0xa2, 0x6d, 0x6c, 0x19, 0xdb,
-	0x45, 0x4b, 0x7d, 0x90, 0xcb, 0x50, 0xe2, 0x47, 0x47, 0x01, 0x13, 0xed, 0x1c, 0xc2, 0xfa, 0xcb,
-	0xfc, 0xd6, 0x00, 0xb2, 0xe8, 0x8c, 0x10, 0x28, 0x78, 0x54, 0x1c, 0xa3, 0x8f, 0xaa, 0x85, 0xbf,
-	0xc9, 0x7d, 0xa8, 0x05, 0xca, 0x3c, 0xb4, 0x99, 0xa0, 0xce, 0x14, 0x5d, 0x55, 0xfa, 0xa4, 0x17,
-	0x67, 0x79, 0xa0, 0x7e, 0x59, 0xeb, 0x9a, 0xb9, 0x87, 0x44, 0xd2, 0x85, 0xca, 0x94, 0x07, 0x62,
-	0xe8, 0x39, 0x6c, 0xcc, 0x82, 0x76, 0x1e, 0x43, 0x00, 0x09, 0x1d, 0x20, 0x42, 0x7a, 0xd0, 0x9a,
-	0xd2, 0x40, 0x0c, 0x65, 0x20, 0x8e, 0x3f, 0xa4, 0x42, 0xb0, 0x99, 0x27, 0xda, 0x85, 0x2d, 0x63,
-	0x3b, 0x6f, 0x35, 0xa5, 0xc9, 0x42, 0xcb, 0x23, 0x65, 0x20, 0x77, 0x61, 0x23, 0x4d, 0x1d, 0x8e,
-	0xf9, 0xdc, 0x15, 0xed, 0x22, 0x0e, 0x20, 0x7e, 0x92, 0xbc, 0x2b, 0x2d, 0xe6, 0x6b, 0xe8, 0xae,
-	0x14, 0x2e, 0xf0, 0xb8, 0x1b, 0x30, 0x72, 0x1f, 0xd6, 0x74, 0xd8, 0x41, 0xdb, 0xd8, 0xca, 0x6f,
-	0x57, 0xfa, 0xd7, 0x7b, 0xf1, 0xa2, 0x2f, 0x8e, 0xb4, 0x22, 0xba, 0xf9, 0x37, 0xa8, 0x3f, 0x61,
-	0xe2, 0x50, 0xd0, 0x78, 0x1d, 0x6e, 0xc0, 0x45, 0xb9, 0x13, 0x86, 0x8e, 0xad, 0x54, 0xdc, 0xa9,
-	0x7d, 0xf9, 0xbe, 0x7b, 0xe1, 0xeb, 0xf7, 0xdd, 0xd2, 0x73, 0x6e, 0xb3, 0xc1, 0x9e, 0x55, 0x92,
-	0xe6, 0x81, 0x6d, 0x7e, 0x6c, 0x40, 0x23, 0x1e, 0xac, 0x63, 0xe9, 0x42, 0x85, 0xce, 0x6d, 0x27,
-	0xcc, 0xcb, 0xc0, 0xbc, 0x00, 0x21, 0xcc, 0x27, 0x26, 0xe0, 0xfe, 0xc1, 0xa5, 0x30, 0x34, 0xc1,
-	0x92, 0x08, 0xf9, 0x2d, 0x54, 0xe7, 0x9e, 0xdc, 0x3e, 0xda, 0x45, 0x1e, 0x5d, 0x54, 0x14, 0xa6,
-	0x7c, 0xc4, 0x14, 0xe5, 0xa4, 0x80, 0x4e, 0x34, 0x05, 0xbd, 0x98, 0xdf, 0x18, 0x40, 0x76, 0x7d,
-	0x46, 0x05, 0xfb, 0x49, 0xc9, 0x65, 0xf3, 0xc8, 0x2d, 0xe4, 0xd1, 0x83, 0x96, 0x22, 0x04, 0xf3,
-	0xf1, 0x98, 0x05, 0x41, 0x2a, 0xda, 0x26, 0x9a, 0x0e, 0x95, 0x25, 0x1b, 0xb3, 0x22, 0x16, 0x16,
-	0xd3, 0xba, 0x0b, 0x1b, 0x9a, 0x92, 0xf6, 0xa9, 0x37, 0x87, 0xb2, 0x25, 0x9d, 0x9a, 0x97, 0xa0,
-	0x95, 0x4a, 0x52, 0x2d, 0x82, 0x79, 0x0b, 0x08, 0xda, 0x65, 0x4e, 0xf1, 0xd2, 0x6c, 0x40, 0x31,
-	0xb9, 0x28, 0xea, 0xc3, 0x6c, 0x41, 0x33, 0xc9, 0x45, 0x99, 0x24, 0xf8, 0x84, 0x89, 0x9d, 0xf9,
-	0xf8, 0x84, 0x45, 0xda, 0x99, 0x4f, 0x81, 0x24, 0xc1, 0xd8, 0xab, 0xe0, 0x82, 0x4e, 0x43, 0xaf,
-	0xf8, 0x41, 0xae, 0x41, 0xde, 0xb1, 0x83, 0x76, 0x6e, 0x2b, 0xbf, 0x5d, 0xdd, 0x81, 0x84, 0xbe,
-	0x12, 0x36, 0xfb, 0xb8, 0x71, 0x94, 0xa7, 0x70, 0x65, 0x36, 0x21, 0xb7, 0x72, 0x51, 0x72, 0x8e,
-	0x6d, 0xfe, 0x33, 0x11, 0x52, 0x34, 0xf9, 0x19, 0x83, 0xc8, 0x16, 0x14, 0xe5, 0x7a, 0xaa, 0x40,
-	0x2a, 0x7d, 0xe8, 0x61, 0x8d, 0x93, 0x04, 0x4b, 0x19, 0xcc, 0x5b, 0x50, 0x52, 0x3e, 0xcf, 0xc1,
-	0xed, 0x01, 0x28, 0xae, 0x3c, 0x90, 0x31, 0xdf, 0x58, 0xc5, 0x7f, 0x06, 0xf5, 0x03, 0xc7, 0x9d,
-	0x20, 0x74, 0xbe, 0x2c, 0x49, 0x1b, 0x2e, 0x52, 0xdb, 0xf6, 0x59, 0x10, 0xe0, 0x96, 0x2b, 0x5b,
-	0xe1, 0xa7, 0x69, 0x42, 0x23, 0x76, 0xa6, 0xd3, 0xaf, 0x41, 0x8e, 0x9f, 0xa0, 0xb7, 0x35, 0x2b,
-	0xc7, 0x4f, 0xcc, 0x87, 0xd0, 0xdc, 0xe7, 0xfc, 0x64, 0xee, 0x25, 0xa7, 0xac, 0x45, 0x53, 0x96,
-	0xcf, 0x98, 0xe2, 0x35, 0x90, 0xe4, 0xf0, 0x48, 0xe3, 0x82, 0x4c, 0x07, 0x3d, 0xa4, 0xd3, 0x44,
-	0x9c, 0xfc, 0x11, 0x0a, 0x33, 0x26, 0x68, 0x54, 0x54, 0x23, 0xfb, 0x3f, 0x98, 0xa0, 0x36, 0x15,
-	0xd4, 0x42, 0xbb, 0xf9, 0x1f, 0xa8, 0x63, 0xa2, 0xee, 0x11, 0x3f, 0xaf, 0x1a, 0xb7, 0xd3, 0xa1,
-	0x56, 0xfa, 0xcd, 0xd8, 0xfb, 0x23, 0x65, 0x88, 0xa3, 0xff, 0xc2, 0x80, 0x46, 0x3c, 0x81, 0x0e,
-	0xde, 0x84, 0x82, 0x38, 0xf5, 0x54, 0xf0, 0xb5, 0x7e, 0x2d, 0x1e, 0xfe, 0xea, 0xd4, 0x63, 0x16,
-	0xda, 0x48, 0x0f, 0xd6, 0xb8, 0xc7, 0x7c, 0x2a, 0xb8, 0xbf, 0x98, 0xc4, 0x0b, 0x6d, 0xb1, 0x22,
-	0x8e, 0xe4, 0x8f, 0xa9, 0x47, 0xc7, 0x8e, 0x38, 0xc5, 0xe3, 0x9e, 0xe2, 0xef, 0x6a, 0x8b, 0x15,
-	0x71, 0x64, 0x16, 0x6f, 0x99, 0x1f, 0x38, 0xdc, 0xc5, 0x43, 0x9f, 0xca, 0xe2, 0x5f, 0xca, 0x60,
-	0x85, 0x0c, 0x73, 0x06, 0xf5, 0xc7, 0x8e, 0x6b, 0x3f, 0x67, 0xd4, 0x3f, 0xaf, 0x4a, 0xbf, 0x87,
-	0x62, 0x20, 0xa8, 0xaf, 0x8a, 0xd4, 0x22, 0x45, 0x19, 0xe3, 0xeb, 0x55, 0x55, 0x28, 0xf5, 0x61,
-	0xde, 0x83, 0x46, 0x3c, 0x9d, 0xd6, 0xec, 0xec, 0x83, 0x40, 0xa0, 0xb1, 0x37, 0x9f, 0x79, 0xa9,
-	0x92, 0xf1, 0x57, 0x68, 0x26, 0xb0, 0xac, 0xab, 0x95, 0x67, 0xa4, 0x06, 0xd5, 0x64, 0x81, 0x36,
-	0xbf, 0x37, 0xa0, 0x25, 0x81, 0xc3, 0xf9, 0x6c, 0x46, 0xfd, 0xd3, 0xc8, 0xd3, 0x75, 0x80, 0x79,
-	0xc0, 0xec, 0x61, 0xe0, 0xd1, 0x31, 0xd3, 0xb5, 0xa6, 0x2c, 0x91, 0x43, 0x09, 0x90, 0x1b, 0x50,
-	0xa7, 0x6f, 0xa9, 0x33, 0x95, 0xb7, 0x9c, 0xe6, 0xa8, 0x92, 0x5d, 0x8b, 0x60, 0x45, 0x94, 0x65,
-	0x58, 0xfa, 0x71, 0xdc, 0x09, 0xee, 0xab, 0xf0, 0x76, 0x09, 0x98, 0x3d, 0x50, 0x90, 0x2c, 0xfd,
-	0x48, 0x61, 0x8a, 0xa1, 0x0a, 0x35, 0xce, 0xfe, 0x77, 0x45, 0xf8, 0x03, 0xd4, 0x90, 0x30, 0xa2,
-	0xae, 0xfd, 0x3f, 0xc7, 0x16, 0xc7, 0xba, 0x42, 0xaf, 0x4b, 0x74, 0x27, 0x04, 0xc9, 0x1d, 0x68,
-	0xc5, 0x31, 0xc5, 0xdc, 0x92, 0xaa, 0xe6, 0x91, 0x29, 0x1a, 0x80, 0xb2, 0xd2, 0xe0, 0x78, 0xc4,
-	0xa9, 0x6f, 0x87, 0x7a, 0x7c, 0x95, 0x87, 0x66, 0x02, 0xd4, 0x6a, 0x9c, 0xfb, 0x1a, 0xbb, 0x09,
-	0x0d, 0x24, 0x8e, 0xb9, 0xeb, 0xb2, 0xb1, 0x6c, 0xd8, 0x02, 0x2d, 0x4c, 0x5d, 0xe2, 0xbb, 0x31,
-	0x4c, 0x6e, 0x43, 0x73, 0xc4, 0xb9, 0x08, 0x84, 0x4f, 0xbd, 0x61, 0x78, 0xec, 0xf2, 0x58, 0x21,
-	0x1a, 0x91, 0x41, 0x9f, 0x3a, 0xe9, 0x17, 0x1b, 0x26, 0x97, 0x4e, 0x23, 0x6e, 0x01, 0xb9, 0xf5,
-	0x10, 0x4f, 0x50, 0xd9, 0xbb, 0x0c, 0xb5, 0xa8, 0xa8, 0x21, 0x1e, 0x52, 0xef, 0xe1, 0x4e, 0x16,
-	0x01, 0x6a, 0x54, 0xe9, 0x6f, 0x26, 0xba, 0x98, 0x25, 0x7b, 0xc2, 0x52, 0x64, 0xf2, 0x67, 0x28,
-	0xa9, 0xab, 0xb1, 0x7d, 0x11, 0x87, 0xfd, 0xa6, 0xa7, 0x9a, 0xd1, 0x5e, 0xd8, 0x8c, 0xf6, 0xf6,
-	0x74, 0xb3, 0x6a, 0x69, 0x22, 0x79, 0x00, 0x15, 0x6c, 0xdb, 0x3c, 0xc7, 0x9d, 0x30, 0xbb, 0xbd,
-	0x86, 0xe3, 0x3a, 0x0b, 0xe3, 0x5e, 0x85, 0x4d, 0xac, 0x05, 0x92, 0x7e, 0x80, 0x6c, 0xf2, 0x10,
-	0xaa, 0x38, 0xf8, 0xcd, 0x9c, 0xf9, 0x0e, 0xb3, 0xdb, 0xe5, 0x33, 0x47, 0xe3, 0x64, 0x2f, 0x15,
-	0xdd, 0xfc, 0xc8, 0x80, 0x0d, 0xdd, 0x88, 0x3d, 0x65, 0x74, 0x2a, 0x8e, 0xc3, 0x73, 0x7e, 0x19,
-	0x4a, 0x23, 0xbc, 0x5e, 0x74, 0xf7, 0xaa, 0xbf, 0xe4, 0x76, 0x63, 0xee, 0xd8, 0x3f, 0xf5, 0x04,
-	0xb3, 0x87, 0xd8, 0xdd, 0xe2, 0x41, 0xb7, 0xd6, 0x23, 0xf4, 0x40, 0xb6, 0xb9, 0xbf, 0x83, 0xb0,
-	0x79, 0x1d, 0x3a, 0xae, 0xcd, 0xde, 0xe9, 0xad, 0x5d, 0xd5, 0xe0, 0x40, 0x62, 0xf2, 0x18, 0x79,
-	0x3e, 0xff, 0x2f, 0x1b, 0x0b, 0xb9, 0x77, 0x0a, 0xe8, 0xa7, 0xac, 0x91, 0x81, 0x6d, 0xee, 0xc3,
-	0x7a, 0x2a, 0x34, 0x79, 0x5c, 0xb8, 0x3b, 0x75, 0x5c, 0x36, 0x0c, 0xcf, 0xb1, 0xec, 0x80, 0x2b,
-	0x0a, 0xc3, 0xb3, 0x2e, 0xef, 0x13, 0x3d, 0x85, 0x8e, 0x2b, 0xfc, 0x34, 0xff, 0x6f, 0xc0, 0xa5,
-	0x4c, 0xa6, 0x7a, 0xff, 0xde, 0x85, 0xd2, 0x31, 0x22, 0xfa, 0x56, 0x69, 0x27, 0x57, 0x3a, 0x35,
-	0x42, 0xf3, 0xc8, 0x03, 0x00, 0x9f, 0xd9, 0x73, 0xd7, 0xa6, 0xee, 0xf8, 0x54, 0x97, 0xe9, 0xab,
-	0x89, 0x06, 0xde, 0x8a, 0x8c, 0x87, 0xe3, 0x63, 0x36, 0x63, 0x56, 0x82, 0x6e, 0x7e, 0x67, 0x40,
-	0xeb, 0xc5, 0x48, 0xe6, 0x98, 0x56, 0x7c, 0x51, 0x59, 0x63, 0x99, 0xb2, 0xf1, 0xc2, 0xe4, 0x52,
-	0x0b, 0x93, 0x16, 0x33, 0x9f, 0x11, 0x53, 0x76, 0x88, 0x58, 0x7a, 0x87, 0xf4, 0x48, 0x30, 0x7f,
-	0x18, 0x8a, 0xa4, 0xdf, 0x06, 0x68, 0x7a, 0x24, 0x2d, 0xe1, 0xdb, 0xe5, 0x4f, 0x40, 0x98, 0x6b,
-	0x0f, 0x47, 0xec, 0x88, 0xfb, 0x2c, 0xa2, 0xab, 0xd2, 0xd2, 0x60, 0xae, 0xbd, 0x83, 0x86, 0x90,
-	0x1d, 0xd5, 0xf3, 0x52, 0xe2, 0xb9, 0x64, 0x7e, 0x68, 0xc0, 0x46, 0x3a, 0x53, 0xad, 0xf8, 0xbd,
-	0x85, 0x37, 0xc2, 0x6a, 0xcd, 0x23, 0xe6, 0xcf, 0x52, 0xbd, 0xff, 0x79, 0x1e, 0xaa, 0xcf, 0xa8,
-	0x3d, 0x08, 0x67, 0x21, 0x03, 0x80, 0xb8, 0xd5, 0x24, 0xd7, 0x12, 0xf3, 0x2f, 0x74, 0xa0, 0x9d,
-	0xeb, 0x2b, 0xac, 0x3a, 0x9d, 0x5d, 0x58, 0x0b, 0xbb, 0x21, 0xd2, 0x49, 0x50, 0x33, 0xfd, 0x56,
-	0xe7, 0xea, 0x52, 0x9b, 0x76, 0x32, 0x00, 0x88, 0xfb, 0x9d, 0x54, 0x3c, 0x0b, 0x5d, 0x54, 0x2a,
-	0x9e, 0x25, 0x4d, 0xd2, 0x2e, 0xac, 0x85, 0xbd, 0x47, 0x2a, 0x9e, 0x4c, 0xc7, 0x93, 0x8a, 0x67,
-	0xa1, 0x59, 0xd9, 0x85, 0xb5, 0xf0, 0x32, 0x4e, 0x39, 0xc9, 0x34, 0x04, 0x29, 0x27, 0x0b, 0xb7,
-	0xf7, 0x63, 0x28, 0x47, 0xf7, 0x30, 0x49, 0x32, 0xb3, 0x37, 0x76, 0xe7, 0xda, 0x72, 0xa3, 0xf2,
-	0xd3, 0xff, 0x34, 0x07, 0x8d, 0x17, 0x6f, 0x99, 0x3f, 0xa5, 0xa7, 0xbf, 0xca, 0x0a, 0xfe, 0x42,
-	0x71, 0x4a, 0xd1, 0xc2, 0x47, 0x68, 0x4a, 0xb4, 0xcc, 0xb3, 0x36, 0x25, 0xda, 0xc2, 0xab, 0x75,
-	0x1f, 0x2a, 0x89, 0x77, 0x14, 0x49, 0x85, 0xbe, 0xf0, 0x88, 0xec, 0x6c, 0xae, 0x32, 0x6b, 0xe9,
-	0x3e, 0x31, 0xa0, 0x85, 0xff, 0x0f, 0x1c, 0x0a, 0xee, 0xb3, 0x58, 0xbd, 0x1d, 0x28, 0x2a, 0xff,
-	0x57, 0x32, 0x17, 0xdb, 0x52, 0xcf, 0x4b, 0x6e, 0x3c, 0xf3, 0x02, 0x79, 0x0a, 0xe5, 0xa8, 0x1d,
-	0x48, 0xcb, 0x96, 0xe9, 0x1c, 0xd2, 0xb2, 0x65, 0x3b, 0x08, 0xf3, 0x42, 0xff, 0x03, 0x03, 0x36,
-	0x12, 0xff, 0x0d, 0xc4, 0x61, 0x7a, 0x70, 0x65, 0xc5, 0x3f, 0x0e, 0xe4, 0x66, 0xf2, 0x14, 0xfc,
-	0xe8, 0xdf, 0x39, 0x9d, 0x5b, 0xe7, 0xa1, 0x6a, 0xc1, 0x3e, 0x33, 0xa0, 0xae, 0x6a, 0x4f, 0x1c,
-	0xc5, 0x4b, 0xa8, 0x26, 0x0b, 0x19, 0x49, 0x4a, 0xb3, 0xa4, 0x96, 0x77, 0xba, 0x2b, 0xed, 0x91,
-	0x76, 0xaf, 0xb2, 0xb7, 0x5b, 0x77, 0x65, 0x09, 0xd4, 0x4e, 0xb7, 0x56, 0x13, 0x42, 0xaf, 0x3b,
-	0x85, 0x7f, 0xe7, 0xbc, 0xd1, 0xa8, 0x84, 0xd7, 0xfe, 0x5f, 0x7e, 0x08, 0x00, 0x00, 0xff, 0xff,
-	0x9d, 0x4f, 0x03, 0xcd, 0x6e, 0x13, 0x00, 0x00,
+	// 3260 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0x4b, 0x6c, 0x1c, 0xc7,
+	0xd1, 0xd6, 0x3e, 0xb8, 0xdc, 0xad, 0x5d, 0xee, 0xa3, 0xb9, 0xa2, 0xd6, 0x23, 0x89, 0xa4, 0xc7,
+	0xf6, 0x6f, 0xd9, 0xf2, 0xbf, 0xb2, 0xf9, 0xd3, 0xc6, 0xef, 0xf8, 0x01, 0xf0, 0xa1, 0xc7, 0x46,
+	0xb4, 0x1e, 0x43, 0xc9, 0x0e, 0x12, 0x23, 0x8b, 0xe6, 0x4e, 0x93, 0x9c, 0x70, 0x76, 0x66, 0x34,
+	0xd3, 0x63, 0x91, 0xbe, 0x06, 0x08, 0x92, 0xdc, 0x73, 0xf0, 0x35, 0xe7, 0x04, 0xc8, 0x21, 0xa7,
+	0x5c, 0x73, 0x09, 0x90, 0x43, 0x2e, 0x39, 0x04, 0x06, 0xe2, 0x43, 0x02, 0x24, 0x77, 0x07, 0xc8,
+	0x39, 0xe8, 0xd7, 0x3c, 0x77, 0xb5, 0x4b, 0xcb, 0xb9, 0x4d, 0x57, 0x7d, 0x55, 0xdd, 0x5d, 0x5d,
+	0x5d, 0x5d, 0xd5, 0x3d, 0xd0, 0xb2, 0x9c, 0xc0, 0x23, 0x23, 0xea, 0xfa, 0x7d, 0xcf, 0x77, 0xa9,
+	0x8b, 0x6a, 0x11, 0x41, 0x83, 0x23, 0xf7, 0xc8, 0x15, 0x64, 0x0d, 0x1c, 0xd7, 0x24, 0xf2, 0xbb,
+	0xe5, 0xb9, 0x96, 0x43, 0x89, 0x6f, 0x1e, 0x48, 0xc2, 0xea, 0x91, 0xeb, 0x1e, 0xd9, 0xe4, 0x06,
+	0x6f, 0x1d, 0x84, 0x87, 0x37, 0xcc, 0xd0, 0xc7, 0xd4, 0x72, 0x1d, 0xc9, 0x5f, 0xcb, 0xf2, 0xa9,
+	0x35, 0x26, 0x01, 0xc5, 0x63, 0x4f, 0x00, 0xf4, 0x7b, 0xb0, 0xba, 0x67, 0x05, 0x74, 0xe0, 0xfb,
+	0xc4, 0xc3, 0x3e, 0x3e, 0xb0, 0xc9, 0x3e, 0x39, 0x1a, 0x13, 0x87, 0x06, 0x06, 0x79, 0x12, 0x92,
+	0x80, 0xa2, 0x2e, 0x2c, 0xd8, 0xd6, 0xd8, 0xa2, 0xbd, 0xc2, 0x7a, 0xe1, 0xda, 0x82, 0x21, 0x1a,
+	0x68, 0x05, 0x2a, 0xee, 0xe1, 0x61, 0x40, 0x68, 0xaf, 0xc8, 0xc9, 0xb2, 0xa5, 0xff, 0xa3, 0x00,
+	0x28, 0xaf, 0x0c, 0x21, 0x28, 0x7b, 0x98, 0x1e, 0x73, 0x1d, 0x0d, 0x83, 0x7f, 0xa3, 0x77, 0xa1,
+	0x19, 0x08, 0xf6, 0xd0, 0x24, 0x14, 0x5b, 0x36, 0x57, 0x55, 0xdf, 0x40, 0xfd, 0x78, 0x96, 0x0f,
+	0xc4, 0x97, 0xb1, 0x24, 0x91, 0xbb, 0x1c, 0x88, 0xd6, 0xa0, 0x6e, 0xbb, 0x01, 0x1d, 0x7a, 0x16,
+	0x19, 0x91, 0xa0, 0x57, 0xe2, 0x43, 0x00, 0x46, 0x7a, 0xc0, 0x29, 0xa8, 0x0f, 0xcb, 0x36, 0x0e,
+	0xe8, 0x90, 0x0d, 0xc4, 0xf2, 0x87, 0x98, 0x52, 0x32, 0xf6, 0x68, 0xaf, 0xbc, 0x5e, 0xb8, 0x56,
+	0x32, 0x3a, 0x8c, 0x65, 0x70, 0xce, 0x96, 0x60, 0xa0, 0x37, 0xa1, 0x9b, 0x86, 0x0e, 0x47, 0x6e,
+	0xe8, 0xd0, 0xde, 0x02, 0x17, 0x40, 0x7e, 0x12, 0xbc, 0xc3, 0x38, 0xfa, 0xa7, 0xb0, 0x36, 0xd5,
+	0x70, 0x81, 0xe7, 0x3a, 0x01, 0x41, 0xef, 0x42, 0x55, 0x0e, 0x3b, 0xe8, 0x15, 0xd6, 0x4b, 0xd7,
+	0xea, 0x1b, 0x57, 0xfb, 0xf1, 0xa2, 0xe7, 0x25, 0x8d, 0x08, 0xae, 0xbf, 0x01, 0x2b, 0x1f, 0x61,
+	0xff, 0x44, 0x32, 0xf6, 0xdc, 0x80, 0xaa, 0xe5, 0x98, 0x60, 0x49, 0x7d, 0x17, 0x2e, 0xe5, 0xd0,
+	0x72, 0x0c, 0xaf, 0x41, 0xdb, 0x71, 0xa9, 0x75, 0x68, 0x11, 0x73, 0x38, 0x26, 0xe3, 0x03, 0xe2,
+	0x07, 0x72, 0x21, 0x5b, 0x8a, 0xfe, 0x91, 0x20, 0xeb, 0xdf, 0x81, 0xd6, 0x6d, 0x42, 0xf7, 0x29,
+	0x8e, 0xd7, 0xfe, 0x55, 0x58, 0x64, 0xde, 0x37, 0xb4, 0x4c, 0xd1, 0xdf, 0x76, 0xf3, 0x0f, 0x5f,
+	0xad, 0x5d, 0xf8, 0xf2, 0xab, 0xb5, 0xca, 0x3d, 0xd7, 0x24, 0x83, 0x5d, 0xa3, 0xc2, 0xd8, 0x03,
+	0x53, 0xff, 0xba, 0x08, 0xed, 0x58, 0x58, 0xf6, 0xbd, 0x06, 0x75, 0x1c, 0x9a, 0x96, 0xb2, 0x65,
+	0x81, 0xdb, 0x12, 0x38, 0x89, 0xdb, 0x30, 0x06, 0x70, 0x9f, 0xe5, 0xcb, 0x5f, 0x90, 0x00, 0x83,
+	0x51, 0xd0, 0x8b, 0xd0, 0x08, 0x3d, 0xe6, 0xb2, 0x52, 0x45, 0x89, 0xab, 0xa8, 0x0b, 0x9a, 0xd0,
+	0x11, 0x43, 0x84, 0x92, 0x32, 0x57, 0x22, 0x21, 0x42, 0xcb, 0x26, 0xac, 0xc8, 0x6e, 0x88, 0x17,
+	0x52, 0x46, 0x72, 0x86, 0xd8, 0xf6, 0x8e, 0x31, 0x5f, 0xde, 0x82, 0xd1, 0x15, 0x3d, 0x46, 0xcc,
+	0x2d, 0xc6, 0x43, 0x1b, 0x70, 0x31, 0x27, 0x75, 0x40, 0x28, 0xee, 0x55, 0xb8, 0xd0, 0x72, 0x46,
+	0x68, 0x9b, 0x50, 0x8c, 0xde, 0x81, 0x4b, 0x6a, 0x30, 0xd9, 0xae, 0x16, 0xb9, 0xd4, 0x45, 0x39,
+	0xae, 0x4c, 0x5f, 0x9b, 0xb0, 0x92, 0x97, 0xe3, 0x9d, 0x55, 0xc5, 0x08, 0xb3, 0x62, 0xac, 0x37,
+	0xfd, 0xef, 0x05, 0x40, 0x3b, 0x3e, 0xc1, 0x94, 0x7c, 0xa3, 0x45, 0xcb, 0xae, 0x4f, 0x31, 0xb7,
+	0x3e, 0x7d, 0x10, 0xb3, 0x1c, 0x06, 0xe1, 0x68, 0x44, 0x82, 0x20, 0xb5, 0x0a, 0x1d, 0xce, 0xda,
+	0x17, 0x9c, 0xec, 0x5a, 0x08, 0x60, 0x39, 0xbf, 0x5c, 0x6f, 0x82, 0x9c, 0x4b, 0x46, 0xa7, 0xdc,
+	0x68, 0x82, 0x97, 0x54, 0xaa, 0x5f, 0x84, 0xe5, 0xd4, 0x24, 0x85, 0x73, 0xe9, 0x4f, 0xa0, 0xcd,
+	0xa6, 0xb3, 0x65, 0x8e, 0x2d, 0xe7, 0xdc, 0x33, 0xd7, 0xa0, 0xea, 0x7a, 0xc4, 0xc7, 0xd4, 0xf5,
+	0xf9, 0xb4, 0x6b, 0x46, 0xd4, 0x46, 0x3d, 0x58, 0x14, 0xe1, 0x48, 0xc4, 0x95, 0x9a, 0xa1, 0x9a,
+	0xfa, 0x1f, 0x0b, 0xd0, 0x49, 0xf4, 0x29, 0xbd, 0xfc, 0x43, 0x68, 0x98, 0x56, 0xf0, 0x24, 0xc4,
+	0x36, 0xdf, 0x4d, 0xbc, 0xe7, 0xfa, 0x86, 0xd6, 0x17, 0x91, 0xb7, 0xaf, 0x22, 0x6f, 0xff, 0x91,
+	0x8a, 0xbc, 0x46, 0x0a, 0x8f, 0xfe, 0x1f, 0x6a, 0x41, 0x18, 0x78, 0xc4, 0x31, 0x89, 0xd9, 0x2b,
+	0xce, 0x14, 0x8e, 0xc1, 0xe8, 0x7d, 0xa8, 0x3f, 0x09, 0xb1, 0x8f, 0x1d, 0x6a, 0x39, 0xc4, 0xec,
+	0x95, 0x66, 0xca, 0x26, 0xe1, 0xfa, 0xbf, 0x93, 0xb3, 0xd9, 0x73, 0x8f, 0x6e, 0x3a, 0xd4, 0x3f,
+	0x9b, 0xdf, 0x84, 0x2b, 0x50, 0xc1, 0x23, 0xe6, 0x8a, 0xd2, 0x80, 0xb2, 0x95, 0x32, 0x6d, 0x29,
+	0x63, 0xda, 0x1b, 0x50, 0x57, 0xdf, 0xac, 0x83, 0xf2, 0xc4, 0x0e, 0x40, 0x41, 0x06, 0x66, 0x72,
+	0x2d, 0x16, 0x52, 0x6b, 0x81, 0xde, 0x05, 0x18, 0x71, 0xaf, 0x30, 0x87, 0x98, 0xf6, 0x2a, 0x33,
+	0xa7, 0x5e, 0x93, 0xe8, 0x2d, 0xaa, 0x1b, 0xd0, 0xb9, 0x4d, 0x28, 0xeb, 0x6d, 0xcf, 0x3d, 0x3a,
+	0xb7, 0xeb, 0x44, 0xc7, 0x61, 0x31, 0x71, 0x1c, 0xea, 0x7b, 0x80, 0x92, 0x3a, 0xa5, 0x6b, 0xbc,
+	0x03, 0x8b, 0xc4, 0xa1, 0xbe, 0x45, 0x54, 0xfc, 0xbf, 0x92, 0x88, 0xff, 0x39, 0xdb, 0x1b, 0x0a,
+	0xac, 0x6f, 0x03, 0xba, 0x79, 0xea, 0xb9, 0x3e, 0x57, 0x18, 0xed, 0xeb, 0xf8, 0xc8, 0x15, 0x91,
+	0x54, 0xb6, 0xa6, 0x8c, 0xe8, 0xcb, 0x2a, 0x2c, 0xa7, 0x94, 0x44, 0x87, 0xd2, 0x02, 0x9b, 0x89,
+	0x1a, 0xd1, 0x4b, 0x89, 0x11, 0x4d, 0x80, 0xf3, 0x51, 0x1a, 0x42, 0x82, 0x1d, 0x3d, 0x63, 0xd7,
+	0x27, 0xbc, 0x9f, 0xaa, 0xc1, 0xbf, 0xb5, 0x7f, 0x2d, 0x42, 0x99, 0x61, 0xe6, 0x37, 0x60, 0x0f,
+	0x16, 0xb1, 0x69, 0xfa, 0x24, 0x08, 0xa4, 0xe7, 0xa8, 0x26, 0x7a, 0x01, 0xaa, 0xfc, 0xd0, 0x76,
+	0x08, 0x55, 0x5b, 0x8f, 0xb5, 0xef, 0x11, 0x1e, 0x59, 0x78, 0x9c, 0xf0, 0xcf, 0x86, 0x23, 0xd7,
+	0x24, 0xdc, 0x75, 0x6a, 0x46, 0x5d, 0xd2, 0x76, 0xd8, 0x00, 0xba, 0xb0, 0x40, 0xc6, 0x2c, 0x8b,
+	0x10, 0x9e, 0x22, 0x1a, 0xcc, 0x68, 0x4f, 0xb1, 0x6d, 0x13, 0xe1, 0x23, 0x35, 0x43, 0xb6, 0xd0,
+	0x2b, 0xd0, 0x3c, 0xf4, 0x09, 0x19, 0x1e, 0x60, 0xc7, 0x7c, 0x6a, 0x99, 0xf4, 0x98, 0x07, 0xe8,
+	0x92, 0xb1, 0xc4, 0xa8, 0xdb, 0x8a, 0x88, 0x2e, 0x43, 0x8d, 0xc3, 0x4c, 0x2b, 0x38, 0xe1, 0xb1,
+	0xb8, 0x64, 0x54, 0x19, 0x61, 0xd7, 0x0a, 0x4e, 0xd8, 0x4c, 0x3e, 0x23, 0x7e, 0xc0, 0xf6, 0x40,
+	0x4d, 0x0c, 0x57, 0x36, 0xb3, 0x91, 0x15, 0xe6, 0x8d, 0xac, 0xf5, 0x79, 0x23, 0x6b, 0x63, 0xfe,
+	0xc8, 0xba, 0x34, 0x2d, 0xb2, 0xb2, 0xdc, 0x20, 0x7b, 0xc2, 0xf5, 0x9a, 0xfc, 0xbc, 0x69, 0x65,
+	0x0e, 0x37, 0x74, 0x1d, 0x3a, 0xb9, 0x03, 0xaa, 0xd7, 0xe2, 0xd8, 0x76, 0xf6, 0x6c, 0x42, 0x7b,
+	0xd0, 0xe5, 0xeb, 0x38, 0x72, 0x1d, 0x8a, 0x47, 0xd1, 0x1c, 0x7b, 0xed, 0x99, 0xbb, 0x14, 0x31,
+	0xb9, 0x1d, 0x21, 0x26, 0x87, 0x9a, 0xd3, 0x76, 0x88, 0x2d, 0x3b, 0xf4, 0x49, 0xaf, 0x73, 0x2e,
+	0x6d, 0xb7, 0x84, 0x54, 0x2e, 0x5a, 0xa3, 0xe7, 0x89, 0xd6, 0xcb, 0xcf, 0x11, 0xad, 0xbb, 0xe7,
+	0x8a, 0xd6, 0xe8, 0x16, 0x74, 0xc8, 0xa9, 0x45, 0x87, 0x96, 0x63, 0x51, 0x4b, 0x85, 0xbd, 0x8b,
+	0x33, 0x75, 0xb4, 0x98, 0xd0, 0x40, 0xc9, 0x6c, 0x51, 0xb4, 0x0b, 0x6d, 0xae, 0xe7, 0xd0, 0x72,
+	0xac, 0xe0, 0x58, 0xa8, 0x59, 0x99, 0xa9, 0xa6, 0xc9, 0x64, 0x6e, 0x49, 0x91, 0x2d, 0xaa, 0x87,
+	0x70, 0xe9, 0x36, 0xa1, 0x8f, 0xf9, 0xc2, 0xdf, 0xb1, 0x02, 0xea, 0xfa, 0x67, 0xe7, 0x0e, 0xa4,
+	0x6f, 0x41, 0xe5, 0xa9, 0xe5, 0x98, 0xee, 0x53, 0x79, 0xe8, 0xbd, 0x90, 0xeb, 0x7f, 0x57, 0xd6,
+	0x32, 0x86, 0x04, 0xea, 0xbf, 0x2d, 0x43, 0x2f, 0xdf, 0xaf, 0x0c, 0x6c, 0x6f, 0xc2, 0x42, 0x60,
+	0x39, 0x23, 0x32, 0xc7, 0x01, 0x2c, 0x80, 0x4c, 0x22, 0x74, 0x68, 0x54, 0x77, 0x3c, 0x53, 0x82,
+	0x03, 0xd9, 0xe1, 0x26, 0xeb, 0x83, 0x40, 0x66, 0x41, 0x51, 0x1b, 0xbd, 0x0a, 0x2d, 0xe6, 0x9a,
+	0xdc, 0xa4, 0x12, 0x22, 0xf2, 0x9f, 0xa6, 0x20, 0x6f, 0x29, 0xe0, 0xdb, 0x50, 0x35, 0xdd, 0xa7,
+	0x0e, 0x9b, 0x43, 0x6f, 0x61, 0xd6, 0xd4, 0x23, 0x28, 0xda, 0x83, 0x45, 0x61, 0x86, 0xa0, 0x57,
+	0xe1, 0xa1, 0x7b, 0x23, 0x11, 0xba, 0xa7, 0x59, 0xa5, 0xbf, 0x2b, 0xc5, 0x3f, 0xe1, 0xa2, 0x86,
+	0x52, 0x91, 0xf6, 0xe3, 0xc5, 0xf3, 0xf8, 0x71, 0x76, 0x07, 0x55, 0xcf, 0xb7, 0x83, 0x34, 0x0f,
+	0x9a, 0xe9, 0x41, 0xf1, 0x95, 0xa3, 0xd8, 0xa7, 0x73, 0xad, 0x1c, 0x03, 0xa2, 0x37, 0xa0, 0x44,
+	0x9c, 0x79, 0xb2, 0x25, 0x06, 0xd3, 0x5f, 0x07, 0xc4, 0x03, 0x5e, 0xfa, 0x20, 0xec, 0xc2, 0x42,
+	0xb2, 0x2e, 0x11, 0x0d, 0x7d, 0x19, 0x3a, 0x49, 0x2c, 0xf7, 0x69, 0x46, 0xbc, 0x4d, 0xe8, 0x76,
+	0x38, 0x3a, 0x21, 0x51, 0x9a, 0xad, 0xdf, 0x01, 0x94, 0x24, 0xc6, 0x5a, 0xa9, 0x4b, 0xb1, 0xad,
+	0xb4, 0xf2, 0x06, 0xba, 0x02, 0x25, 0xcb, 0x64, 0xe7, 0x5d, 0xe9, 0x5a, 0x63, 0x1b, 0x12, 0x9b,
+	0x81, 0x91, 0xf5, 0x0d, 0x68, 0x47, 0x9a, 0xd4, 0x36, 0x5a, 0x85, 0xe2, 0xd4, 0x1d, 0x54, 0xb4,
+	0x4c, 0xfd, 0x71, 0x62, 0x48, 0x51, 0xe7, 0x33, 0x84, 0xd0, 0xba, 0x3a, 0xfb, 0x8b, 0xdc, 0x81,
+	0xa0, 0xcf, 0x5a, 0xc9, 0x23, 0x5e, 0x7f, 0x1d, 0x2a, 0x42, 0xe7, 0x1c, 0xd8, 0x3e, 0x80, 0xc0,
+	0xb2, 0x3a, 0x18, 0xad, 0xa7, 0xf3, 0x8a, 0x09, 0xf8, 0xbb, 0xd0, 0x7a, 0x60, 0x39, 0x47, 0x9c,
+	0x34, 0xdf, 0x2c, 0xa7, 0xe7, 0x0a, 0xba, 0x0e, 0xed, 0x58, 0x99, 0x9c, 0x7e, 0x13, 0x8a, 0xee,
+	0x09, 0xd7, 0x56, 0x35, 0x8a, 0xee, 0x89, 0xfe, 0x01, 0x74, 0xf6, 0x5c, 0xf7, 0x24, 0xf4, 0x92,
+	0x5d, 0x36, 0xa3, 0x2e, 0x6b, 0x33, 0xba, 0xf8, 0x14, 0x50, 0x52, 0x3c, 0xb2, 0x71, 0x99, 0x4d,
+	0x47, 0xfa, 0x6a, 0x72, 0x9a, 0x9c, 0x8e, 0xfe, 0x07, 0xca, 0x63, 0x56, 0xb8, 0xa9, 0xbb, 0x8c,
+	0x88, 0xff, 0x11, 0xa1, 0xd8, 0xc4, 0x14, 0x1b, 0x9c, 0xaf, 0xff, 0x10, 0x5a, 0x7c, 0xa2, 0xce,
+	0xa1, 0x3b, 0xaf, 0x35, 0xae, 0xa7, 0x87, 0x5a, 0xdf, 0xe8, 0xc4, 0xda, 0xb7, 0x04, 0x23, 0x1e,
+	0xfd, 0xef, 0x0b, 0xd0, 0x8e, 0x3b, 0x90, 0x83, 0xd7, 0xa1, 0x4c, 0xcf, 0x3c, 0x31, 0xf8, 0xe6,
+	0x46, 0x33, 0x16, 0x7f, 0x74, 0xe6, 0x11, 0x83, 0xf3, 0x50, 0x3f, 0x53, 0x1b, 0xa5, 0x26, 0x71,
+	0x5f, 0x72, 0x12, 0x49, 0x7d, 0x1f, 0xaa, 0x23, 0xec, 0xe1, 0x91, 0x45, 0xcf, 0x7a, 0xa5, 0x2c,
+	0x7e, 0x47, 0x72, 0x8c, 0x08, 0xc3, 0x66, 0xa1, 0xb2, 0xa6, 0x72, 0x76, 0x16, 0x1f, 0x0b, 0x46,
+	0x94, 0x48, 0xe9, 0x63, 0x68, 0xdd, 0xb2, 0x1c, 0xf3, 0x1e, 0xc1, 0xfe, 0xbc, 0x56, 0x7a, 0x59,
+	0x45, 0x93, 0xe2, 0x44, 0x88, 0x60, 0xc6, 0x49, 0xb3, 0x08, 0xe3, 0xa2, 0xa1, 0x6f, 0x42, 0x3b,
+	0xee, 0x4e, 0xda, 0x6c, 0xf6, 0x46, 0x40, 0xd0, 0xde, 0x0d, 0xc7, 0x5e, 0x2a, 0x64, 0xbc, 0x0d,
+	0x9d, 0x04, 0x2d, 0xab, 0x6a, 0xea, 0x1e, 0x59, 0x81, 0x6e, 0xb4, 0xad, 0xd9, 0xb6, 0x52, 0xea,
+	0x7e, 0x5a, 0x82, 0x8b, 0x19, 0x86, 0xd4, 0xb9, 0x05, 0x8b, 0x07, 0x9c, 0xaa, 0xb4, 0xbe, 0x9a,
+	0x3e, 0x16, 0xf2, 0x22, 0x7d, 0x41, 0x32, 0x94, 0x1c, 0xcb, 0x56, 0xc5, 0xe7, 0x30, 0xb0, 0x3e,
+	0x27, 0xea, 0x1e, 0x40, 0x90, 0xf6, 0xad, 0xcf, 0x09, 0xbb, 0x9e, 0xf0, 0x89, 0x67, 0xe3, 0x11,
+	0xe1, 0xb7, 0x75, 0x23, 0x3c, 0x3a, 0x26, 0x02, 0x2b, 0xac, 0xd7, 0x4d, 0x70, 0x77, 0x18, 0x93,
+	0x49, 0x69, 0x7f, 0x2e, 0x44, 0xc1, 0xe4, 0x3a, 0xd4, 0x64, 0x0f, 0x53, 0x97, 0xae, 0x2a, 0x00,
+	0x03, 0x13, 0xdd, 0x80, 0x25, 0xdf, 0x0d, 0xa9, 0xe5, 0x1c, 0x0d, 0xa7, 0x19, 0xbe, 0x21, 0x01,
+	0xac, 0x11, 0xa0, 0xff, 0x85, 0x06, 0x1f, 0x92, 0x29, 0xf1, 0xa5, 0x1c, 0xbe, 0x2e, 0xf8, 0x02,
+	0xfe, 0x01, 0x34, 0x78, 0x42, 0x19, 0x7a, 0x26, 0xa6, 0xc4, 0xec, 0x95, 0x67, 0x9e, 0x22, 0x75,
+	0x86, 0x7f, 0x2c, 0xe0, 0xfa, 0x26, 0xa0, 0x47, 0x3e, 0x1e, 0x11, 0x11, 0x1b, 0xe6, 0x8d, 0xd7,
+	0xbf, 0x2a, 0xc0, 0x72, 0x4a, 0x6c, 0xce, 0x70, 0x72, 0x0d, 0xca, 0xc7, 0xae, 0xa7, 0x6c, 0xd0,
+	0x4d, 0xac, 0xad, 0x50, 0x74, 0xc7, 0xf5, 0x0c, 0x8e, 0xe0, 0x69, 0x85, 0xcc, 0x1a, 0x7a, 0xa5,
+	0xd9, 0x69, 0x85, 0xfc, 0xe2, 0x65, 0x93, 0xef, 0xbb, 0xbe, 0x2c, 0xa9, 0x44, 0x43, 0xff, 0x4d,
+	0x01, 0x6a, 0x51, 0x07, 0x33, 0x07, 0xf9, 0x21, 0x2c, 0xf9, 0x72, 0x42, 0x43, 0x9e, 0xd6, 0xcc,
+	0xcc, 0xe8, 0x1a, 0x0a, 0xcf, 0x8c, 0xcc, 0x16, 0xd0, 0x27, 0x34, 0xf4, 0x1d, 0x62, 0x0e, 0x2d,
+	0x53, 0x2c, 0x60, 0xfa, 0x9c, 0xac, 0x2b, 0xfe, 0xc0, 0x0c, 0xa6, 0x0c, 0xb9, 0x09, 0x8d, 0xe4,
+	0x35, 0x98, 0xfe, 0x75, 0x09, 0x96, 0x19, 0x61, 0x3f, 0x1c, 0x8f, 0x71, 0x22, 0x4f, 0xbc, 0x0a,
+	0x10, 0x06, 0xc4, 0x1c, 0x06, 0x1e, 0x96, 0xc9, 0x62, 0xc9, 0xa8, 0x31, 0xca, 0x3e, 0x23, 0xb0,
+	0x34, 0x0e, 0x7f, 0x86, 0x2d, 0x9b, 0xdd, 0xcb, 0x4a, 0x8c, 0xd8, 0x10, 0xcd, 0x88, 0x2c, 0x80,
+	0xac, 0x24, 0x63, 0x7a, 0x2c, 0xe7, 0x88, 0x87, 0x64, 0x75, 0x37, 0x19, 0x10, 0x73, 0x20, 0x48,
+	0x6c, 0x63, 0x71, 0x08, 0x11, 0x08, 0x91, 0x0e, 0xf2, 0xde, 0x6f, 0x0a, 0xc0, 0x2b, 0xd0, 0xe4,
+	0x80, 0xb8, 0x0a, 0x15, 0xf7, 0x60, 0x4b, 0x8c, 0x1a, 0x57, 0xa1, 0x37, 0x60, 0x39, 0x1e, 0x53,
+	0x8c, 0xad, 0x70, 0x2c, 0x8a, 0x58, 0xb1, 0xc0, 0x26, 0xac, 0x90, 0x53, 0xcf, 0xf2, 0x89, 0x29,
+	0xaf, 0xc8, 0x87, 0x26, 0xb1, 0x09, 0x95, 0xa9, 0x5e, 0xc9, 0xe8, 0x4a, 0xae, 0xb8, 0x2d, 0xdf,
+	0x15, 0x3c, 0x56, 0x94, 0x2a, 0xa9, 0x83, 0x33, 0x4a, 0x82, 0x21, 0xab, 0x74, 0x4d, 0x59, 0xf6,
+	0x76, 0x24, 0x6b, 0x9b, 0x71, 0x6e, 0x31, 0x06, 0x2b, 0x8e, 0x59, 0x5d, 0xcc, 0x61, 0xbc, 0x02,
+	0x2e, 0x19, 0x55, 0x46, 0x60, 0x5c, 0xf4, 0x06, 0x20, 0xce, 0x14, 0x06, 0x08, 0xa8, 0x35, 0xc6,
+	0x94, 0xc8, 0x4a, 0xb8, 0xcd, 0x38, 0x8f, 0x99, 0x19, 0x24, 0x3d, 0x56, 0x15, 0xda, 0x36, 0xaf,
+	0x82, 0xab, 0x52, 0x55, 0x68, 0xdb, 0xac, 0x4e, 0xe5, 0x4c, 0xd3, 0x0a, 0x46, 0x3e, 0xf1, 0xb0,
+	0x33, 0x3a, 0xe3, 0x05, 0x70, 0xd5, 0x68, 0x31, 0xfa, 0x6e, 0x4c, 0xd6, 0x2f, 0xc1, 0xc5, 0x7d,
+	0x4c, 0x89, 0x6d, 0x5b, 0xe9, 0x4b, 0x51, 0xfd, 0xe7, 0x25, 0x58, 0xc9, 0x72, 0xa4, 0x43, 0xdc,
+	0x05, 0x08, 0x14, 0x47, 0x05, 0xd1, 0xeb, 0x89, 0x8d, 0x36, 0x59, 0x2c, 0x26, 0x1b, 0x09, 0x71,
+	0xed, 0x97, 0x45, 0xa8, 0x45, 0x1c, 0xf4, 0x16, 0x34, 0x22, 0xde, 0xf4, 0xd0, 0x57, 0x8f, 0x30,
+	0x03, 0x33, 0xe3, 0x9e, 0xc5, 0x49, 0xee, 0x69, 0xdb, 0xee, 0x08, 0xd3, 0x08, 0x53, 0x92, 0xee,
+	0xa9, 0xc8, 0x93, 0xdd, 0xb3, 0x3c, 0xd3, 0x3d, 0x17, 0xe6, 0x70, 0xcf, 0xca, 0x34, 0xf7, 0x8c,
+	0xc6, 0x94, 0xbd, 0x50, 0x41, 0x11, 0x2b, 0x12, 0xd0, 0x3f, 0x86, 0xce, 0xcd, 0xb1, 0x47, 0xcf,
+	0x1e, 0xf9, 0x38, 0x38, 0x56, 0x11, 0x74, 0x0b, 0x9a, 0x94, 0xb5, 0x99, 0x0e, 0x72, 0xe8, 0xfa,
+	0xf3, 0x14, 0x72, 0x4b, 0x52, 0x62, 0x9b, 0x0b, 0xb0, 0x8c, 0x2d, 0xa9, 0x57, 0xae, 0xef, 0x2b,
+	0xd0, 0xcc, 0x6c, 0x02, 0xb1, 0xe9, 0x97, 0xbc, 0x94, 0xf7, 0xb3, 0x53, 0x30, 0xe1, 0xf5, 0xea,
+	0x14, 0x8c, 0xdc, 0x5d, 0xef, 0x02, 0xba, 0xef, 0x9b, 0xc4, 0x7f, 0x18, 0x92, 0x30, 0x3e, 0xe8,
+	0x7f, 0x5c, 0x84, 0xe5, 0x14, 0x59, 0xf6, 0xfa, 0x3e, 0x54, 0x9e, 0x70, 0x8a, 0xf4, 0xa8, 0x97,
+	0x13, 0x1e, 0x35, 0x01, 0xdf, 0xe7, 0x4d, 0x43, 0xca, 0x68, 0xbf, 0x2e, 0xc0, 0x02, 0xa7, 0x7c,
+	0x13, 0x17, 0x5a, 0x81, 0x4a, 0xe8, 0x04, 0x24, 0xba, 0xd2, 0x97, 0x2d, 0x56, 0xbd, 0xca, 0xcb,
+	0x13, 0xf5, 0x64, 0x16, 0xb5, 0xd9, 0xa1, 0xe8, 0x90, 0x53, 0x9a, 0x7a, 0x29, 0x9b, 0x71, 0x28,
+	0x32, 0xbc, 0x2c, 0x6a, 0x79, 0x0a, 0x84, 0x83, 0xe3, 0x03, 0x17, 0xfb, 0xa6, 0xb2, 0xcc, 0x9f,
+	0x4a, 0xd0, 0x49, 0x10, 0xa5, 0x5d, 0xe6, 0xbe, 0x1f, 0xe0, 0x2f, 0x57, 0x26, 0xbb, 0xf0, 0x72,
+	0x1c, 0xc2, 0xef, 0x96, 0x03, 0x39, 0x9f, 0x16, 0xa3, 0xef, 0xc4, 0x64, 0x76, 0x3b, 0x75, 0xe0,
+	0xba, 0x34, 0xa0, 0x3e, 0xf6, 0x86, 0x2a, 0x45, 0x16, 0x37, 0x88, 0xed, 0x88, 0x21, 0x33, 0x64,
+	0xa6, 0x97, 0xbf, 0x29, 0x3a, 0xd8, 0x8e, 0xb0, 0xe2, 0x20, 0x69, 0x29, 0x7a, 0x02, 0x4a, 0x4e,
+	0x33, 0x50, 0x71, 0xbb, 0xd8, 0x22, 0xa7, 0x69, 0xe8, 0x26, 0xcf, 0x3a, 0x69, 0x20, 0xaf, 0xa2,
+	0x57, 0x93, 0xf1, 0x23, 0x7f, 0x08, 0x19, 0x02, 0xcc, 0xee, 0x40, 0xc4, 0xed, 0x59, 0x6f, 0x71,
+	0xd6, 0x89, 0x29, 0x81, 0xe8, 0x3d, 0xe0, 0xd9, 0xc8, 0xd0, 0xb3, 0x9c, 0xa3, 0xb9, 0xaa, 0x6f,
+	0x60, 0xf0, 0x07, 0x1c, 0x1d, 0xa5, 0x3e, 0x4f, 0x42, 0xe2, 0xb3, 0xda, 0xbd, 0x36, 0x5f, 0xea,
+	0xf3, 0x50, 0xc0, 0xf5, 0x2f, 0x0a, 0xd0, 0x95, 0x8f, 0x8c, 0x77, 0x08, 0xb6, 0xe9, 0x71, 0xe2,
+	0x6a, 0x5a, 0xa4, 0x6f, 0xf2, 0x59, 0x52, 0xb6, 0xd8, 0xd6, 0x23, 0xce, 0xc8, 0x3f, 0xf3, 0x58,
+	0x64, 0xe0, 0xcf, 0x96, 0x3c, 0x29, 0x37, 0x96, 0x22, 0xea, 0x03, 0xf6, 0x12, 0xfc, 0x12, 0xa8,
+	0xf7, 0xdd, 0xa1, 0xe5, 0x98, 0xe4, 0x54, 0x86, 0xb4, 0x86, 0x24, 0x0e, 0x18, 0x8d, 0x05, 0x46,
+	0xcf, 0x77, 0x7f, 0x44, 0x46, 0x34, 0x7a, 0x3b, 0x30, 0x6a, 0x92, 0x32, 0x30, 0xf5, 0x3d, 0x58,
+	0x4a, 0x0d, 0x8d, 0x05, 0x40, 0xd7, 0xb1, 0x2d, 0x87, 0x0c, 0x55, 0xce, 0xcd, 0x3c, 0xbe, 0x2e,
+	0x68, 0x22, 0x13, 0xec, 0xc1, 0xa2, 0xec, 0x42, 0x8e, 0x4b, 0x35, 0xf5, 0x9f, 0x14, 0xe0, 0x62,
+	0x66, 0xa6, 0xd1, 0x35, 0x53, 0xe5, 0x98, 0x53, 0x64, 0x78, 0xea, 0x25, 0x57, 0x3a, 0x25, 0x21,
+	0x71, 0xe8, 0x3d, 0x00, 0x9f, 0x98, 0xa1, 0x63, 0xf2, 0x83, 0x4b, 0xa4, 0x46, 0x97, 0x13, 0x6f,
+	0xdc, 0x46, 0xc4, 0xdc, 0x1f, 0x1d, 0x93, 0x31, 0x31, 0x12, 0x70, 0xfd, 0x9f, 0x05, 0x58, 0xbe,
+	0x7f, 0xc0, 0xe6, 0x98, 0xb6, 0x78, 0xde, 0xb2, 0x85, 0x49, 0x96, 0x8d, 0x17, 0xa6, 0x98, 0x5a,
+	0x98, 0xb4, 0x31, 0x4b, 0x19, 0x63, 0xb2, 0x4c, 0x80, 0x97, 0x49, 0x43, 0x7c, 0x48, 0x89, 0x3f,
+	0x54, 0x46, 0x92, 0xcf, 0xe7, 0x9c, 0xb5, 0xc5, 0x38, 0x72, 0xc2, 0xec, 0xb0, 0x27, 0x8e, 0x8a,
+	0xdb, 0x11, 0x5c, 0x1c, 0x28, 0x6d, 0xe2, 0xc8, 0xf8, 0xac, 0xd0, 0x51, 0xed, 0x55, 0x49, 0x3e,
+	0x58, 0xfc, 0xac, 0x00, 0xdd, 0xf4, 0x4c, 0xa5, 0xc5, 0x37, 0x73, 0xcf, 0xe8, 0xd3, 0x6d, 0x1e,
+	0x21, 0x9f, 0xcb, 0xea, 0x1b, 0x7f, 0x2d, 0x43, 0xe3, 0x2e, 0x36, 0x07, 0xaa, 0x17, 0x34, 0x00,
+	0x88, 0xaf, 0x85, 0x50, 0xf2, 0x19, 0x27, 0x77, 0x5b, 0xa4, 0x5d, 0x9d, 0xc2, 0x95, 0xd3, 0xd9,
+	0x81, 0xaa, 0xba, 0xb9, 0x40, 0x5a, 0x02, 0x9a, 0xb9, 0x1b, 0xd1, 0x2e, 0x4f, 0xe4, 0x49, 0x25,
+	0x03, 0x80, 0xf8, 0x6e, 0x22, 0x35, 0x9e, 0xdc, 0x8d, 0x87, 0x76, 0x75, 0x0a, 0x37, 0x1e, 0x8f,
+	0xba, 0x27, 0x48, 0x8d, 0x27, 0x73, 0x3b, 0xa1, 0x5d, 0x9e, 0xc8, 0x8b, 0x95, 0xa8, 0xc2, 0x39,
+	0xa5, 0x24, 0x53, 0xbc, 0x6b, 0x97, 0x27, 0xf2, 0xa4, 0x92, 0x5b, 0x50, 0x8b, 0x6a, 0x66, 0x94,
+	0x44, 0x66, 0xab, 0x6b, 0xed, 0xca, 0x64, 0xa6, 0xd4, 0x63, 0xc0, 0x52, 0xaa, 0xf0, 0x45, 0x6b,
+	0xd3, 0x4b, 0x62, 0xa1, 0x6f, 0x7d, 0x56, 0xcd, 0x8c, 0xf6, 0xa0, 0x9e, 0x28, 0xdf, 0x50, 0xd2,
+	0xa6, 0xf9, 0x6a, 0x50, 0x5b, 0x9d, 0xc6, 0x16, 0xda, 0x36, 0x7e, 0x51, 0x82, 0xf6, 0xfd, 0xcf,
+	0x88, 0x6f, 0xe3, 0xb3, 0xff, 0x8a, 0x8f, 0x7d, 0x5b, 0x96, 0xdc, 0x81, 0xaa, 0xfa, 0xab, 0x23,
+	0xb5, 0xac, 0x99, 0xff, 0x44, 0xb4, 0xcb, 0x13, 0x79, 0xb1, 0xe9, 0x12, 0x0f, 0xf8, 0x29, 0xd3,
+	0xe5, 0xff, 0x5e, 0xd0, 0x56, 0xa7, 0xb1, 0xa5, 0xb6, 0x1f, 0x40, 0x3b, 0x7b, 0xd9, 0x8d, 0xf4,
+	0x67, 0xde, 0x84, 0x0b, 0xbd, 0x2f, 0xcd, 0x71, 0x5b, 0xbe, 0xf1, 0x97, 0x12, 0x34, 0xe4, 0xba,
+	0xf0, 0xa7, 0x59, 0x74, 0x17, 0x9a, 0x0f, 0xa3, 0x57, 0x18, 0xbe, 0xd7, 0x2e, 0x4f, 0x7a, 0xc2,
+	0x9d, 0x64, 0xcd, 0xfc, 0x9f, 0x02, 0x77, 0xa1, 0xb9, 0xab, 0x6e, 0xc2, 0xcf, 0x9e, 0x57, 0xd9,
+	0x77, 0x61, 0xc9, 0x20, 0x96, 0x13, 0x50, 0x4c, 0x9f, 0x7b, 0x60, 0x03, 0x68, 0x6c, 0x39, 0x8e,
+	0xfb, 0xed, 0xa8, 0x82, 0xf8, 0x21, 0x3c, 0xe5, 0xc4, 0xb9, 0x37, 0x77, 0xed, 0xea, 0x14, 0x6e,
+	0xec, 0x37, 0x89, 0x17, 0xe9, 0x94, 0xdf, 0xe4, 0x5f, 0xc7, 0xb5, 0xd5, 0x69, 0x6c, 0xb9, 0xb4,
+	0x5f, 0x94, 0x60, 0x99, 0x97, 0xbb, 0xfb, 0xd4, 0xf5, 0x49, 0xbc, 0xeb, 0xb6, 0x61, 0x41, 0xf8,
+	0xe5, 0xa5, 0x4c, 0xca, 0x36, 0x51, 0xf3, 0x84, 0x5c, 0x4e, 0xbf, 0x80, 0xee, 0x40, 0x2d, 0x4a,
+	0x74, 0xd3, 0xdb, 0x2d, 0x93, 0x13, 0x6b, 0x57, 0x26, 0x33, 0x23, 0x4d, 0x9f, 0x40, 0x33, 0x5d,
+	0x6e, 0xa2, 0xf5, 0x67, 0x54, 0xa2, 0x42, 0xe7, 0x8b, 0x33, 0x6b, 0x55, 0xfd, 0x02, 0x2b, 0x72,
+	0xe3, 0xd2, 0x28, 0xb5, 0x2e, 0xb9, 0x4a, 0x4c, 0xbb, 0x3a, 0x85, 0x1b, 0x29, 0xbb, 0x07, 0xf5,
+	0x44, 0x09, 0x93, 0x5a, 0x99, 0x7c, 0x85, 0xa4, 0xad, 0x4e, 0x63, 0x2b, 0x7d, 0x1b, 0x7f, 0x2b,
+	0x40, 0x37, 0xf1, 0x3b, 0x5c, 0xbc, 0x38, 0x1e, 0x5c, 0x9a, 0xf2, 0x93, 0x1d, 0x7a, 0x2d, 0x79,
+	0xaa, 0x3d, 0xf3, 0x0f, 0x46, 0xed, 0xf5, 0x79, 0xa0, 0xd2, 0xe9, 0xbe, 0x07, 0xad, 0xcc, 0xaf,
+	0x74, 0x28, 0x69, 0xdf, 0xc9, 0x3f, 0xe5, 0x69, 0xfa, 0xb3, 0x20, 0xd2, 0x01, 0x7f, 0x57, 0x80,
+	0x96, 0xc8, 0x52, 0xe2, 0xf9, 0x3d, 0x84, 0x46, 0x32, 0xe5, 0x41, 0x29, 0x53, 0xe5, 0xb3, 0x3e,
+	0x6d, 0x6d, 0x2a, 0x3f, 0x5a, 0x9b, 0x47, 0xd9, 0x3c, 0x78, 0x6d, 0x6a, 0xb2, 0x34, 0xe1, 0xf0,
+	0x9b, 0x98, 0xf3, 0xea, 0x17, 0xb6, 0xcb, 0xdf, 0x2f, 0x7a, 0x07, 0x07, 0x15, 0x5e, 0x20, 0xfc,
+	0xdf, 0x7f, 0x06, 0x00, 0xa0, 0x06, 0x84, 0xc6, 0xbb, 0x2a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1755,6 +3397,10 @@ type KadInspectorClient interface {
 	FindNear(ctx context.Context, in *FindNearRequest, opts ...grpc.CallOption) (*FindNearResponse, error)
 	// DumpNodes returns all the nodes in the node database
 	DumpNodes(ctx context.Context, in *DumpNodesRequest, opts ...grpc.CallOption) (*DumpNodesResponse, error)
+	// GetBucketList returns the buckets of the routing table with their nodes and replacement caches
+	GetBucketList(ctx context.Context, in *GetBucketListRequest, opts ...grpc.CallOption) (*GetBucketListResponse, error)
+	// TraceLookup triggers a Kademlia FindNode and returns every node queried during the lookup
+	TraceLookup(ctx context.Context, in *TraceLookupRequest, opts ...grpc.CallOption) (*TraceLookupResponse, error)
 }
 
 type kadInspectorClient struct {
@@ -1819,6 +3465,24 @@ func (c *kadInspectorClient) DumpNodes(ctx context.Context, in *DumpNodesRequest
 	return out, nil
 }
 
+func (c *kadInspectorClient) GetBucketList(ctx context.Context, in *GetBucketListRequest, opts ...grpc.CallOption) (*GetBucketListResponse, error) {
+	out := new(GetBucketListResponse)
+	err := c.cc.Invoke(ctx, "/inspector.KadInspector/GetBucketList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kadInspectorClient) TraceLookup(ctx context.Context, in *TraceLookupRequest, opts ...grpc.CallOption) (*TraceLookupResponse, error) {
+	out := new(TraceLookupResponse)
+	err := c.cc.Invoke(ctx, "/inspector.KadInspector/TraceLookup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // KadInspectorServer is the server API for KadInspector service.
 type KadInspectorServer interface {
 	// CountNodes returns the number of nodes in the routing table
@@ -1833,6 +3497,10 @@ type KadInspectorServer interface {
 	FindNear(context.Context, *FindNearRequest) (*FindNearResponse, error)
 	// DumpNodes returns all the nodes in the node database
 	DumpNodes(context.Context, *DumpNodesRequest) (*DumpNodesResponse, error)
+	// GetBucketList returns the buckets of the routing table with their nodes and replacement caches
+	GetBucketList(context.Context, *GetBucketListRequest) (*GetBucketListResponse, error)
+	// TraceLookup triggers a Kademlia FindNode and returns every node queried during the lookup
+	TraceLookup(context.Context, *TraceLookupRequest) (*TraceLookupResponse, error)
 }
 
 func RegisterKadInspectorServer(s *grpc.Server, srv KadInspectorServer) {
@@ -1935,14 +3603,50 @@ func _KadInspector_DumpNodes_Handler(srv interface{}, ctx context.Context, dec f
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KadInspectorServer).DumpNodes(ctx, in)
+		return srv.(KadInspectorServer).DumpNodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inspector.KadInspector/DumpNodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KadInspectorServer).DumpNodes(ctx, req.(*DumpNodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KadInspector_GetBucketList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBucketListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KadInspectorServer).GetBucketList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inspector.KadInspector/GetBucketList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KadInspectorServer).GetBucketList(ctx, req.(*GetBucketListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KadInspector_TraceLookup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TraceLookupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KadInspectorServer).TraceLookup(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/inspector.KadInspector/DumpNodes",
+		FullMethod: "/inspector.KadInspector/TraceLookup",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KadInspectorServer).DumpNodes(ctx, req.(*DumpNodesRequest))
+		return srv.(KadInspectorServer).TraceLookup(ctx, req.(*TraceLookupRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1975,6 +3679,14 @@ var _KadInspector_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DumpNodes",
 			Handler:    _KadInspector_DumpNodes_Handler,
 		},
+		{
+			MethodName: "GetBucketList",
+			Handler:    _KadInspector_GetBucketList_Handler,
+		},
+		{
+			MethodName: "TraceLookup",
+			Handler:    _KadInspector_TraceLookup_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inspector.proto",
@@ -1992,6 +3704,8 @@ type OverlayInspectorClient interface {
 	GetStats(ctx context.Context, in *GetStatsRequest, opts ...grpc.CallOption) (*GetStatsResponse, error)
 	// CreateStats creates a node with specified stats
 	CreateStats(ctx context.Context, in *CreateStatsRequest, opts ...grpc.CallOption) (*CreateStatsResponse, error)
+	// GetUptimeHistory returns when a node was offline within the window
+	GetUptimeHistory(ctx context.Context, in *GetUptimeHistoryRequest, opts ...grpc.CallOption) (*GetUptimeHistoryResponse, error)
 }
 
 type overlayInspectorClient struct {
@@ -2038,6 +3752,15 @@ func (c *overlayInspectorClient) CreateStats(ctx context.Context, in *CreateStat
 	return out, nil
 }
 
+func (c *overlayInspectorClient) GetUptimeHistory(ctx context.Context, in *GetUptimeHistoryRequest, opts ...grpc.CallOption) (*GetUptimeHistoryResponse, error) {
+	out := new(GetUptimeHistoryResponse)
+	err := c.cc.Invoke(ctx, "/inspector.OverlayInspector/GetUptimeHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OverlayInspectorServer is the server API for OverlayInspector service.
 type OverlayInspectorServer interface {
 	// CountNodes returns the number of nodes in the cache
@@ -2048,6 +3771,8 @@ type OverlayInspectorServer interface {
 	GetStats(context.Context, *GetStatsRequest) (*GetStatsResponse, error)
 	// CreateStats creates a node with specified stats
 	CreateStats(context.Context, *CreateStatsRequest) (*CreateStatsResponse, error)
+	// GetUptimeHistory returns when a node was offline within the window
+	GetUptimeHistory(context.Context, *GetUptimeHistoryRequest) (*GetUptimeHistoryResponse, error)
 }
 
 func RegisterOverlayInspectorServer(s *grpc.Server, srv OverlayInspectorServer) {
@@ -2126,6 +3851,24 @@ func _OverlayInspector_CreateStats_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OverlayInspector_GetUptimeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUptimeHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OverlayInspectorServer).GetUptimeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inspector.OverlayInspector/GetUptimeHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OverlayInspectorServer).GetUptimeHistory(ctx, req.(*GetUptimeHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _OverlayInspector_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "inspector.OverlayInspector",
 	HandlerType: (*OverlayInspectorServer)(nil),
@@ -2146,6 +3889,251 @@ var _OverlayInspector_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreateStats",
 			Handler:    _OverlayInspector_CreateStats_Handler,
 		},
+		{
+			MethodName: "GetUptimeHistory",
+			Handler:    _OverlayInspector_GetUptimeHistory_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "inspector.proto",
+}
+
+// OverlayAdminClient is the client API for OverlayAdmin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type OverlayAdminClient interface {
+	// QuarantineNode excludes a node from new uploads
+	QuarantineNode(ctx context.Context, in *NodeAdminRequest, opts ...grpc.CallOption) (*NodeAdminResponse, error)
+	// DisqualifyNode disqualifies a node permanently
+	DisqualifyNode(ctx context.Context, in *NodeAdminRequest, opts ...grpc.CallOption) (*NodeAdminResponse, error)
+	// ReinstateNode lifts the disqualification, suspension and quarantine of a node
+	ReinstateNode(ctx context.Context, in *NodeAdminRequest, opts ...grpc.CallOption) (*NodeAdminResponse, error)
+	// AnnotateNode adds a note about a node to the admin log
+	AnnotateNode(ctx context.Context, in *NodeAdminRequest, opts ...grpc.CallOption) (*NodeAdminResponse, error)
+	// GetNodeLog returns the changes made to a node by operators, newest first
+	GetNodeLog(ctx context.Context, in *GetNodeLogRequest, opts ...grpc.CallOption) (*GetNodeLogResponse, error)
+	// ExportNodes returns a page of the node table
+	ExportNodes(ctx context.Context, in *ExportNodesRequest, opts ...grpc.CallOption) (*ExportNodesResponse, error)
+}
+
+type overlayAdminClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewOverlayAdminClient(cc *grpc.ClientConn) OverlayAdminClient {
+	return &overlayAdminClient{cc}
+}
+
+func (c *overlayAdminClient) QuarantineNode(ctx context.Context, in *NodeAdminRequest, opts ...grpc.CallOption) (*NodeAdminResponse, error) {
+	out := new(NodeAdminResponse)
+	err := c.cc.Invoke(ctx, "/inspector.OverlayAdmin/QuarantineNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *overlayAdminClient) DisqualifyNode(ctx context.Context, in *NodeAdminRequest, opts ...grpc.CallOption) (*NodeAdminResponse, error) {
+	out := new(NodeAdminResponse)
+	err := c.cc.Invoke(ctx, "/inspector.OverlayAdmin/DisqualifyNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *overlayAdminClient) ReinstateNode(ctx context.Context, in *NodeAdminRequest, opts ...grpc.CallOption) (*NodeAdminResponse, error) {
+	out := new(NodeAdminResponse)
+	err := c.cc.Invoke(ctx, "/inspector.OverlayAdmin/ReinstateNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *overlayAdminClient) AnnotateNode(ctx context.Context, in *NodeAdminRequest, opts ...grpc.CallOption) (*NodeAdminResponse, error) {
+	out := new(NodeAdminResponse)
+	err := c.cc.Invoke(ctx, "/inspector.OverlayAdmin/AnnotateNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *overlayAdminClient) GetNodeLog(ctx context.Context, in *GetNodeLogRequest, opts ...grpc.CallOption) (*GetNodeLogResponse, error) {
+	out := new(GetNodeLogResponse)
+	err := c.cc.Invoke(ctx, "/inspector.OverlayAdmin/GetNodeLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *overlayAdminClient) ExportNodes(ctx context.Context, in *ExportNodesRequest, opts ...grpc.CallOption) (*ExportNodesResponse, error) {
+	out := new(ExportNodesResponse)
+	err := c.cc.Invoke(ctx, "/inspector.OverlayAdmin/ExportNodes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OverlayAdminServer is the server API for OverlayAdmin service.
+type OverlayAdminServer interface {
+	// QuarantineNode excludes a node from new uploads
+	QuarantineNode(context.Context, *NodeAdminRequest) (*NodeAdminResponse, error)
+	// DisqualifyNode disqualifies a node permanently
+	DisqualifyNode(context.Context, *NodeAdminRequest) (*NodeAdminResponse, error)
+	// ReinstateNode lifts the disqualification, suspension and quarantine of a node
+	ReinstateNode(context.Context, *NodeAdminRequest) (*NodeAdminResponse, error)
+	// AnnotateNode adds a note about a node to the admin log
+	AnnotateNode(context.Context, *NodeAdminRequest) (*NodeAdminResponse, error)
+	// GetNodeLog returns the changes made to a node by operators, newest first
+	GetNodeLog(context.Context, *GetNodeLogRequest) (*GetNodeLogResponse, error)
+	// ExportNodes returns a page of the node table
+	ExportNodes(context.Context, *ExportNodesRequest) (*ExportNodesResponse, error)
+}
+
+func RegisterOverlayAdminServer(s *grpc.Server, srv OverlayAdminServer) {
+	s.RegisterService(&_OverlayAdmin_serviceDesc, srv)
+}
+
+func _OverlayAdmin_QuarantineNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OverlayAdminServer).QuarantineNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inspector.OverlayAdmin/QuarantineNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OverlayAdminServer).QuarantineNode(ctx, req.(*NodeAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OverlayAdmin_DisqualifyNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OverlayAdminServer).DisqualifyNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inspector.OverlayAdmin/DisqualifyNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OverlayAdminServer).DisqualifyNode(ctx, req.(*NodeAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OverlayAdmin_ReinstateNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OverlayAdminServer).ReinstateNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inspector.OverlayAdmin/ReinstateNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OverlayAdminServer).ReinstateNode(ctx, req.(*NodeAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OverlayAdmin_AnnotateNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OverlayAdminServer).AnnotateNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inspector.OverlayAdmin/AnnotateNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OverlayAdminServer).AnnotateNode(ctx, req.(*NodeAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OverlayAdmin_GetNodeLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNodeLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OverlayAdminServer).GetNodeLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inspector.OverlayAdmin/GetNodeLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OverlayAdminServer).GetNodeLog(ctx, req.(*GetNodeLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OverlayAdmin_ExportNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportNodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OverlayAdminServer).ExportNodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inspector.OverlayAdmin/ExportNodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OverlayAdminServer).ExportNodes(ctx, req.(*ExportNodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _OverlayAdmin_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "inspector.OverlayAdmin",
+	HandlerType: (*OverlayAdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "QuarantineNode",
+			Handler:    _OverlayAdmin_QuarantineNode_Handler,
+		},
+		{
+			MethodName: "DisqualifyNode",
+			Handler:    _OverlayAdmin_DisqualifyNode_Handler,
+		},
+		{
+			MethodName: "ReinstateNode",
+			Handler:    _OverlayAdmin_ReinstateNode_Handler,
+		},
+		{
+			MethodName: "AnnotateNode",
+			Handler:    _OverlayAdmin_AnnotateNode_Handler,
+		},
+		{
+			MethodName: "GetNodeLog",
+			Handler:    _OverlayAdmin_GetNodeLog_Handler,
+		},
+		{
+			MethodName: "ExportNodes",
+			Handler:    _OverlayAdmin_ExportNodes_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inspector.proto",
@@ -2159,6 +4147,12 @@ type PieceStoreInspectorClient interface {
 	Stats(ctx context.Context, in *StatsRequest, opts ...grpc.CallOption) (*StatSummaryResponse, error)
 	// Dashboard returns stats for a specific storagenode
 	Dashboard(ctx context.Context, in *DashboardRequest, opts ...grpc.CallOption) (*DashboardResponse, error)
+	// SatelliteStats returns space and bandwidth stats for every satellite of a storagenode
+	SatelliteStats(ctx context.Context, in *SatelliteStatsRequest, opts ...grpc.CallOption) (*SatelliteStatsResponse, error)
+	// EmptyTrash permanently deletes pieces that were trashed before the given time
+	EmptyTrash(ctx context.Context, in *EmptyTrashRequest, opts ...grpc.CallOption) (*EmptyTrashResponse, error)
+	// OrderQueues returns the unsent orders and the settlement state of every satellite
+	OrderQueues(ctx context.Context, in *OrderQueuesRequest, opts ...grpc.CallOption) (*OrderQueuesResponse, error)
 }
 
 type pieceStoreInspectorClient struct {
@@ -2187,12 +4181,45 @@ func (c *pieceStoreInspectorClient) Dashboard(ctx context.Context, in *Dashboard
 	return out, nil
 }
 
+func (c *pieceStoreInspectorClient) SatelliteStats(ctx context.Context, in *SatelliteStatsRequest, opts ...grpc.CallOption) (*SatelliteStatsResponse, error) {
+	out := new(SatelliteStatsResponse)
+	err := c.cc.Invoke(ctx, "/inspector.PieceStoreInspector/SatelliteStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pieceStoreInspectorClient) EmptyTrash(ctx context.Context, in *EmptyTrashRequest, opts ...grpc.CallOption) (*EmptyTrashResponse, error) {
+	out := new(EmptyTrashResponse)
+	err := c.cc.Invoke(ctx, "/inspector.PieceStoreInspector/EmptyTrash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pieceStoreInspectorClient) OrderQueues(ctx context.Context, in *OrderQueuesRequest, opts ...grpc.CallOption) (*OrderQueuesResponse, error) {
+	out := new(OrderQueuesResponse)
+	err := c.cc.Invoke(ctx, "/inspector.PieceStoreInspector/OrderQueues", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PieceStoreInspectorServer is the server API for PieceStoreInspector service.
 type PieceStoreInspectorServer interface {
 	// Stats return space and bandwidth stats for a storagenode
 	Stats(context.Context, *StatsRequest) (*StatSummaryResponse, error)
 	// Dashboard returns stats for a specific storagenode
 	Dashboard(context.Context, *DashboardRequest) (*DashboardResponse, error)
+	// SatelliteStats returns space and bandwidth stats for every satellite of a storagenode
+	SatelliteStats(context.Context, *SatelliteStatsRequest) (*SatelliteStatsResponse, error)
+	// EmptyTrash permanently deletes pieces that were trashed before the given time
+	EmptyTrash(context.Context, *EmptyTrashRequest) (*EmptyTrashResponse, error)
+	// OrderQueues returns the unsent orders and the settlement state of every satellite
+	OrderQueues(context.Context, *OrderQueuesRequest) (*OrderQueuesResponse, error)
 }
 
 func RegisterPieceStoreInspectorServer(s *grpc.Server, srv PieceStoreInspectorServer) {
@@ -2235,6 +4262,60 @@ func _PieceStoreInspector_Dashboard_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PieceStoreInspector_SatelliteStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SatelliteStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PieceStoreInspectorServer).SatelliteStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inspector.PieceStoreInspector/SatelliteStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PieceStoreInspectorServer).SatelliteStats(ctx, req.(*SatelliteStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PieceStoreInspector_EmptyTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyTrashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PieceStoreInspectorServer).EmptyTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inspector.PieceStoreInspector/EmptyTrash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PieceStoreInspectorServer).EmptyTrash(ctx, req.(*EmptyTrashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PieceStoreInspector_OrderQueues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderQueuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PieceStoreInspectorServer).OrderQueues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inspector.PieceStoreInspector/OrderQueues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PieceStoreInspectorServer).OrderQueues(ctx, req.(*OrderQueuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _PieceStoreInspector_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "inspector.PieceStoreInspector",
 	HandlerType: (*PieceStoreInspectorServer)(nil),
@@ -2247,6 +4328,18 @@ var _PieceStoreInspector_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Dashboard",
 			Handler:    _PieceStoreInspector_Dashboard_Handler,
 		},
+		{
+			MethodName: "SatelliteStats",
+			Handler:    _PieceStoreInspector_SatelliteStats_Handler,
+		},
+		{
+			MethodName: "EmptyTrash",
+			Handler:    _PieceStoreInspector_EmptyTrash_Handler,
+		},
+		{
+			MethodName: "OrderQueues",
+			Handler:    _PieceStoreInspector_OrderQueues_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inspector.proto",
@@ -2258,6 +4351,8 @@ var _PieceStoreInspector_serviceDesc = grpc.ServiceDesc{
 type IrreparableInspectorClient interface {
 	// ListIrreparableSegments returns damaged segments
 	ListIrreparableSegments(ctx context.Context, in *ListIrreparableSegmentsRequest, opts ...grpc.CallOption) (*ListIrreparableSegmentsResponse, error)
+	// MarkSegmentLost marks an irreparable segment as permanently lost and notifies the project
+	MarkSegmentLost(ctx context.Context, in *MarkSegmentLostRequest, opts ...grpc.CallOption) (*MarkSegmentLostResponse, error)
 }
 
 type irreparableInspectorClient struct {
@@ -2277,10 +4372,21 @@ func (c *irreparableInspectorClient) ListIrreparableSegments(ctx context.Context
 	return out, nil
 }
 
+func (c *irreparableInspectorClient) MarkSegmentLost(ctx context.Context, in *MarkSegmentLostRequest, opts ...grpc.CallOption) (*MarkSegmentLostResponse, error) {
+	out := new(MarkSegmentLostResponse)
+	err := c.cc.Invoke(ctx, "/inspector.IrreparableInspector/MarkSegmentLost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // IrreparableInspectorServer is the server API for IrreparableInspector service.
 type IrreparableInspectorServer interface {
 	// ListIrreparableSegments returns damaged segments
 	ListIrreparableSegments(context.Context, *ListIrreparableSegmentsRequest) (*ListIrreparableSegmentsResponse, error)
+	// MarkSegmentLost marks an irreparable segment as permanently lost and notifies the project
+	MarkSegmentLost(context.Context, *MarkSegmentLostRequest) (*MarkSegmentLostResponse, error)
 }
 
 func RegisterIrreparableInspectorServer(s *grpc.Server, srv IrreparableInspectorServer) {
@@ -2305,6 +4411,24 @@ func _IrreparableInspector_ListIrreparableSegments_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IrreparableInspector_MarkSegmentLost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkSegmentLostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IrreparableInspectorServer).MarkSegmentLost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inspector.IrreparableInspector/MarkSegmentLost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IrreparableInspectorServer).MarkSegmentLost(ctx, req.(*MarkSegmentLostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _IrreparableInspector_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "inspector.IrreparableInspector",
 	HandlerType: (*IrreparableInspectorServer)(nil),
@@ -2313,6 +4437,10 @@ var _IrreparableInspector_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListIrreparableSegments",
 			Handler:    _IrreparableInspector_ListIrreparableSegments_Handler,
 		},
+		{
+			MethodName: "MarkSegmentLost",
+			Handler:    _IrreparableInspector_MarkSegmentLost_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inspector.proto",
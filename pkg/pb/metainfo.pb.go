@@ -765,6 +765,83 @@ func (m *ListSegmentsResponse_Item) GetIsPrefix() bool {
 	return false
 }
 
+type SetBucketPlacementRequest struct {
+	Bucket []byte `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	// placement is a region (e.g. "EU") or an ISO 3166-1 alpha-2 country code, empty removes the constraint
+	Placement            string   `protobuf:"bytes,2,opt,name=placement,proto3" json:"placement,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetBucketPlacementRequest) Reset()         { *m = SetBucketPlacementRequest{} }
+func (m *SetBucketPlacementRequest) String() string { return proto.CompactTextString(m) }
+func (*SetBucketPlacementRequest) ProtoMessage()    {}
+func (*SetBucketPlacementRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{13}
+}
+func (m *SetBucketPlacementRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetBucketPlacementRequest.Unmarshal(m, b)
+}
+func (m *SetBucketPlacementRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetBucketPlacementRequest.Marshal(b, m, deterministic)
+}
+func (m *SetBucketPlacementRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetBucketPlacementRequest.Merge(m, src)
+}
+func (m *SetBucketPlacementRequest) XXX_Size() int {
+	return xxx_messageInfo_SetBucketPlacementRequest.Size(m)
+}
+func (m *SetBucketPlacementRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetBucketPlacementRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetBucketPlacementRequest proto.InternalMessageInfo
+
+func (m *SetBucketPlacementRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *SetBucketPlacementRequest) GetPlacement() string {
+	if m != nil {
+		return m.Placement
+	}
+	return ""
+}
+
+type SetBucketPlacementResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetBucketPlacementResponse) Reset()         { *m = SetBucketPlacementResponse{} }
+func (m *SetBucketPlacementResponse) String() string { return proto.CompactTextString(m) }
+func (*SetBucketPlacementResponse) ProtoMessage()    {}
+func (*SetBucketPlacementResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{14}
+}
+func (m *SetBucketPlacementResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetBucketPlacementResponse.Unmarshal(m, b)
+}
+func (m *SetBucketPlacementResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetBucketPlacementResponse.Marshal(b, m, deterministic)
+}
+func (m *SetBucketPlacementResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetBucketPlacementResponse.Merge(m, src)
+}
+func (m *SetBucketPlacementResponse) XXX_Size() int {
+	return xxx_messageInfo_SetBucketPlacementResponse.Size(m)
+}
+func (m *SetBucketPlacementResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetBucketPlacementResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetBucketPlacementResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*AddressedOrderLimit)(nil), "metainfo.AddressedOrderLimit")
 	proto.RegisterType((*SegmentWriteRequest)(nil), "metainfo.SegmentWriteRequest")
@@ -780,66 +857,71 @@ func init() {
 	proto.RegisterType((*ListSegmentsRequest)(nil), "metainfo.ListSegmentsRequest")
 	proto.RegisterType((*ListSegmentsResponse)(nil), "metainfo.ListSegmentsResponse")
 	proto.RegisterType((*ListSegmentsResponse_Item)(nil), "metainfo.ListSegmentsResponse.Item")
+	proto.RegisterType((*SetBucketPlacementRequest)(nil), "metainfo.SetBucketPlacementRequest")
+	proto.RegisterType((*SetBucketPlacementResponse)(nil), "metainfo.SetBucketPlacementResponse")
 }
 
 func init() { proto.RegisterFile("metainfo.proto", fileDescriptor_631e2f30a93cd64e) }
 
 var fileDescriptor_631e2f30a93cd64e = []byte{
-	// 856 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0x67, 0xed, 0x38, 0x4e, 0x9e, 0x9d, 0x1a, 0x26, 0x21, 0x5d, 0x6d, 0x1b, 0x6c, 0x96, 0x4b,
-	0x90, 0xd0, 0x56, 0x4a, 0x4f, 0x50, 0x2e, 0x4d, 0x52, 0x44, 0x50, 0x5b, 0xa2, 0x09, 0x02, 0xa9,
-	0x42, 0xac, 0xc6, 0xde, 0xe7, 0xed, 0x08, 0xef, 0xce, 0x32, 0x33, 0x86, 0xb4, 0x77, 0x3e, 0x40,
-	0x0f, 0x7c, 0xa7, 0x1e, 0xf8, 0x00, 0x88, 0x43, 0xbe, 0x03, 0xdf, 0x00, 0xed, 0xec, 0x8c, 0xbd,
-	0x89, 0x6d, 0x42, 0x25, 0xdf, 0xe6, 0xbd, 0xf9, 0xcd, 0xfb, 0xf7, 0x7b, 0xef, 0x0d, 0xdc, 0xc9,
-	0x50, 0x33, 0x9e, 0x8f, 0x45, 0x54, 0x48, 0xa1, 0x05, 0xd9, 0x72, 0x72, 0x00, 0xa9, 0x48, 0xad,
-	0x36, 0xe8, 0xa7, 0x42, 0xa4, 0x13, 0x7c, 0x60, 0xa4, 0xe1, 0x74, 0xfc, 0x40, 0xf3, 0x0c, 0x95,
-	0x66, 0x59, 0x61, 0x01, 0x90, 0x8b, 0x04, 0xed, 0xb9, 0x57, 0x08, 0x9e, 0x6b, 0x94, 0xc9, 0xd0,
-	0x2a, 0xba, 0x42, 0x26, 0x28, 0x55, 0x25, 0x85, 0xbf, 0x7b, 0xb0, 0xfb, 0x38, 0x49, 0x24, 0x2a,
-	0x85, 0xc9, 0xb7, 0xe5, 0xcd, 0x53, 0x9e, 0x71, 0x4d, 0x3e, 0x85, 0xd6, 0xa4, 0x3c, 0xf8, 0xde,
-	0xc0, 0x3b, 0xec, 0x1c, 0xed, 0x46, 0xf6, 0xd5, 0x1c, 0x72, 0x44, 0x2b, 0x04, 0x39, 0x81, 0x3d,
-	0xa5, 0x85, 0x64, 0x29, 0xc6, 0xa5, 0xdf, 0x98, 0x55, 0xe6, 0xfc, 0x86, 0x79, 0xf9, 0x41, 0x64,
-	0x82, 0x79, 0x2e, 0x12, 0xb4, 0x7e, 0x28, 0xb1, 0xf0, 0x9a, 0x2e, 0x7c, 0xd3, 0x80, 0xdd, 0x0b,
-	0x4c, 0x33, 0xcc, 0xf5, 0x0f, 0x92, 0x6b, 0xa4, 0xf8, 0xcb, 0x14, 0x95, 0x26, 0xfb, 0xb0, 0x39,
-	0x9c, 0x8e, 0x7e, 0xc6, 0x2a, 0x90, 0x2e, 0xb5, 0x12, 0x21, 0xb0, 0x51, 0x30, 0xfd, 0xd2, 0x38,
-	0xe9, 0x52, 0x73, 0x26, 0x3e, 0xb4, 0x55, 0x65, 0xc2, 0x6f, 0x0e, 0xbc, 0xc3, 0x26, 0x75, 0x22,
-	0x79, 0x04, 0x20, 0x31, 0x99, 0xe6, 0x09, 0xcb, 0x47, 0xaf, 0xfc, 0x0d, 0x13, 0xd8, 0xbd, 0x68,
-	0x5e, 0x19, 0x3a, 0xbb, 0xbc, 0x18, 0xbd, 0xc4, 0x0c, 0x69, 0x0d, 0x4e, 0x1e, 0x41, 0x90, 0xb1,
-	0xcb, 0x18, 0xf3, 0x91, 0x7c, 0x55, 0x68, 0x4c, 0x62, 0x6b, 0x35, 0x56, 0xfc, 0x35, 0xfa, 0x2d,
-	0xe3, 0xe9, 0x6e, 0xc6, 0x2e, 0x9f, 0x38, 0x80, 0xcd, 0xe3, 0x82, 0xbf, 0x46, 0xf2, 0x05, 0x00,
-	0x5e, 0x16, 0x5c, 0x32, 0xcd, 0x45, 0xee, 0x6f, 0x1a, 0xcf, 0x41, 0x54, 0x11, 0x18, 0x39, 0x02,
-	0xa3, 0xef, 0x1c, 0x81, 0xb4, 0x86, 0x0e, 0xff, 0xf0, 0x60, 0xef, 0x7a, 0x4d, 0x54, 0x21, 0x72,
-	0x85, 0xe4, 0x6b, 0x78, 0x9f, 0x39, 0xce, 0x62, 0x43, 0x82, 0xf2, 0xbd, 0x41, 0xf3, 0xb0, 0x73,
-	0x74, 0x10, 0xcd, 0x3a, 0x68, 0x09, 0xab, 0xb4, 0x37, 0x7b, 0x66, 0x64, 0x45, 0x1e, 0xc2, 0x8e,
-	0x14, 0x42, 0xc7, 0x05, 0xc7, 0x11, 0xc6, 0x3c, 0xa9, 0xea, 0x79, 0xdc, 0x7b, 0x7b, 0xd5, 0x7f,
-	0xef, 0xef, 0xab, 0x7e, 0xfb, 0xbc, 0xd4, 0x9f, 0x9d, 0xd2, 0x4e, 0x89, 0xaa, 0x84, 0x24, 0x7c,
-	0x3b, 0x8f, 0xeb, 0x44, 0x64, 0xa5, 0xdd, 0xb5, 0x92, 0xf5, 0x19, 0xb4, 0x2d, 0x33, 0x96, 0x29,
-	0x52, 0x63, 0xea, 0xbc, 0x3a, 0x51, 0x07, 0x21, 0x5f, 0x42, 0x4f, 0x48, 0x9e, 0xf2, 0x9c, 0x4d,
-	0x5c, 0x29, 0x5a, 0xa6, 0x14, 0x4b, 0x5b, 0xf6, 0x8e, 0xc3, 0x56, 0xf9, 0x87, 0x4f, 0xe0, 0xc3,
-	0x1b, 0x99, 0xd8, 0x12, 0xd7, 0x82, 0xf0, 0x6e, 0x0d, 0x22, 0xfc, 0x09, 0xf6, 0xad, 0x99, 0x53,
-	0xf1, 0x5b, 0x3e, 0x11, 0x2c, 0x59, 0x6b, 0x49, 0xc2, 0x37, 0x1e, 0xdc, 0x5d, 0x70, 0xb0, 0xf6,
-	0x66, 0xa8, 0xe5, 0xdc, 0xb8, 0x3d, 0xe7, 0x17, 0x40, 0x6c, 0x48, 0x67, 0xf9, 0x58, 0xac, 0x37,
-	0xdf, 0x93, 0xd9, 0x32, 0xa8, 0x6c, 0x2f, 0x92, 0xf2, 0x3f, 0x02, 0xfc, 0x71, 0xd6, 0xa5, 0xa7,
-	0x38, 0xc1, 0x35, 0xaf, 0x94, 0x90, 0xcd, 0x3a, 0xc7, 0x59, 0x5f, 0x37, 0x1f, 0xe1, 0x5f, 0x1e,
-	0xec, 0x3e, 0xe5, 0x4a, 0x5b, 0x3f, 0xea, 0xb6, 0x04, 0xf6, 0x61, 0xb3, 0x90, 0x38, 0xe6, 0x97,
-	0x36, 0x05, 0x2b, 0x91, 0x3e, 0x74, 0x94, 0x66, 0x52, 0xc7, 0x6c, 0x5c, 0x96, 0xae, 0x69, 0x2e,
-	0xc1, 0xa8, 0x1e, 0x97, 0x1a, 0x72, 0x00, 0x80, 0x79, 0x12, 0x0f, 0x71, 0x2c, 0x24, 0x9a, 0xa1,
-	0xeb, 0xd2, 0x6d, 0xcc, 0x93, 0x63, 0xa3, 0x20, 0xf7, 0x61, 0x5b, 0xe2, 0x68, 0x2a, 0x15, 0xff,
-	0xb5, 0xda, 0x77, 0x5b, 0x74, 0xae, 0x20, 0x7b, 0xee, 0xa7, 0x28, 0x97, 0x5b, 0xcb, 0x7d, 0x0a,
-	0x07, 0x00, 0x65, 0xb2, 0xf1, 0x78, 0xc2, 0x52, 0xe5, 0xb7, 0x07, 0xde, 0x61, 0x9b, 0x6e, 0x97,
-	0x9a, 0xaf, 0x4a, 0x45, 0xf8, 0xa7, 0x07, 0x7b, 0xd7, 0x53, 0xb3, 0xd5, 0xfb, 0x1c, 0x5a, 0x5c,
-	0x63, 0xe6, 0x4a, 0xf6, 0xc9, 0xbc, 0x64, 0xcb, 0xe0, 0xd1, 0x99, 0xc6, 0x8c, 0x56, 0x2f, 0x4a,
-	0xfe, 0xb2, 0x32, 0xfe, 0x86, 0x89, 0xd0, 0x9c, 0x03, 0x84, 0x8d, 0x12, 0x32, 0xe3, 0xd6, 0xab,
-	0x71, 0xfb, 0x4e, 0xdd, 0x44, 0xee, 0xc1, 0x36, 0x57, 0xb1, 0xad, 0x6f, 0xd3, 0xb8, 0xd8, 0xe2,
-	0xea, 0xdc, 0xc8, 0x47, 0xff, 0x34, 0x61, 0xeb, 0x99, 0x0d, 0x94, 0x3c, 0x87, 0x9d, 0x13, 0x89,
-	0x4c, 0xa3, 0x8d, 0x96, 0xd4, 0x78, 0x5f, 0xf2, 0xc5, 0x05, 0x1f, 0xad, 0xba, 0xb6, 0x25, 0x39,
-	0x87, 0x9d, 0x6a, 0x39, 0x39, 0x7b, 0x8b, 0x0f, 0xae, 0xad, 0xe1, 0xa0, 0xbf, 0xf2, 0xde, 0x5a,
-	0xfc, 0x06, 0x3a, 0xb5, 0xf1, 0x22, 0xf7, 0x17, 0xf0, 0xb5, 0x89, 0x0e, 0x0e, 0x56, 0xdc, 0x5a,
-	0x5b, 0xdf, 0x43, 0xcf, 0xad, 0x24, 0x17, 0xdf, 0x60, 0xe1, 0xc5, 0x8d, 0xad, 0x18, 0x7c, 0xfc,
-	0x1f, 0x88, 0x79, 0xd6, 0xd5, 0x60, 0xad, 0xce, 0xfa, 0xda, 0x58, 0x2f, 0xc9, 0xfa, 0xc6, 0x60,
-	0x3e, 0x83, 0x6e, 0xbd, 0x87, 0xea, 0xb4, 0x2c, 0x99, 0xb2, 0x3a, 0x2d, 0xcb, 0x5a, 0xef, 0x78,
-	0xe3, 0x45, 0xa3, 0x18, 0x0e, 0x37, 0xcd, 0x1f, 0xfe, 0xf0, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xbd, 0xa9, 0x5c, 0xa6, 0xba, 0x09, 0x00, 0x00,
+	// 904 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0x67, 0xfd, 0x27, 0x8e, 0x9f, 0xdd, 0x1a, 0xc6, 0x69, 0xba, 0x6c, 0x93, 0xda, 0x6c, 0x39,
+	0x04, 0x09, 0x6d, 0xa5, 0xf4, 0x04, 0xe5, 0xd2, 0x24, 0x45, 0x04, 0xb5, 0xc5, 0x4c, 0x10, 0x48,
+	0x15, 0x62, 0x35, 0xf6, 0x3e, 0xbb, 0x23, 0xbc, 0x3b, 0xcb, 0xcc, 0x18, 0xd2, 0xde, 0xf9, 0x00,
+	0x3d, 0xf0, 0x9d, 0x7a, 0xe0, 0xc4, 0x09, 0x71, 0xe8, 0x67, 0x41, 0x3b, 0x3b, 0x6b, 0xaf, 0x63,
+	0xbb, 0x01, 0xc9, 0xb7, 0x79, 0xef, 0xfd, 0xe6, 0xfd, 0xfb, 0xbd, 0x79, 0xbb, 0x70, 0x33, 0x46,
+	0xcd, 0x78, 0x32, 0x16, 0x41, 0x2a, 0x85, 0x16, 0x64, 0xb7, 0x90, 0x3d, 0x98, 0x88, 0x89, 0xd5,
+	0x7a, 0xbd, 0x89, 0x10, 0x93, 0x29, 0xde, 0x37, 0xd2, 0x70, 0x36, 0xbe, 0xaf, 0x79, 0x8c, 0x4a,
+	0xb3, 0x38, 0xb5, 0x00, 0x48, 0x44, 0x84, 0xf6, 0xdc, 0x49, 0x05, 0x4f, 0x34, 0xca, 0x68, 0x68,
+	0x15, 0x6d, 0x21, 0x23, 0x94, 0x2a, 0x97, 0xfc, 0xdf, 0x1d, 0xe8, 0x3e, 0x8a, 0x22, 0x89, 0x4a,
+	0x61, 0xf4, 0x4d, 0x66, 0x79, 0xc2, 0x63, 0xae, 0xc9, 0x27, 0x50, 0x9f, 0x66, 0x07, 0xd7, 0xe9,
+	0x3b, 0x47, 0xad, 0xe3, 0x6e, 0x60, 0x6f, 0x2d, 0x20, 0xc7, 0x34, 0x47, 0x90, 0x53, 0xd8, 0x53,
+	0x5a, 0x48, 0x36, 0xc1, 0x30, 0x8b, 0x1b, 0xb2, 0xdc, 0x9d, 0x5b, 0x31, 0x37, 0x3f, 0x08, 0x4c,
+	0x32, 0xcf, 0x44, 0x84, 0x36, 0x0e, 0x25, 0x16, 0x5e, 0xd2, 0xf9, 0xaf, 0x2b, 0xd0, 0xbd, 0xc0,
+	0x49, 0x8c, 0x89, 0xfe, 0x41, 0x72, 0x8d, 0x14, 0x7f, 0x99, 0xa1, 0xd2, 0x64, 0x1f, 0x76, 0x86,
+	0xb3, 0xd1, 0xcf, 0x98, 0x27, 0xd2, 0xa6, 0x56, 0x22, 0x04, 0x6a, 0x29, 0xd3, 0x2f, 0x4c, 0x90,
+	0x36, 0x35, 0x67, 0xe2, 0x42, 0x43, 0xe5, 0x2e, 0xdc, 0x6a, 0xdf, 0x39, 0xaa, 0xd2, 0x42, 0x24,
+	0x0f, 0x01, 0x24, 0x46, 0xb3, 0x24, 0x62, 0xc9, 0xe8, 0xa5, 0x5b, 0x33, 0x89, 0xdd, 0x09, 0x16,
+	0x9d, 0xa1, 0x73, 0xe3, 0xc5, 0xe8, 0x05, 0xc6, 0x48, 0x4b, 0x70, 0xf2, 0x10, 0xbc, 0x98, 0x5d,
+	0x86, 0x98, 0x8c, 0xe4, 0xcb, 0x54, 0x63, 0x14, 0x5a, 0xaf, 0xa1, 0xe2, 0xaf, 0xd0, 0xad, 0x9b,
+	0x48, 0xb7, 0x63, 0x76, 0xf9, 0xb8, 0x00, 0xd8, 0x3a, 0x2e, 0xf8, 0x2b, 0x24, 0x9f, 0x03, 0xe0,
+	0x65, 0xca, 0x25, 0xd3, 0x5c, 0x24, 0xee, 0x8e, 0x89, 0xec, 0x05, 0x39, 0x81, 0x41, 0x41, 0x60,
+	0xf0, 0x5d, 0x41, 0x20, 0x2d, 0xa1, 0xfd, 0x3f, 0x1c, 0xd8, 0x5b, 0xee, 0x89, 0x4a, 0x45, 0xa2,
+	0x90, 0x7c, 0x05, 0xef, 0xb3, 0x82, 0xb3, 0xd0, 0x90, 0xa0, 0x5c, 0xa7, 0x5f, 0x3d, 0x6a, 0x1d,
+	0x1f, 0x06, 0xf3, 0x09, 0x5a, 0xc3, 0x2a, 0xed, 0xcc, 0xaf, 0x19, 0x59, 0x91, 0x07, 0x70, 0x43,
+	0x0a, 0xa1, 0xc3, 0x94, 0xe3, 0x08, 0x43, 0x1e, 0xe5, 0xfd, 0x3c, 0xe9, 0xbc, 0x79, 0xdb, 0x7b,
+	0xef, 0x9f, 0xb7, 0xbd, 0xc6, 0x20, 0xd3, 0x9f, 0x9f, 0xd1, 0x56, 0x86, 0xca, 0x85, 0xc8, 0x7f,
+	0xb3, 0xc8, 0xeb, 0x54, 0xc4, 0x99, 0xdf, 0xad, 0x92, 0xf5, 0x29, 0x34, 0x2c, 0x33, 0x96, 0x29,
+	0x52, 0x62, 0x6a, 0x90, 0x9f, 0x68, 0x01, 0x21, 0x5f, 0x40, 0x47, 0x48, 0x3e, 0xe1, 0x09, 0x9b,
+	0x16, 0xad, 0xa8, 0xf7, 0xab, 0x9b, 0x46, 0xf6, 0x66, 0x81, 0xcd, 0xeb, 0xf7, 0x1f, 0xc3, 0xad,
+	0x2b, 0x95, 0xd8, 0x16, 0x97, 0x92, 0x70, 0xae, 0x4d, 0xc2, 0xff, 0x09, 0xf6, 0xad, 0x9b, 0x33,
+	0xf1, 0x5b, 0x32, 0x15, 0x2c, 0xda, 0x6a, 0x4b, 0xfc, 0xd7, 0x0e, 0xdc, 0x5e, 0x09, 0xb0, 0xf5,
+	0x61, 0x28, 0xd5, 0x5c, 0xb9, 0xbe, 0xe6, 0xe7, 0x40, 0x6c, 0x4a, 0xe7, 0xc9, 0x58, 0x6c, 0xb7,
+	0xde, 0x53, 0xe8, 0x2e, 0xf9, 0x5e, 0x25, 0xe5, 0x3f, 0x24, 0xf8, 0xe3, 0x7c, 0x4a, 0xcf, 0x70,
+	0x8a, 0x5b, 0x5e, 0x29, 0x3e, 0x83, 0x5b, 0x57, 0xbc, 0x6f, 0x9b, 0x0f, 0xff, 0x6f, 0x07, 0xba,
+	0x4f, 0xb8, 0xd2, 0x36, 0x8e, 0xba, 0xae, 0x80, 0x7d, 0xd8, 0x49, 0x25, 0x8e, 0xf9, 0xa5, 0x2d,
+	0xc1, 0x4a, 0xa4, 0x07, 0x2d, 0xa5, 0x99, 0xd4, 0x21, 0x1b, 0x67, 0xad, 0xab, 0x1a, 0x23, 0x18,
+	0xd5, 0xa3, 0x4c, 0x43, 0x0e, 0x01, 0x30, 0x89, 0xc2, 0x21, 0x8e, 0x85, 0x44, 0xf3, 0xe8, 0xda,
+	0xb4, 0x89, 0x49, 0x74, 0x62, 0x14, 0xe4, 0x00, 0x9a, 0x12, 0x47, 0x33, 0xa9, 0xf8, 0xaf, 0xf9,
+	0xbe, 0xdb, 0xa5, 0x0b, 0x05, 0xd9, 0x2b, 0xbe, 0x14, 0xd9, 0x72, 0xab, 0x17, 0x1f, 0x85, 0x43,
+	0x80, 0xac, 0xd8, 0x70, 0x3c, 0x65, 0x13, 0xe5, 0x36, 0xfa, 0xce, 0x51, 0x83, 0x36, 0x33, 0xcd,
+	0x97, 0x99, 0xc2, 0xff, 0xd3, 0x81, 0xbd, 0xe5, 0xd2, 0x6c, 0xf7, 0x3e, 0x83, 0x3a, 0xd7, 0x18,
+	0x17, 0x2d, 0xbb, 0xb7, 0x68, 0xd9, 0x3a, 0x78, 0x70, 0xae, 0x31, 0xa6, 0xf9, 0x8d, 0x8c, 0xbf,
+	0x38, 0xcb, 0xbf, 0x62, 0x32, 0x34, 0x67, 0x0f, 0xa1, 0x96, 0x41, 0xe6, 0xdc, 0x3a, 0x25, 0x6e,
+	0xff, 0xd7, 0x34, 0x91, 0x3b, 0xd0, 0xe4, 0x2a, 0xb4, 0xfd, 0xad, 0x9a, 0x10, 0xbb, 0x5c, 0x0d,
+	0x8c, 0xec, 0x7f, 0x0b, 0x1f, 0x5e, 0xa0, 0x3e, 0x31, 0x34, 0x0c, 0xa6, 0x6c, 0x84, 0x59, 0x96,
+	0xd7, 0xd1, 0x75, 0x00, 0xcd, 0xb4, 0xc0, 0x9a, 0x0c, 0x9a, 0x74, 0xa1, 0xf0, 0x0f, 0xc0, 0x5b,
+	0xe7, 0x32, 0xaf, 0xfb, 0xf8, 0xaf, 0x1a, 0xec, 0x3e, 0xb5, 0x9d, 0x21, 0xcf, 0xe0, 0xc6, 0xa9,
+	0x44, 0xa6, 0xd1, 0xb6, 0x87, 0x94, 0x06, 0x6d, 0xcd, 0x37, 0xd5, 0xbb, 0xbb, 0xc9, 0x6c, 0x39,
+	0x18, 0xc0, 0x8d, 0x7c, 0x1b, 0x16, 0xfe, 0x56, 0x2f, 0x2c, 0xed, 0x7d, 0xaf, 0xb7, 0xd1, 0x6e,
+	0x3d, 0x7e, 0x0d, 0xad, 0xd2, 0x7b, 0x26, 0x07, 0x2b, 0xf8, 0xd2, 0x0a, 0xf1, 0x0e, 0x37, 0x58,
+	0xad, 0xaf, 0xef, 0xa1, 0x53, 0xec, 0xc0, 0x22, 0xbf, 0xfe, 0xca, 0x8d, 0x2b, 0x6b, 0xd8, 0xfb,
+	0xe8, 0x1d, 0x88, 0x45, 0xd5, 0xf9, 0x4b, 0xde, 0x5c, 0xf5, 0xd2, 0x1e, 0xf1, 0x7a, 0x1b, 0xed,
+	0xd6, 0xe3, 0x53, 0x68, 0x97, 0x87, 0xb6, 0x4c, 0xcb, 0x9a, 0x67, 0xed, 0xdd, 0xdd, 0x64, 0xb6,
+	0xee, 0x42, 0x20, 0xab, 0x13, 0x41, 0xee, 0x95, 0xb3, 0xd8, 0x30, 0x82, 0xde, 0xc7, 0xef, 0x06,
+	0xe5, 0x01, 0x4e, 0x6a, 0xcf, 0x2b, 0xe9, 0x70, 0xb8, 0x63, 0xfe, 0x4a, 0x1e, 0xfc, 0x3b, 0x00,
+	0x2f, 0x64, 0x7f, 0x99, 0x8c, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -860,6 +942,7 @@ type MetainfoClient interface {
 	DownloadSegment(ctx context.Context, in *SegmentDownloadRequest, opts ...grpc.CallOption) (*SegmentDownloadResponse, error)
 	DeleteSegment(ctx context.Context, in *SegmentDeleteRequest, opts ...grpc.CallOption) (*SegmentDeleteResponse, error)
 	ListSegments(ctx context.Context, in *ListSegmentsRequest, opts ...grpc.CallOption) (*ListSegmentsResponse, error)
+	SetBucketPlacement(ctx context.Context, in *SetBucketPlacementRequest, opts ...grpc.CallOption) (*SetBucketPlacementResponse, error)
 }
 
 type metainfoClient struct {
@@ -924,6 +1007,15 @@ func (c *metainfoClient) ListSegments(ctx context.Context, in *ListSegmentsReque
 	return out, nil
 }
 
+func (c *metainfoClient) SetBucketPlacement(ctx context.Context, in *SetBucketPlacementRequest, opts ...grpc.CallOption) (*SetBucketPlacementResponse, error) {
+	out := new(SetBucketPlacementResponse)
+	err := c.cc.Invoke(ctx, "/metainfo.Metainfo/SetBucketPlacement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MetainfoServer is the server API for Metainfo service.
 type MetainfoServer interface {
 	CreateSegment(context.Context, *SegmentWriteRequest) (*SegmentWriteResponse, error)
@@ -932,6 +1024,7 @@ type MetainfoServer interface {
 	DownloadSegment(context.Context, *SegmentDownloadRequest) (*SegmentDownloadResponse, error)
 	DeleteSegment(context.Context, *SegmentDeleteRequest) (*SegmentDeleteResponse, error)
 	ListSegments(context.Context, *ListSegmentsRequest) (*ListSegmentsResponse, error)
+	SetBucketPlacement(context.Context, *SetBucketPlacementRequest) (*SetBucketPlacementResponse, error)
 }
 
 func RegisterMetainfoServer(s *grpc.Server, srv MetainfoServer) {
@@ -1046,6 +1139,24 @@ func _Metainfo_ListSegments_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Metainfo_SetBucketPlacement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBucketPlacementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetainfoServer).SetBucketPlacement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metainfo.Metainfo/SetBucketPlacement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetainfoServer).SetBucketPlacement(ctx, req.(*SetBucketPlacementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Metainfo_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "metainfo.Metainfo",
 	HandlerType: (*MetainfoServer)(nil),
@@ -1074,6 +1185,10 @@ var _Metainfo_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListSegments",
 			Handler:    _Metainfo_ListSegments_Handler,
 		},
+		{
+			MethodName: "SetBucketPlacement",
+			Handler:    _Metainfo_SetBucketPlacement_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "metainfo.proto",
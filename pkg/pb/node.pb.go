@@ -365,6 +365,7 @@ func (m *NodeStats) GetLastContactFailure() *timestamp.Timestamp {
 type NodeOperator struct {
 	Email                string   `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
 	Wallet               string   `protobuf:"bytes,2,opt,name=wallet,proto3" json:"wallet,omitempty"`
+	CountryCode          string   `protobuf:"bytes,3,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -408,6 +409,13 @@ func (m *NodeOperator) GetWallet() string {
 	return ""
 }
 
+func (m *NodeOperator) GetCountryCode() string {
+	if m != nil {
+		return m.CountryCode
+	}
+	return ""
+}
+
 // NodeCapacity contains all relevant data about a nodes ability to store data
 type NodeCapacity struct {
 	FreeBandwidth        int64    `protobuf:"varint,1,opt,name=free_bandwidth,json=freeBandwidth,proto3" json:"free_bandwidth,omitempty"`
@@ -628,57 +636,58 @@ func init() {
 func init() { proto.RegisterFile("node.proto", fileDescriptor_0c843d59d2d938e7) }
 
 var fileDescriptor_0c843d59d2d938e7 = []byte{
-	// 828 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xcf, 0x6e, 0xdb, 0x46,
-	0x10, 0xc6, 0x4d, 0x91, 0x96, 0xc4, 0xd1, 0x9f, 0x32, 0x6b, 0x23, 0x20, 0x5c, 0xb4, 0x56, 0x14,
-	0x14, 0x15, 0x52, 0x40, 0x76, 0xdd, 0x4b, 0x53, 0xf4, 0x22, 0xcb, 0x6e, 0x2a, 0x94, 0xb5, 0x8d,
-	0x15, 0xed, 0x43, 0x2e, 0xc4, 0x8a, 0x5c, 0x4b, 0x8b, 0x50, 0x22, 0xc1, 0x5d, 0x36, 0xd0, 0xbb,
-	0xe4, 0x81, 0xfa, 0x0c, 0x3d, 0xe4, 0xdc, 0xc7, 0x28, 0x76, 0x97, 0x14, 0x45, 0x17, 0x2d, 0x5a,
-	0x20, 0x37, 0xee, 0x37, 0xbf, 0x9d, 0x19, 0x70, 0xbe, 0x1d, 0x80, 0x4d, 0x12, 0xd1, 0x71, 0x9a,
-	0x25, 0x22, 0x41, 0x96, 0xfc, 0x3e, 0x81, 0x65, 0xb2, 0x4c, 0xb4, 0x72, 0x72, 0xba, 0x4c, 0x92,
-	0x65, 0x4c, 0xcf, 0xd4, 0x69, 0x91, 0x3f, 0x9e, 0x09, 0xb6, 0xa6, 0x5c, 0x90, 0x75, 0xaa, 0x81,
-	0xe1, 0x07, 0x0b, 0xac, 0x9b, 0x24, 0xa2, 0xe8, 0x4b, 0x68, 0xb0, 0xc8, 0x35, 0x06, 0xc6, 0xa8,
-	0x7b, 0xd9, 0xff, 0xfd, 0xe3, 0xe9, 0xc1, 0x1f, 0x1f, 0x4f, 0x9b, 0x32, 0x32, 0xbb, 0xc2, 0x0d,
-	0x16, 0xa1, 0x6f, 0xa0, 0x45, 0xa2, 0x28, 0xa3, 0x9c, 0xbb, 0x8d, 0x81, 0x31, 0xea, 0x5c, 0x3c,
-	0x1b, 0xab, 0xca, 0x12, 0x99, 0xe8, 0x00, 0x2e, 0x09, 0x34, 0x04, 0x4b, 0x6c, 0x53, 0xea, 0x9a,
-	0x03, 0x63, 0xd4, 0xbf, 0xe8, 0x57, 0xa4, 0xbf, 0x4d, 0x29, 0x56, 0x31, 0xf4, 0x03, 0x74, 0x33,
-	0xca, 0x45, 0xc6, 0x42, 0xc1, 0x92, 0x0d, 0x77, 0x2d, 0x95, 0xf5, 0x79, 0xc5, 0xe2, 0xbd, 0x28,
-	0xae, 0xb1, 0xe8, 0x0c, 0x20, 0xa3, 0x69, 0x2e, 0x88, 0x3c, 0xba, 0x87, 0xea, 0xe6, 0x67, 0xd5,
-	0xcd, 0xb9, 0x20, 0x82, 0xe3, 0x3d, 0x04, 0x8d, 0xa1, 0xbd, 0xa6, 0x82, 0x44, 0x44, 0x10, 0xb7,
-	0xa9, 0x70, 0x54, 0xe1, 0xbf, 0x16, 0x11, 0xbc, 0x63, 0xd0, 0x0b, 0xe8, 0xc6, 0x44, 0xd0, 0x4d,
-	0xb8, 0x0d, 0x62, 0xc6, 0x85, 0xdb, 0x1a, 0x98, 0x23, 0x13, 0x77, 0x0a, 0xcd, 0x63, 0x5c, 0xa0,
-	0x97, 0xd0, 0x23, 0x79, 0xc4, 0x44, 0xc0, 0xf3, 0x30, 0x94, 0xbf, 0xa5, 0x3d, 0x30, 0x46, 0x6d,
-	0xdc, 0x55, 0xe2, 0x5c, 0x6b, 0xe8, 0x08, 0x0e, 0x19, 0x0f, 0xf2, 0xd4, 0xb5, 0x55, 0xd0, 0x62,
-	0xfc, 0x3e, 0x45, 0x5f, 0x41, 0x3f, 0x4f, 0x23, 0x22, 0x68, 0x50, 0xe4, 0x73, 0x41, 0x45, 0x7b,
-	0x5a, 0xf5, 0xb4, 0x88, 0xce, 0xe1, 0xb8, 0xc0, 0xea, 0x75, 0x3a, 0x0a, 0x46, 0x3a, 0x36, 0xd9,
-	0xaf, 0xf6, 0x12, 0x8a, 0x14, 0x41, 0x9e, 0xca, 0x41, 0xbb, 0x5d, 0xdd, 0x92, 0x16, 0xef, 0x95,
-	0x26, 0x07, 0xf9, 0x1b, 0xcd, 0xb8, 0xfc, 0x71, 0xbd, 0xa7, 0x83, 0x7c, 0xd0, 0x01, 0x5c, 0x12,
-	0xc3, 0xb7, 0xd0, 0xd9, 0x1b, 0x30, 0xfa, 0x16, 0x6c, 0x91, 0x91, 0x0d, 0x4f, 0x93, 0x4c, 0x28,
-	0xaf, 0xf4, 0x2f, 0x8e, 0xf6, 0x86, 0x5b, 0x86, 0x70, 0x45, 0x21, 0xb7, 0xee, 0x1b, 0x7b, 0x67,
-	0x92, 0xe1, 0x9f, 0x26, 0xd8, 0xbb, 0x69, 0xa1, 0xaf, 0xa1, 0x25, 0x13, 0x05, 0xff, 0x68, 0xc2,
-	0xa6, 0x0c, 0xcf, 0x22, 0xf4, 0x05, 0x40, 0x39, 0x9a, 0xd7, 0xe7, 0x2a, 0xa7, 0x89, 0xed, 0x42,
-	0x79, 0x7d, 0x8e, 0xc6, 0x70, 0x54, 0xfb, 0x5d, 0x41, 0x26, 0x1d, 0xa0, 0x9c, 0x68, 0xe0, 0x67,
-	0xfb, 0xc3, 0xc1, 0x32, 0x20, 0x27, 0xad, 0x7f, 0x56, 0x01, 0x5a, 0x0a, 0xec, 0x68, 0x4d, 0x23,
-	0xa7, 0xd0, 0xd1, 0x29, 0xc3, 0x24, 0xdf, 0x08, 0x65, 0x37, 0x13, 0x83, 0x92, 0xa6, 0x52, 0xf9,
-	0x7b, 0x4d, 0x0d, 0x36, 0x15, 0x58, 0xab, 0xa9, 0xf9, 0xaa, 0xa6, 0x06, 0x5b, 0x0a, 0x2c, 0x6a,
-	0x6a, 0x44, 0x0d, 0x5f, 0x21, 0xf5, 0x9c, 0x6d, 0x85, 0x22, 0x1d, 0xab, 0x25, 0xf5, 0xe0, 0x38,
-	0x26, 0x5c, 0x36, 0xb9, 0x11, 0x24, 0xac, 0xec, 0x62, 0xab, 0x21, 0x9f, 0x8c, 0xf5, 0x26, 0x18,
-	0x97, 0x9b, 0x60, 0xec, 0x97, 0x9b, 0x00, 0x23, 0x79, 0x6f, 0xaa, 0xaf, 0x95, 0x56, 0x7a, 0x9a,
-	0xed, 0x91, 0xb0, 0x38, 0xcf, 0xa8, 0x72, 0xea, 0x7f, 0xcf, 0xf6, 0x93, 0xbe, 0x35, 0xfc, 0x11,
-	0xba, 0x72, 0x8a, 0xb7, 0x29, 0xcd, 0x88, 0x48, 0x32, 0x74, 0x0c, 0x87, 0x74, 0x4d, 0x58, 0xac,
-	0x46, 0x6d, 0x63, 0x7d, 0x40, 0xcf, 0xa1, 0xf9, 0x9e, 0xc4, 0x31, 0x15, 0x85, 0x53, 0x8a, 0xd3,
-	0x10, 0xeb, 0xdb, 0x53, 0x92, 0x92, 0x90, 0x89, 0xad, 0x7c, 0x3f, 0x8f, 0x19, 0xa5, 0xc1, 0x82,
-	0x6c, 0xa2, 0xf7, 0x2c, 0x12, 0x2b, 0x95, 0xc6, 0xc4, 0x3d, 0xa9, 0x5e, 0x96, 0x22, 0xfa, 0x1c,
-	0x6c, 0x85, 0x45, 0x8c, 0xbf, 0x2b, 0x7c, 0xd2, 0x96, 0xc2, 0x15, 0xe3, 0xef, 0xca, 0x8e, 0xca,
-	0xa7, 0xff, 0x3f, 0x3b, 0x7a, 0x00, 0xe7, 0xe9, 0x86, 0xfa, 0x24, 0x5d, 0x7d, 0x30, 0xf4, 0x7b,
-	0x2b, 0xde, 0xa1, 0x7c, 0x3c, 0xe5, 0x5b, 0xd5, 0x7d, 0x95, 0x47, 0xe9, 0xc9, 0x30, 0x59, 0xaf,
-	0x99, 0x08, 0x56, 0x84, 0xaf, 0x8a, 0xf6, 0x40, 0x4b, 0x3f, 0x13, 0xbe, 0x42, 0xdf, 0x83, 0xbd,
-	0xdb, 0xf5, 0xca, 0xfd, 0xff, 0x3e, 0xb5, 0x0a, 0x96, 0x45, 0x33, 0x1a, 0x53, 0xc2, 0xa9, 0x7a,
-	0x0c, 0x6d, 0x5c, 0x1e, 0x5f, 0xdd, 0x40, 0xbb, 0x5c, 0xe2, 0xa8, 0x03, 0xad, 0xd9, 0xcd, 0xc3,
-	0xc4, 0x9b, 0x5d, 0x39, 0x07, 0xa8, 0x07, 0xf6, 0x7c, 0xe2, 0x5f, 0x7b, 0xde, 0xcc, 0xbf, 0x76,
-	0x0c, 0x19, 0x9b, 0xfb, 0xb7, 0x78, 0xf2, 0xe6, 0xda, 0x69, 0x20, 0x80, 0xe6, 0xfd, 0x9d, 0x37,
-	0xbb, 0xf9, 0xc5, 0x31, 0x25, 0x77, 0x79, 0x7b, 0xeb, 0xcf, 0x7d, 0x3c, 0xb9, 0x73, 0xac, 0x57,
-	0x2f, 0xa0, 0x57, 0xdb, 0x1b, 0xc8, 0x81, 0xae, 0x3f, 0xbd, 0x0b, 0x7c, 0x6f, 0x1e, 0xbc, 0xc1,
-	0x77, 0x53, 0xe7, 0xe0, 0xd2, 0x7a, 0xdb, 0x48, 0x17, 0x8b, 0xa6, 0xea, 0xf8, 0xbb, 0xbf, 0x02,
-	0x00, 0x00, 0xff, 0xff, 0x8f, 0x51, 0xee, 0x90, 0xed, 0x06, 0x00, 0x00,
+	// 843 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0x41, 0x6f, 0xdb, 0x36,
+	0x14, 0xc7, 0x63, 0x4b, 0xb1, 0xad, 0x27, 0xdb, 0x53, 0x99, 0xa0, 0x10, 0x32, 0x6c, 0x71, 0x55,
+	0x0c, 0x33, 0x3a, 0xc0, 0xc9, 0xb2, 0xcb, 0x3a, 0xec, 0xe2, 0x38, 0x59, 0x67, 0x4c, 0x4b, 0x02,
+	0x5a, 0xc9, 0xa1, 0x17, 0x81, 0x91, 0x98, 0x84, 0xa8, 0x6c, 0x09, 0x22, 0xb5, 0xc2, 0xdf, 0xa5,
+	0x1f, 0x68, 0x9f, 0x61, 0x87, 0x9e, 0xf7, 0x31, 0x06, 0x92, 0x52, 0x24, 0x65, 0x18, 0x86, 0x02,
+	0xbd, 0x99, 0xff, 0xf7, 0xe3, 0x7b, 0x34, 0xff, 0x8f, 0x4f, 0x00, 0x9b, 0x34, 0xa6, 0xb3, 0x2c,
+	0x4f, 0x45, 0x8a, 0x4c, 0xf9, 0xfb, 0x00, 0xee, 0xd3, 0xfb, 0x54, 0x2b, 0x07, 0x87, 0xf7, 0x69,
+	0x7a, 0x9f, 0xd0, 0x23, 0xb5, 0xba, 0x2d, 0xee, 0x8e, 0x04, 0x5b, 0x53, 0x2e, 0xc8, 0x3a, 0xd3,
+	0x80, 0xf7, 0xc1, 0x04, 0xf3, 0x22, 0x8d, 0x29, 0xfa, 0x1a, 0xba, 0x2c, 0x76, 0x3b, 0x93, 0xce,
+	0x74, 0x78, 0x3a, 0xfe, 0xf3, 0xe3, 0xe1, 0xce, 0x5f, 0x1f, 0x0f, 0x7b, 0x32, 0xb2, 0x3c, 0xc3,
+	0x5d, 0x16, 0xa3, 0xef, 0xa0, 0x4f, 0xe2, 0x38, 0xa7, 0x9c, 0xbb, 0xdd, 0x49, 0x67, 0x6a, 0x9f,
+	0x3c, 0x9b, 0xa9, 0xca, 0x12, 0x99, 0xeb, 0x00, 0xae, 0x08, 0xe4, 0x81, 0x29, 0xb6, 0x19, 0x75,
+	0x8d, 0x49, 0x67, 0x3a, 0x3e, 0x19, 0xd7, 0x64, 0xb0, 0xcd, 0x28, 0x56, 0x31, 0xf4, 0x13, 0x0c,
+	0x73, 0xca, 0x45, 0xce, 0x22, 0xc1, 0xd2, 0x0d, 0x77, 0x4d, 0x95, 0xf5, 0x79, 0xcd, 0xe2, 0x46,
+	0x14, 0xb7, 0x58, 0x74, 0x04, 0x90, 0xd3, 0xac, 0x10, 0x44, 0x2e, 0xdd, 0x5d, 0xb5, 0xf3, 0x8b,
+	0x7a, 0xe7, 0x4a, 0x10, 0xc1, 0x71, 0x03, 0x41, 0x33, 0x18, 0xac, 0xa9, 0x20, 0x31, 0x11, 0xc4,
+	0xed, 0x29, 0x1c, 0xd5, 0xf8, 0xef, 0x65, 0x04, 0x3f, 0x32, 0xe8, 0x05, 0x0c, 0x13, 0x22, 0xe8,
+	0x26, 0xda, 0x86, 0x09, 0xe3, 0xc2, 0xed, 0x4f, 0x8c, 0xa9, 0x81, 0xed, 0x52, 0xf3, 0x19, 0x17,
+	0xe8, 0x25, 0x8c, 0x48, 0x11, 0x33, 0x11, 0xf2, 0x22, 0x8a, 0xe4, 0xb5, 0x0c, 0x26, 0x9d, 0xe9,
+	0x00, 0x0f, 0x95, 0xb8, 0xd2, 0x1a, 0xda, 0x83, 0x5d, 0xc6, 0xc3, 0x22, 0x73, 0x2d, 0x15, 0x34,
+	0x19, 0xbf, 0xce, 0xd0, 0x37, 0x30, 0x2e, 0xb2, 0x98, 0x08, 0x1a, 0x96, 0xf9, 0x5c, 0x50, 0xd1,
+	0x91, 0x56, 0x7d, 0x2d, 0xa2, 0x63, 0xd8, 0x2f, 0xb1, 0x76, 0x1d, 0x5b, 0xc1, 0x48, 0xc7, 0xe6,
+	0xcd, 0x6a, 0x2f, 0xa1, 0x4c, 0x11, 0x16, 0x99, 0x34, 0xda, 0x1d, 0xea, 0x23, 0x69, 0xf1, 0x5a,
+	0x69, 0xd2, 0xc8, 0x3f, 0x68, 0xce, 0xe5, 0xc5, 0x8d, 0x9e, 0x1a, 0x79, 0xa3, 0x03, 0xb8, 0x22,
+	0xbc, 0xb7, 0x60, 0x37, 0x0c, 0x46, 0xdf, 0x83, 0x25, 0x72, 0xb2, 0xe1, 0x59, 0x9a, 0x0b, 0xd5,
+	0x2b, 0xe3, 0x93, 0xbd, 0x86, 0xb9, 0x55, 0x08, 0xd7, 0x14, 0x72, 0xdb, 0x7d, 0x63, 0x3d, 0x36,
+	0x89, 0xf7, 0xb7, 0x01, 0xd6, 0xa3, 0x5b, 0xe8, 0x5b, 0xe8, 0xcb, 0x44, 0xe1, 0x7f, 0x36, 0x61,
+	0x4f, 0x86, 0x97, 0x31, 0xfa, 0x0a, 0xa0, 0xb2, 0xe6, 0xf5, 0xb1, 0xca, 0x69, 0x60, 0xab, 0x54,
+	0x5e, 0x1f, 0xa3, 0x19, 0xec, 0xb5, 0xae, 0x2b, 0xcc, 0x65, 0x07, 0xa8, 0x4e, 0xec, 0xe0, 0x67,
+	0x4d, 0x73, 0xb0, 0x0c, 0x48, 0xa7, 0xf5, 0x65, 0x95, 0xa0, 0xa9, 0x40, 0x5b, 0x6b, 0x1a, 0x39,
+	0x04, 0x5b, 0xa7, 0x8c, 0xd2, 0x62, 0x23, 0x54, 0xbb, 0x19, 0x18, 0x94, 0xb4, 0x90, 0xca, 0xbf,
+	0x6b, 0x6a, 0xb0, 0xa7, 0xc0, 0x56, 0x4d, 0xcd, 0xd7, 0x35, 0x35, 0xd8, 0x57, 0x60, 0x59, 0x53,
+	0x23, 0xca, 0x7c, 0x85, 0xb4, 0x73, 0x0e, 0x14, 0x8a, 0x74, 0xac, 0x95, 0xd4, 0x87, 0xfd, 0x84,
+	0x70, 0x79, 0xc8, 0x8d, 0x20, 0x51, 0xdd, 0x2e, 0x96, 0x32, 0xf9, 0x60, 0xa6, 0x27, 0xc1, 0xac,
+	0x9a, 0x04, 0xb3, 0xa0, 0x9a, 0x04, 0x18, 0xc9, 0x7d, 0x0b, 0xbd, 0xad, 0x6a, 0xa5, 0xa7, 0xd9,
+	0xee, 0x08, 0x4b, 0x8a, 0x9c, 0xba, 0xf0, 0x49, 0xd9, 0x7e, 0xd1, 0xbb, 0xbc, 0x10, 0x86, 0xd2,
+	0xc5, 0xcb, 0x8c, 0xe6, 0x44, 0xa4, 0x39, 0xda, 0x87, 0x5d, 0xba, 0x26, 0x2c, 0x51, 0x56, 0x5b,
+	0x58, 0x2f, 0xd0, 0x73, 0xe8, 0xbd, 0x27, 0x49, 0x42, 0x45, 0xd9, 0x29, 0xe5, 0x4a, 0x5e, 0x97,
+	0xfa, 0xf3, 0xf9, 0x36, 0x8c, 0xd2, 0x58, 0x4f, 0x15, 0x0b, 0xdb, 0xa5, 0xb6, 0x48, 0x63, 0xea,
+	0x61, 0x5d, 0x60, 0x41, 0x32, 0x12, 0x31, 0xb1, 0x95, 0x4f, 0xec, 0x2e, 0xa7, 0x34, 0xbc, 0x25,
+	0x9b, 0xf8, 0x3d, 0x8b, 0xc5, 0x83, 0xaa, 0x64, 0xe0, 0x91, 0x54, 0x4f, 0x2b, 0x11, 0x7d, 0x09,
+	0x96, 0xc2, 0x62, 0xc6, 0xdf, 0x95, 0xad, 0x34, 0x90, 0xc2, 0x19, 0xe3, 0xef, 0xbc, 0x9f, 0x61,
+	0xd8, 0x9c, 0x0e, 0x9f, 0x76, 0x68, 0xef, 0x06, 0x9c, 0xa7, 0x43, 0xec, 0xb3, 0x9c, 0xea, 0x43,
+	0x07, 0xec, 0xc6, 0x53, 0x95, 0xef, 0xab, 0x7a, 0xce, 0xfa, 0x5c, 0xd5, 0x52, 0xb6, 0x6d, 0x94,
+	0xae, 0xd7, 0x4c, 0x84, 0x0f, 0x84, 0x3f, 0x94, 0xc7, 0x03, 0x2d, 0xfd, 0x4a, 0xf8, 0x03, 0xfa,
+	0x11, 0xac, 0xc7, 0xcf, 0x81, 0x6b, 0xfc, 0xaf, 0xb1, 0x35, 0x2c, 0x8b, 0xe6, 0x34, 0xa1, 0x84,
+	0x53, 0xf5, 0x5e, 0x06, 0xb8, 0x5a, 0xbe, 0xba, 0x80, 0x41, 0x35, 0xe7, 0x91, 0x0d, 0xfd, 0xe5,
+	0xc5, 0xcd, 0xdc, 0x5f, 0x9e, 0x39, 0x3b, 0x68, 0x04, 0xd6, 0x6a, 0x1e, 0x9c, 0xfb, 0xfe, 0x32,
+	0x38, 0x77, 0x3a, 0x32, 0xb6, 0x0a, 0x2e, 0xf1, 0xfc, 0xcd, 0xb9, 0xd3, 0x45, 0x00, 0xbd, 0xeb,
+	0x2b, 0x7f, 0x79, 0xf1, 0x9b, 0x63, 0x48, 0xee, 0xf4, 0xf2, 0x32, 0x58, 0x05, 0x78, 0x7e, 0xe5,
+	0x98, 0xaf, 0x5e, 0xc0, 0xa8, 0x35, 0x5a, 0x90, 0x03, 0xc3, 0x60, 0x71, 0x15, 0x06, 0xfe, 0x2a,
+	0x7c, 0x83, 0xaf, 0x16, 0xce, 0xce, 0xa9, 0xf9, 0xb6, 0x9b, 0xdd, 0xde, 0xf6, 0xd4, 0x89, 0x7f,
+	0xf8, 0x67, 0x00, 0xd4, 0x72, 0x0c, 0x4a, 0x10, 0x07, 0x00, 0x00,
 }
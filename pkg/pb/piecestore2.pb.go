@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	grpc "google.golang.org/grpc"
 	math "math"
 )
@@ -24,13 +25,13 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
 // Expected order of messages from uplink:
-//   OrderLimit ->
-//   repeated
-//      Order ->
-//      Chunk ->
-//   PieceHash signed by uplink ->
-//      <- PieceHash signed by storage node
 //
+//	OrderLimit ->
+//	repeated
+//	   Order ->
+//	   Chunk ->
+//	PieceHash signed by uplink ->
+//	   <- PieceHash signed by storage node
 type PieceUploadRequest struct {
 	// first message to show that we are allowed to upload
 	Limit *OrderLimit2 `protobuf:"bytes,1,opt,name=limit,proto3" json:"limit,omitempty"`
@@ -182,11 +183,12 @@ func (m *PieceUploadResponse) GetDone() *PieceHash {
 }
 
 // Expected order of messages from uplink:
-//   {OrderLimit, Chunk} ->
-//   go repeated
-//      Order -> (async)
-//   go repeated
-//      <- PieceDownloadResponse.Chunk
+//
+//	{OrderLimit, Chunk} ->
+//	go repeated
+//	   Order -> (async)
+//	go repeated
+//	   <- PieceDownloadResponse.Chunk
 type PieceDownloadRequest struct {
 	// first message to show that we are allowed to upload
 	Limit *OrderLimit2 `protobuf:"bytes,1,opt,name=limit,proto3" json:"limit,omitempty"`
@@ -444,6 +446,147 @@ func (m *PieceDeleteResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PieceDeleteResponse proto.InternalMessageInfo
 
+// RetainRequest is sent by the satellite to let the storage node know
+// which pieces it should keep. Pieces created before creation_date
+// which are not in the bloom filter are garbage collected.
+type RetainRequest struct {
+	CreationDate         *timestamp.Timestamp `protobuf:"bytes,1,opt,name=creation_date,json=creationDate,proto3" json:"creation_date,omitempty"`
+	Filter               []byte               `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *RetainRequest) Reset()         { *m = RetainRequest{} }
+func (m *RetainRequest) String() string { return proto.CompactTextString(m) }
+func (*RetainRequest) ProtoMessage()    {}
+func (*RetainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23ff32dd550c2439, []int{6}
+}
+func (m *RetainRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RetainRequest.Unmarshal(m, b)
+}
+func (m *RetainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RetainRequest.Marshal(b, m, deterministic)
+}
+func (m *RetainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetainRequest.Merge(m, src)
+}
+func (m *RetainRequest) XXX_Size() int {
+	return xxx_messageInfo_RetainRequest.Size(m)
+}
+func (m *RetainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetainRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetainRequest proto.InternalMessageInfo
+
+func (m *RetainRequest) GetCreationDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreationDate
+	}
+	return nil
+}
+
+func (m *RetainRequest) GetFilter() []byte {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+type RetainResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RetainResponse) Reset()         { *m = RetainResponse{} }
+func (m *RetainResponse) String() string { return proto.CompactTextString(m) }
+func (*RetainResponse) ProtoMessage()    {}
+func (*RetainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23ff32dd550c2439, []int{7}
+}
+func (m *RetainResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RetainResponse.Unmarshal(m, b)
+}
+func (m *RetainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RetainResponse.Marshal(b, m, deterministic)
+}
+func (m *RetainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetainResponse.Merge(m, src)
+}
+func (m *RetainResponse) XXX_Size() int {
+	return xxx_messageInfo_RetainResponse.Size(m)
+}
+func (m *RetainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetainResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetainResponse proto.InternalMessageInfo
+
+// RestoreTrashRequest is sent by the satellite to let the storage node know
+// that it should restore all of the satellite's pieces that are still in the trash.
+type RestoreTrashRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreTrashRequest) Reset()         { *m = RestoreTrashRequest{} }
+func (m *RestoreTrashRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreTrashRequest) ProtoMessage()    {}
+func (*RestoreTrashRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23ff32dd550c2439, []int{8}
+}
+func (m *RestoreTrashRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreTrashRequest.Unmarshal(m, b)
+}
+func (m *RestoreTrashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreTrashRequest.Marshal(b, m, deterministic)
+}
+func (m *RestoreTrashRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreTrashRequest.Merge(m, src)
+}
+func (m *RestoreTrashRequest) XXX_Size() int {
+	return xxx_messageInfo_RestoreTrashRequest.Size(m)
+}
+func (m *RestoreTrashRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreTrashRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreTrashRequest proto.InternalMessageInfo
+
+type RestoreTrashResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreTrashResponse) Reset()         { *m = RestoreTrashResponse{} }
+func (m *RestoreTrashResponse) String() string { return proto.CompactTextString(m) }
+func (*RestoreTrashResponse) ProtoMessage()    {}
+func (*RestoreTrashResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23ff32dd550c2439, []int{9}
+}
+func (m *RestoreTrashResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreTrashResponse.Unmarshal(m, b)
+}
+func (m *RestoreTrashResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreTrashResponse.Marshal(b, m, deterministic)
+}
+func (m *RestoreTrashResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreTrashResponse.Merge(m, src)
+}
+func (m *RestoreTrashResponse) XXX_Size() int {
+	return xxx_messageInfo_RestoreTrashResponse.Size(m)
+}
+func (m *RestoreTrashResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreTrashResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreTrashResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*PieceUploadRequest)(nil), "piecestore.PieceUploadRequest")
 	proto.RegisterType((*PieceUploadRequest_Chunk)(nil), "piecestore.PieceUploadRequest.Chunk")
@@ -454,38 +597,50 @@ func init() {
 	proto.RegisterType((*PieceDownloadResponse_Chunk)(nil), "piecestore.PieceDownloadResponse.Chunk")
 	proto.RegisterType((*PieceDeleteRequest)(nil), "piecestore.PieceDeleteRequest")
 	proto.RegisterType((*PieceDeleteResponse)(nil), "piecestore.PieceDeleteResponse")
+	proto.RegisterType((*RetainRequest)(nil), "piecestore.RetainRequest")
+	proto.RegisterType((*RetainResponse)(nil), "piecestore.RetainResponse")
+	proto.RegisterType((*RestoreTrashRequest)(nil), "piecestore.RestoreTrashRequest")
+	proto.RegisterType((*RestoreTrashResponse)(nil), "piecestore.RestoreTrashResponse")
 }
 
 func init() { proto.RegisterFile("piecestore2.proto", fileDescriptor_23ff32dd550c2439) }
 
 var fileDescriptor_23ff32dd550c2439 = []byte{
-	// 404 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0x51, 0x4f, 0xea, 0x30,
-	0x14, 0xc7, 0x29, 0x6c, 0xcb, 0xbd, 0xe7, 0x92, 0x9b, 0x50, 0xc4, 0x2c, 0x4b, 0x54, 0x5c, 0x50,
-	0xf1, 0x65, 0x31, 0xe3, 0xcd, 0xa0, 0x26, 0xca, 0x83, 0x89, 0x1a, 0x49, 0x0d, 0x2f, 0xbe, 0x98,
-	0xc1, 0x0a, 0x2c, 0xe2, 0x3a, 0xd7, 0x11, 0x13, 0xbe, 0x82, 0x9f, 0xd3, 0x8f, 0x61, 0x34, 0x6b,
-	0x37, 0xc8, 0x00, 0x21, 0x9a, 0xf8, 0x04, 0x3d, 0xe7, 0x7f, 0xfa, 0xff, 0xf5, 0xdf, 0x66, 0x50,
-	0x0a, 0x3c, 0xda, 0xa3, 0x3c, 0x62, 0x21, 0xb5, 0xad, 0x20, 0x64, 0x11, 0xc3, 0x30, 0x2b, 0x19,
-	0x30, 0x60, 0x03, 0x26, 0xeb, 0x46, 0x91, 0x85, 0x2e, 0x0d, 0xb9, 0x5c, 0x99, 0xef, 0x08, 0x70,
-	0x3b, 0x16, 0x76, 0x82, 0x11, 0x73, 0x5c, 0x42, 0x9f, 0xc7, 0x94, 0x47, 0xf8, 0x10, 0xd4, 0x91,
-	0xf7, 0xe4, 0x45, 0x3a, 0xaa, 0xa2, 0xfa, 0x3f, 0xbb, 0x6c, 0x25, 0x43, 0xb7, 0xf1, 0xcf, 0x75,
-	0xdc, 0xb1, 0x89, 0x54, 0xe0, 0x1a, 0xa8, 0xa2, 0xa9, 0xe7, 0x85, 0xf4, 0x7f, 0x46, 0x6a, 0x13,
-	0xd9, 0xc4, 0xc7, 0xa0, 0xf6, 0x86, 0x63, 0xff, 0x51, 0x2f, 0x08, 0x55, 0xcd, 0x9a, 0xd1, 0x59,
-	0x8b, 0xfe, 0xd6, 0x45, 0xac, 0x25, 0x72, 0x04, 0xef, 0x81, 0xe2, 0x32, 0x9f, 0xea, 0x8a, 0x18,
-	0x2d, 0xa5, 0x06, 0x62, 0xec, 0xd2, 0xe1, 0x43, 0x22, 0xda, 0x46, 0x03, 0x54, 0x31, 0x86, 0x37,
-	0x41, 0x63, 0xfd, 0x3e, 0xa7, 0x92, 0xbe, 0x40, 0x92, 0x15, 0xc6, 0xa0, 0xb8, 0x4e, 0xe4, 0x08,
-	0xd0, 0x22, 0x11, 0xff, 0xcd, 0x26, 0x94, 0x33, 0xf6, 0x3c, 0x60, 0x3e, 0xa7, 0x53, 0x4b, 0xb4,
-	0xd2, 0xd2, 0x7c, 0x43, 0xb0, 0x21, 0x6a, 0x2d, 0xf6, 0xe2, 0xff, 0x6a, 0x7e, 0xcd, 0x6c, 0x7e,
-	0xfb, 0x0b, 0xf9, 0xcd, 0x11, 0x64, 0x12, 0x34, 0x4e, 0xd7, 0x45, 0xb3, 0x05, 0x20, 0x94, 0x0f,
-	0xdc, 0x9b, 0x50, 0x41, 0x52, 0x20, 0x7f, 0x45, 0xe5, 0xce, 0x9b, 0x50, 0xf3, 0x15, 0x41, 0x65,
-	0xce, 0x25, 0x09, 0xea, 0x24, 0xe5, 0x92, 0x07, 0x3d, 0x58, 0xc1, 0x25, 0x27, 0xb2, 0x60, 0x3f,
-	0xba, 0xb3, 0xb3, 0xe4, 0xc9, 0xb6, 0xe8, 0x88, 0x46, 0xf4, 0xfb, 0x91, 0x9b, 0x95, 0xe4, 0xd2,
-	0xd3, 0x0d, 0x24, 0x99, 0xfd, 0x81, 0x00, 0xda, 0x53, 0x7c, 0x7c, 0x03, 0x9a, 0x7c, 0x15, 0x78,
-	0x7b, 0xf5, 0x6b, 0x35, 0x76, 0xbe, 0xec, 0xcb, 0x9d, 0xcd, 0x5c, 0x1d, 0xe1, 0x0e, 0xfc, 0x49,
-	0xb3, 0xc0, 0xd5, 0x75, 0xd7, 0x67, 0xec, 0xae, 0x0d, 0x32, 0xde, 0xf4, 0x08, 0xe1, 0x2b, 0xd0,
-	0xe4, 0x31, 0x96, 0x50, 0x66, 0x02, 0x5a, 0x42, 0x99, 0x3d, 0xbf, 0x99, 0x3b, 0x57, 0xee, 0xf3,
-	0x41, 0xb7, 0xab, 0x89, 0x4f, 0x43, 0xe3, 0x33, 0x00, 0x00, 0xff, 0xff, 0xf5, 0xfa, 0xf7, 0xbe,
-	0x55, 0x04, 0x00, 0x00,
+	// 530 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0x4d, 0x62, 0xc1, 0xe0, 0x56, 0x74, 0xd3, 0x54, 0x61, 0x25, 0x48, 0xb0, 0x0a, 0x94,
+	0x8b, 0x8b, 0xdc, 0x1b, 0x2a, 0x54, 0x40, 0x0e, 0x48, 0x80, 0xa8, 0xb6, 0xed, 0x85, 0x4b, 0xe5,
+	0xc4, 0x93, 0xc4, 0xc2, 0xf1, 0x1a, 0x7b, 0x23, 0xa4, 0xbe, 0x02, 0x0f, 0xc0, 0x13, 0xf2, 0x18,
+	0x48, 0xc8, 0xfb, 0x93, 0x76, 0x9b, 0x3f, 0x81, 0xd4, 0x53, 0xb2, 0x33, 0xdf, 0xcc, 0xf7, 0xf9,
+	0x9b, 0x19, 0xd8, 0xce, 0x13, 0x1c, 0x60, 0x29, 0x78, 0x81, 0x61, 0x90, 0x17, 0x5c, 0x70, 0x02,
+	0x57, 0x21, 0x0a, 0x23, 0x3e, 0xe2, 0x2a, 0x4e, 0x3b, 0x23, 0xce, 0x47, 0x29, 0x1e, 0xc8, 0x57,
+	0x7f, 0x3a, 0x3c, 0x10, 0xc9, 0x04, 0x4b, 0x11, 0x4d, 0x72, 0x0d, 0xf0, 0x78, 0x11, 0x63, 0x51,
+	0xaa, 0x97, 0xff, 0xc7, 0x01, 0x72, 0x52, 0x75, 0x3a, 0xcf, 0x53, 0x1e, 0xc5, 0x0c, 0xbf, 0x4f,
+	0xb1, 0x14, 0xe4, 0x05, 0x34, 0xd2, 0x64, 0x92, 0x88, 0xb6, 0xd3, 0x75, 0xf6, 0xef, 0x87, 0xcd,
+	0x40, 0x17, 0x7d, 0xa9, 0x7e, 0x3e, 0x55, 0x99, 0x90, 0x29, 0x04, 0xd9, 0x83, 0x86, 0x4c, 0xb6,
+	0x37, 0x24, 0x74, 0xcb, 0x82, 0x86, 0x4c, 0x25, 0xc9, 0x2b, 0x68, 0x0c, 0xc6, 0xd3, 0xec, 0x5b,
+	0xbb, 0x26, 0x51, 0x7b, 0xc1, 0x95, 0xfc, 0x60, 0x9e, 0x3f, 0x78, 0x5f, 0x61, 0x99, 0x2a, 0x21,
+	0x4f, 0xa1, 0x1e, 0xf3, 0x0c, 0xdb, 0x75, 0x59, 0xba, 0x6d, 0x08, 0x64, 0xd9, 0x87, 0xa8, 0x1c,
+	0x33, 0x99, 0xa6, 0x87, 0xd0, 0x90, 0x65, 0x64, 0x17, 0x5c, 0x3e, 0x1c, 0x96, 0xa8, 0xd4, 0xd7,
+	0x98, 0x7e, 0x11, 0x02, 0xf5, 0x38, 0x12, 0x91, 0x14, 0xea, 0x31, 0xf9, 0xdf, 0x3f, 0x82, 0xa6,
+	0x45, 0x5f, 0xe6, 0x3c, 0x2b, 0x71, 0x46, 0xe9, 0xac, 0xa4, 0xf4, 0x7f, 0x3b, 0xb0, 0x23, 0x63,
+	0x3d, 0xfe, 0x23, 0xbb, 0x55, 0xff, 0x8e, 0x6c, 0xff, 0x9e, 0xcd, 0xf9, 0x77, 0x43, 0x81, 0xe5,
+	0x20, 0x7d, 0xb3, 0xce, 0x9a, 0x47, 0x00, 0x12, 0x79, 0x51, 0x26, 0x97, 0x28, 0x95, 0xd4, 0xd8,
+	0x3d, 0x19, 0x39, 0x4d, 0x2e, 0xd1, 0xff, 0xe9, 0x40, 0xeb, 0x06, 0x8b, 0x36, 0xea, 0xb5, 0xd1,
+	0xa5, 0x3e, 0xf4, 0xf9, 0x0a, 0x5d, 0xaa, 0xc2, 0x16, 0xf6, 0x5f, 0x33, 0x3b, 0xd6, 0x2b, 0xdb,
+	0xc3, 0x14, 0x05, 0xfe, 0xbb, 0xe5, 0x7e, 0x0b, 0x9a, 0x56, 0x03, 0xa5, 0xcc, 0x1f, 0xc3, 0x26,
+	0x43, 0x11, 0x25, 0x99, 0x69, 0x79, 0x0c, 0x9b, 0x83, 0x02, 0x23, 0x91, 0xf0, 0xec, 0x22, 0x8e,
+	0x84, 0x59, 0x07, 0x1a, 0xa8, 0x1b, 0x0b, 0xcc, 0x8d, 0x05, 0x67, 0xe6, 0xc6, 0x98, 0x67, 0x0a,
+	0x7a, 0x91, 0xc0, 0xea, 0xab, 0x86, 0x49, 0x2a, 0xf4, 0x70, 0x3d, 0xa6, 0x5f, 0xfe, 0x03, 0xd8,
+	0x32, 0x4c, 0x9a, 0xbb, 0x05, 0x4d, 0xa6, 0x6c, 0x3b, 0x2b, 0xaa, 0xfd, 0x52, 0x0a, 0xfc, 0x5d,
+	0xd8, 0xb1, 0xc3, 0x0a, 0x1e, 0xfe, 0xaa, 0x01, 0x9c, 0xcc, 0x9c, 0x26, 0x9f, 0xc1, 0x55, 0x0b,
+	0x4c, 0x1e, 0xaf, 0x3e, 0x2c, 0xda, 0x59, 0x9a, 0xd7, 0x42, 0xee, 0xec, 0x3b, 0xe4, 0x1c, 0xee,
+	0x9a, 0xb1, 0x91, 0xee, 0xba, 0x4d, 0xa3, 0x4f, 0xd6, 0xce, 0xbc, 0x6a, 0xfa, 0xd2, 0x21, 0x1f,
+	0xc1, 0x55, 0x8e, 0x2f, 0x50, 0x69, 0xcd, 0x92, 0x76, 0x96, 0xe6, 0x4d, 0x43, 0xf2, 0x16, 0x5c,
+	0x65, 0x21, 0x79, 0x78, 0x1d, 0x6c, 0x0d, 0x90, 0xd2, 0x45, 0xa9, 0x59, 0x8b, 0x53, 0xf0, 0xae,
+	0x9b, 0x4b, 0x3a, 0x36, 0x7a, 0x6e, 0x1a, 0xb4, 0xbb, 0x1c, 0x60, 0x9a, 0xbe, 0xab, 0x7f, 0xdd,
+	0xc8, 0xfb, 0x7d, 0x57, 0xae, 0xc6, 0xe1, 0xdf, 0x01, 0x00, 0x3d, 0x20, 0x3d, 0xa5, 0xb9, 0x05,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -503,6 +658,8 @@ type PiecestoreClient interface {
 	Upload(ctx context.Context, opts ...grpc.CallOption) (Piecestore_UploadClient, error)
 	Download(ctx context.Context, opts ...grpc.CallOption) (Piecestore_DownloadClient, error)
 	Delete(ctx context.Context, in *PieceDeleteRequest, opts ...grpc.CallOption) (*PieceDeleteResponse, error)
+	Retain(ctx context.Context, in *RetainRequest, opts ...grpc.CallOption) (*RetainResponse, error)
+	RestoreTrash(ctx context.Context, in *RestoreTrashRequest, opts ...grpc.CallOption) (*RestoreTrashResponse, error)
 }
 
 type piecestoreClient struct {
@@ -587,11 +744,31 @@ func (c *piecestoreClient) Delete(ctx context.Context, in *PieceDeleteRequest, o
 	return out, nil
 }
 
+func (c *piecestoreClient) Retain(ctx context.Context, in *RetainRequest, opts ...grpc.CallOption) (*RetainResponse, error) {
+	out := new(RetainResponse)
+	err := c.cc.Invoke(ctx, "/piecestore.Piecestore/Retain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *piecestoreClient) RestoreTrash(ctx context.Context, in *RestoreTrashRequest, opts ...grpc.CallOption) (*RestoreTrashResponse, error) {
+	out := new(RestoreTrashResponse)
+	err := c.cc.Invoke(ctx, "/piecestore.Piecestore/RestoreTrash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PiecestoreServer is the server API for Piecestore service.
 type PiecestoreServer interface {
 	Upload(Piecestore_UploadServer) error
 	Download(Piecestore_DownloadServer) error
 	Delete(context.Context, *PieceDeleteRequest) (*PieceDeleteResponse, error)
+	Retain(context.Context, *RetainRequest) (*RetainResponse, error)
+	RestoreTrash(context.Context, *RestoreTrashRequest) (*RestoreTrashResponse, error)
 }
 
 func RegisterPiecestoreServer(s *grpc.Server, srv PiecestoreServer) {
@@ -668,6 +845,42 @@ func _Piecestore_Delete_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Piecestore_Retain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PiecestoreServer).Retain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/piecestore.Piecestore/Retain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PiecestoreServer).Retain(ctx, req.(*RetainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Piecestore_RestoreTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreTrashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PiecestoreServer).RestoreTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/piecestore.Piecestore/RestoreTrash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PiecestoreServer).RestoreTrash(ctx, req.(*RestoreTrashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Piecestore_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "piecestore.Piecestore",
 	HandlerType: (*PiecestoreServer)(nil),
@@ -676,6 +889,14 @@ var _Piecestore_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _Piecestore_Delete_Handler,
 		},
+		{
+			MethodName: "Retain",
+			Handler:    _Piecestore_Retain_Handler,
+		},
+		{
+			MethodName: "RestoreTrash",
+			Handler:    _Piecestore_RestoreTrash_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
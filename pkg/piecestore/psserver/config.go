@@ -11,7 +11,9 @@ import (
 
 // Config contains everything necessary for a server
 type Config struct {
-	Path string `help:"path to store data in" default:"$CONFDIR/storage"`
+	Path            string `help:"path to store data in" default:"$CONFDIR/storage"`
+	AdditionalPaths string `help:"a comma-separated list of additional directories to store pieces in" default:""`
+	Placement       string `help:"how new pieces are placed when there are additional directories: fill-first or balanced" default:"fill-first"`
 
 	WhitelistedSatelliteIDs string        `help:"a comma-separated list of approved satellite node ids" default:""`
 	SatelliteIDRestriction  bool          `help:"if true, only allow data from approved satellites" devDefault:"false" default:"true"`
@@ -0,0 +1,35 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pointerdbtest
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/pointerdb"
+	"storj.io/storj/storage"
+)
+
+// ListRemotePointers returns all remote pointers in pointerdb keyed by their path
+func ListRemotePointers(t *testing.T, pointerdb *pointerdb.Service) map[string]*pb.Pointer {
+	pointers := map[string]*pb.Pointer{}
+	err := pointerdb.Iterate("", "", true, false, func(it storage.Iterator) error {
+		var item storage.ListItem
+		for it.Next(&item) {
+			pointer := &pb.Pointer{}
+			if err := proto.Unmarshal(item.Value, pointer); err != nil {
+				return err
+			}
+			if pointer.GetRemote() != nil {
+				pointers[string(item.Key)] = pointer
+			}
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	return pointers
+}
@@ -61,6 +61,41 @@ func (s *Service) Get(path string) (pointer *pb.Pointer, err error) {
 	return pointer, nil
 }
 
+// Update updates the pointer under path with fn and stores it only when the pointer
+// wasn't changed in the meantime, fn is called again with the current pointer otherwise
+func (s *Service) Update(path string, fn func(pointer *pb.Pointer) error) (pointer *pb.Pointer, err error) {
+	for {
+		oldPointerBytes, err := s.DB.Get([]byte(path))
+		if err != nil {
+			return nil, err
+		}
+
+		pointer = &pb.Pointer{}
+		err = proto.Unmarshal(oldPointerBytes, pointer)
+		if err != nil {
+			return nil, errs.New("error unmarshaling pointer: %v", err)
+		}
+
+		if err := fn(pointer); err != nil {
+			return nil, err
+		}
+
+		newPointerBytes, err := proto.Marshal(pointer)
+		if err != nil {
+			return nil, err
+		}
+
+		err = s.DB.CompareAndSwap([]byte(path), oldPointerBytes, newPointerBytes)
+		if storage.ErrValueChanged.Has(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		return pointer, nil
+	}
+}
+
 // List returns all Path keys in the pointers bucket
 func (s *Service) List(prefix string, startAfter string, endBefore string, recursive bool, limit int32,
 	metaFlags uint32) (items []*pb.ListResponse_Item, more bool, err error) {
@@ -21,23 +21,25 @@ import (
 
 // Repairer for segments
 type Repairer struct {
-	pointerdb *pointerdb.Service
-	orders    *orders.Service
-	cache     *overlay.Cache
-	ec        ecclient.Client
-	identity  *identity.FullIdentity
-	timeout   time.Duration
+	pointerdb  *pointerdb.Service
+	orders     *orders.Service
+	cache      *overlay.Cache
+	placements overlay.BucketPlacements
+	ec         ecclient.Client
+	identity   *identity.FullIdentity
+	timeout    time.Duration
 }
 
 // NewSegmentRepairer creates a new instance of SegmentRepairer
-func NewSegmentRepairer(pointerdb *pointerdb.Service, orders *orders.Service, cache *overlay.Cache, ec ecclient.Client, identity *identity.FullIdentity, timeout time.Duration) *Repairer {
+func NewSegmentRepairer(pointerdb *pointerdb.Service, orders *orders.Service, cache *overlay.Cache, placements overlay.BucketPlacements, ec ecclient.Client, identity *identity.FullIdentity, timeout time.Duration) *Repairer {
 	return &Repairer{
-		pointerdb: pointerdb,
-		orders:    orders,
-		cache:     cache,
-		ec:        ec,
-		identity:  identity,
-		timeout:   timeout,
+		pointerdb:  pointerdb,
+		orders:     orders,
+		cache:      cache,
+		placements: placements,
+		ec:         ec,
+		identity:   identity,
+		timeout:    timeout,
 	}
 }
 
@@ -67,8 +69,24 @@ func (repairer *Repairer) Repair(ctx context.Context, path storj.Path, lostPiece
 	var healthyPieces []*pb.RemotePiece
 	lostPiecesSet := sliceToSet(lostPieces)
 
+	// pieces on disqualified nodes are lost, even when the checker didn't know about it yet
+	pieces := pointer.GetRemote().GetRemotePieces()
+	var nodeIDs storj.NodeIDList
+	for _, piece := range pieces {
+		nodeIDs = append(nodeIDs, piece.NodeId)
+	}
+	if len(nodeIDs) > 0 {
+		disqualified, err := repairer.cache.DisqualifiedNodes(ctx, nodeIDs)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		for _, i := range disqualified {
+			lostPiecesSet[pieces[i].GetPieceNum()] = struct{}{}
+		}
+	}
+
 	// Populate healthyPieces with all pieces from the pointer except those correlating to indices in lostPieces
-	for _, piece := range pointer.GetRemote().GetRemotePieces() {
+	for _, piece := range pieces {
 		excludeNodeIDs = append(excludeNodeIDs, piece.NodeId)
 		if _, ok := lostPiecesSet[piece.GetPieceNum()]; !ok {
 			healthyPieces = append(healthyPieces, piece)
@@ -86,12 +104,23 @@ func (repairer *Repairer) Repair(ctx context.Context, path storj.Path, lostPiece
 		return Error.Wrap(err)
 	}
 
+	// The repaired pieces must honor the placement constraint of the bucket
+	projectID, bucket, err := overlay.SplitBucketPath(path)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	placement, err := repairer.placements.GetBucketPlacement(ctx, projectID, bucket)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
 	// Request Overlay for n-h new storage nodes
 	request := overlay.FindStorageNodesRequest{
 		RequestedCount: redundancy.TotalCount() - len(healthyPieces),
 		FreeBandwidth:  pieceSize,
 		FreeDisk:       pieceSize,
 		ExcludedNodes:  excludeNodeIDs,
+		Placement:      placement,
 	}
 	newNodes, err := repairer.cache.FindStorageNodes(ctx, request)
 	if err != nil {
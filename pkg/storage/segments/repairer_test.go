@@ -97,7 +97,7 @@ func TestSegmentStoreRepair(t *testing.T) {
 		os := satellite.Orders.Service
 		oc := satellite.Overlay.Service
 		ec := ecclient.NewClient(satellite.Transport, 0)
-		repairer := segments.NewSegmentRepairer(pdb, os, oc, ec, satellite.Identity, time.Minute)
+		repairer := segments.NewSegmentRepairer(pdb, os, oc, satellite.DB.BucketPlacements(), ec, satellite.Identity, time.Minute)
 		assert.NotNil(t, repairer)
 
 		err = repairer.Repair(ctx, path, lostPieces)
@@ -0,0 +1,68 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package contact_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/pkg/pb"
+)
+
+func TestCheckIn(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		node := planet.StorageNodes[0]
+
+		// mark node as offline so that the check-in is the only way back
+		node.Contact.Chore.Loop.Pause()
+		satellite.Discovery.Service.Refresh.Pause()
+		satellite.Discovery.Service.Graveyard.Pause()
+		_, err := satellite.Overlay.Service.UpdateUptime(ctx, node.ID(), false)
+		require.NoError(t, err)
+
+		dossier, err := satellite.Overlay.Service.Get(ctx, node.ID())
+		require.NoError(t, err)
+		require.False(t, dossier.Online())
+
+		freeDisk, err := node.Storage2.Monitor.AvailableSpace(ctx)
+		require.NoError(t, err)
+
+		err = node.Contact.Chore.CheckIn(ctx, satellite.ID(), &pb.CheckInRequest{
+			Address: node.Addr(),
+			Capacity: &pb.NodeCapacity{
+				FreeBandwidth: 1000,
+				FreeDisk:      freeDisk,
+			},
+			Operator: &pb.NodeOperator{
+				Email:  "operator@example.com",
+				Wallet: "0x" + "11111111111111111111111111111111111111111",
+			},
+		})
+		require.NoError(t, err)
+
+		dossier, err = satellite.Overlay.Service.Get(ctx, node.ID())
+		require.NoError(t, err)
+		require.True(t, dossier.Online())
+		require.Equal(t, node.Addr(), dossier.Address.Address)
+		require.Equal(t, int64(1000), dossier.Capacity.FreeBandwidth)
+		require.Equal(t, freeDisk, dossier.Capacity.FreeDisk)
+		require.Equal(t, "operator@example.com", dossier.Operator.Email)
+
+		// a node that can't be dialed back is reported as unreachable
+		err = node.Contact.Chore.CheckIn(ctx, satellite.ID(), &pb.CheckInRequest{
+			Address: "127.0.0.1:1",
+		})
+		require.Error(t, err)
+
+		dossier, err = satellite.Overlay.Service.Get(ctx, node.ID())
+		require.NoError(t, err)
+		require.False(t, dossier.Online())
+	})
+}
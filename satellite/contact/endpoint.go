@@ -0,0 +1,120 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package contact
+
+import (
+	"context"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/kademlia"
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pb"
+)
+
+var (
+	// Error is the default error class for contact package
+	Error = errs.Class("contact")
+
+	mon = monkit.Package()
+)
+
+// Endpoint implements the contact service Endpoints
+type Endpoint struct {
+	log   *zap.Logger
+	cache *overlay.Cache
+	kad   *kademlia.Kademlia
+}
+
+// NewEndpoint returns a new contact service endpoint
+func NewEndpoint(log *zap.Logger, cache *overlay.Cache, kad *kademlia.Kademlia) *Endpoint {
+	return &Endpoint{
+		log:   log,
+		cache: cache,
+		kad:   kad,
+	}
+}
+
+// CheckIn is periodically called by storage nodes to keep the satellite informed of their status.
+// The satellite dials the node back at the reported address to verify that it is reachable.
+func (endpoint *Endpoint) CheckIn(ctx context.Context, req *pb.CheckInRequest) (_ *pb.CheckInResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	nodeID := peer.ID
+
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing node address")
+	}
+
+	node := pb.Node{
+		Id:   nodeID,
+		Type: pb.NodeType_STORAGE,
+		Address: &pb.NodeAddress{
+			Transport: pb.NodeTransport_TCP_TLS_GRPC,
+			Address:   req.Address,
+		},
+	}
+
+	_, pingErr := endpoint.kad.Ping(ctx, node)
+	if pingErr != nil {
+		endpoint.log.Info("failed to ping node on check-in", zap.Stringer("Node ID", nodeID), zap.Error(pingErr))
+
+		// only nodes that we already know about have an uptime to update
+		if _, err := endpoint.cache.Get(ctx, nodeID); err == nil {
+			if _, err := endpoint.cache.UpdateUptime(ctx, nodeID, false); err != nil {
+				endpoint.log.Error("could not update node uptime in cache", zap.Stringer("Node ID", nodeID), zap.Error(err))
+			}
+		}
+
+		return &pb.CheckInResponse{
+			PingNodeSuccess:  false,
+			PingErrorMessage: pingErr.Error(),
+		}, nil
+	}
+
+	if req.Capacity != nil {
+		node.Restrictions = &pb.NodeRestrictions{
+			FreeBandwidth: req.Capacity.FreeBandwidth,
+			FreeDisk:      req.Capacity.FreeDisk,
+		}
+	}
+	if req.Operator != nil {
+		node.Metadata = &pb.NodeMetadata{
+			Email:  req.Operator.Email,
+			Wallet: req.Operator.Wallet,
+		}
+	}
+	node.Version = req.Version
+
+	if err := endpoint.cache.Put(ctx, nodeID, node); err != nil {
+		return nil, status.Error(codes.Internal, Error.Wrap(err).Error())
+	}
+
+	if _, err := endpoint.cache.UpdateUptime(ctx, nodeID, true); err != nil {
+		return nil, status.Error(codes.Internal, Error.Wrap(err).Error())
+	}
+
+	_, err = endpoint.cache.UpdateNodeInfo(ctx, nodeID, &pb.InfoResponse{
+		Type:     pb.NodeType_STORAGE,
+		Operator: req.Operator,
+		Capacity: req.Capacity,
+		Version:  req.Version,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, Error.Wrap(err).Error())
+	}
+
+	return &pb.CheckInResponse{
+		PingNodeSuccess: true,
+	}, nil
+}
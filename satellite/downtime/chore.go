@@ -0,0 +1,133 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package downtime
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/sync2"
+	"storj.io/storj/pkg/overlay"
+)
+
+// Chore suspends and disqualifies nodes based on their downtime
+//
+// Once per interval it totals the downtime of every node within the rolling window,
+// including the time nodes went without checking in.
+// Nodes above the suspension threshold don't receive new uploads until their downtime
+// within the window drops below the threshold again, nodes above the disqualification
+// threshold are disqualified permanently. Contact attempts older than the retention are deleted.
+type Chore struct {
+	log    *zap.Logger
+	config Config
+	Loop   sync2.Cycle
+
+	overlay *overlay.Cache
+}
+
+// NewChore creates a new downtime chore
+func NewChore(log *zap.Logger, config Config, overlay *overlay.Cache) *Chore {
+	return &Chore{
+		log:    log,
+		config: config,
+		Loop:   *sync2.NewCycle(config.Interval),
+
+		overlay: overlay,
+	}
+}
+
+// Run starts the chore
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return chore.Loop.Run(ctx, func(ctx context.Context) error {
+		err := chore.CheckNodes(ctx)
+		if err != nil {
+			chore.log.Error("error checking downtime of nodes", zap.Error(err))
+		}
+
+		deleted, err := chore.overlay.DeleteContactAttemptsBefore(ctx, time.Now().Add(-chore.config.Retention))
+		if err != nil {
+			chore.log.Error("error deleting old contact attempts", zap.Error(err))
+		}
+		mon.IntVal("contact_attempts_deleted").Observe(deleted)
+		return nil
+	})
+}
+
+// CheckNodes suspends, unsuspends or disqualifies nodes based on their downtime within the window
+func (chore *Chore) CheckNodes(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var suspended, disqualified int64
+	for offset, more := int64(0), true; more; {
+		var nodes []*overlay.NodeDossier
+		nodes, more, err = chore.overlay.Paginate(ctx, offset, chore.config.BatchSize)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		offset += int64(len(nodes))
+
+		var checked []*overlay.NodeDossier
+		for _, node := range nodes {
+			if node.Disqualified() || node.ExitStatus.Finished() {
+				continue
+			}
+			checked = append(checked, node)
+		}
+		if len(checked) == 0 {
+			continue
+		}
+
+		histories, err := chore.overlay.UptimeHistories(ctx, checked, chore.config.Window)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		for _, node := range checked {
+			history := histories[node.Id]
+
+			if chore.config.DisqualificationThreshold > 0 && history.Downtime >= chore.config.DisqualificationThreshold {
+				chore.log.Info("disqualifying node", zap.Stringer("node ID", node.Id), zap.Duration("downtime", history.Downtime))
+				if _, err := chore.overlay.DisqualifyNode(ctx, node.Id); err != nil {
+					return Error.Wrap(err)
+				}
+				disqualified++
+				continue
+			}
+
+			isSuspended := history.Downtime >= chore.config.SuspensionThreshold
+			if isSuspended {
+				suspended++
+			}
+			if isSuspended == node.IsSuspended() {
+				continue
+			}
+
+			var since *time.Time
+			if isSuspended {
+				now := time.Now().UTC()
+				since = &now
+				chore.log.Info("suspending node", zap.Stringer("node ID", node.Id), zap.Duration("downtime", history.Downtime))
+			} else {
+				chore.log.Info("unsuspending node", zap.Stringer("node ID", node.Id), zap.Duration("downtime", history.Downtime))
+			}
+			if _, err := chore.overlay.UpdateSuspended(ctx, node.Id, since); err != nil {
+				return Error.Wrap(err)
+			}
+		}
+	}
+
+	mon.IntVal("suspended_nodes").Observe(suspended)
+	mon.IntVal("disqualified_nodes").Observe(disqualified)
+	return nil
+}
+
+// Close halts the chore
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
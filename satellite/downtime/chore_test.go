@@ -0,0 +1,86 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package downtime_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/downtime"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestChore(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		log := zaptest.NewLogger(t)
+		cache := overlay.NewCache(log, db.OverlayCache(), overlay.NodeSelectionConfig{})
+
+		online, offline := storj.NodeID{1}, storj.NodeID{2}
+		for _, nodeID := range []storj.NodeID{online, offline} {
+			require.NoError(t, cache.Put(ctx, nodeID, pb.Node{Id: nodeID}))
+			_, err := cache.UpdateUptime(ctx, nodeID, true)
+			require.NoError(t, err)
+		}
+		_, err := cache.UpdateUptime(ctx, offline, false)
+		require.NoError(t, err)
+
+		config := downtime.Config{
+			Window:              time.Hour,
+			SuspensionThreshold: time.Nanosecond,
+			Retention:           2 * time.Hour,
+			BatchSize:           1,
+		}
+
+		requireSuspended := func(nodeID storj.NodeID, suspended bool) {
+			node, err := cache.Get(ctx, nodeID)
+			require.NoError(t, err)
+			assert.Equal(t, suspended, node.IsSuspended())
+		}
+
+		// offline nodes are suspended
+		chore := downtime.NewChore(log, config, cache)
+		require.NoError(t, chore.CheckNodes(ctx))
+		requireSuspended(online, false)
+		requireSuspended(offline, true)
+
+		offlineNode, err := cache.Get(ctx, offline)
+		require.NoError(t, err)
+		history, err := cache.UptimeHistory(ctx, offlineNode, time.Hour)
+		require.NoError(t, err)
+		assert.EqualValues(t, 2, history.Attempts)
+		assert.EqualValues(t, 1, history.FailedAttempts)
+		require.Len(t, history.Windows, 1)
+
+		// nodes are unsuspended once their downtime drops below the threshold
+		config.SuspensionThreshold = time.Hour
+		chore = downtime.NewChore(log, config, cache)
+		require.NoError(t, chore.CheckNodes(ctx))
+		requireSuspended(offline, false)
+
+		// nodes above the disqualification threshold are disqualified
+		config.DisqualificationThreshold = time.Nanosecond
+		chore = downtime.NewChore(log, config, cache)
+		require.NoError(t, chore.CheckNodes(ctx))
+
+		node, err := cache.Get(ctx, offline)
+		require.NoError(t, err)
+		assert.True(t, node.Disqualified())
+
+		node, err = cache.Get(ctx, online)
+		require.NoError(t, err)
+		assert.False(t, node.Disqualified())
+	})
+}
@@ -0,0 +1,28 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package downtime
+
+import (
+	"time"
+
+	"github.com/zeebo/errs"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+)
+
+var (
+	// Error is the default error class for downtime package.
+	Error = errs.Class("downtime")
+
+	mon = monkit.Package()
+)
+
+// Config for the downtime chore
+type Config struct {
+	Interval                  time.Duration `help:"how often to check the downtime of nodes" default:"1h" devDefault:"1m"`
+	Window                    time.Duration `help:"the rolling window over which the downtime of nodes is totaled" default:"720h"`
+	SuspensionThreshold       time.Duration `help:"total downtime within the window after which a node is suspended from new uploads" default:"48h"`
+	DisqualificationThreshold time.Duration `help:"total downtime within the window after which a node is disqualified, 0 disables disqualification" default:"0"`
+	Retention                 time.Duration `help:"how long contact attempts are kept, must be longer than the window" default:"1440h"`
+	BatchSize                 int           `help:"the number of nodes checked at once" default:"100"`
+}
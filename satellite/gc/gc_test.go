@@ -0,0 +1,112 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gc_test
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/pointerdb/pointerdbtest"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storagenode"
+)
+
+// TestGarbageCollection does the following:
+// * uploads two objects
+// * removes the pointers of one of them without deleting its pieces
+// * runs garbage collection
+// * checks that the pieces of the removed object are deleted from the storage nodes
+// * checks that the pieces of the other object are kept
+func TestGarbageCollection(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		satellite.GarbageCollection.Service.Loop.Pause()
+
+		upl := planet.Uplinks[0]
+		pointerdb := satellite.Metainfo.Service
+
+		keepData := make([]byte, 10*memory.KiB)
+		_, err := rand.Read(keepData)
+		require.NoError(t, err)
+		err = upl.Upload(ctx, satellite, "testbucket", "keep", keepData)
+		require.NoError(t, err)
+		keepPointers := pointerdbtest.ListRemotePointers(t, pointerdb)
+
+		deleteData := make([]byte, 10*memory.KiB)
+		_, err = rand.Read(deleteData)
+		require.NoError(t, err)
+		err = upl.Upload(ctx, satellite, "testbucket", "delete", deleteData)
+		require.NoError(t, err)
+
+		// remove the pointers of the second object, simulating a delete which never reached the nodes
+		deletePointers := map[string]*pb.Pointer{}
+		for path, pointer := range pointerdbtest.ListRemotePointers(t, pointerdb) {
+			if _, ok := keepPointers[path]; !ok {
+				deletePointers[path] = pointer
+				require.NoError(t, pointerdb.Delete(path))
+			}
+		}
+		require.NotEmpty(t, deletePointers)
+
+		// check that all pieces are on the nodes before garbage collection
+		require.Equal(t, countPieces(t, ctx, planet, keepPointers), countRemotePieces(keepPointers))
+		require.Equal(t, countPieces(t, ctx, planet, deletePointers), countRemotePieces(deletePointers))
+
+		err = satellite.GarbageCollection.Service.Collect(ctx)
+		require.NoError(t, err)
+
+		// the nodes trash the pieces in the background
+		for _, node := range planet.StorageNodes {
+			node.Storage2.Retain.Wait()
+		}
+
+		require.Equal(t, countPieces(t, ctx, planet, keepPointers), countRemotePieces(keepPointers))
+		require.Equal(t, 0, countPieces(t, ctx, planet, deletePointers))
+
+		// the kept object is still downloadable
+		data, err := upl.Download(ctx, satellite, "testbucket", "keep")
+		require.NoError(t, err)
+		require.Equal(t, keepData, data)
+	})
+}
+
+// countRemotePieces counts the pieces referenced by the pointers
+func countRemotePieces(pointers map[string]*pb.Pointer) (count int) {
+	for _, pointer := range pointers {
+		count += len(pointer.GetRemote().GetRemotePieces())
+	}
+	return count
+}
+
+// countPieces counts how many of the pieces referenced by the pointers are stored on the storage nodes
+func countPieces(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet, pointers map[string]*pb.Pointer) (count int) {
+	satelliteID := planet.Satellites[0].ID()
+
+	nodes := map[storj.NodeID]*storagenode.Peer{}
+	for _, node := range planet.StorageNodes {
+		nodes[node.ID()] = node
+	}
+
+	for _, pointer := range pointers {
+		remote := pointer.GetRemote()
+		for _, piece := range remote.GetRemotePieces() {
+			node, ok := nodes[piece.NodeId]
+			require.True(t, ok)
+
+			_, err := node.DB.PieceInfo().Get(ctx, satelliteID, remote.RootPieceId.Derive(piece.NodeId))
+			if err == nil {
+				count++
+			}
+		}
+	}
+	return count
+}
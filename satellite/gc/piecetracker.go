@@ -0,0 +1,85 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gc
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/bloomfilter"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite/metainfo"
+)
+
+var _ metainfo.Observer = (*PieceTracker)(nil)
+
+// PieceTracker implements the metainfo loop observer interface for garbage collection
+type PieceTracker struct {
+	log          *zap.Logger
+	config       Config
+	creationDate time.Time
+
+	// RetainInfos contains the bloom filter and piece count for every node seen in the loop
+	RetainInfos map[storj.NodeID]*RetainInfo
+}
+
+// RetainInfo contains info needed for a storage node to retain important data and delete garbage data
+type RetainInfo struct {
+	Filter       *bloomfilter.Filter
+	CreationDate time.Time
+	Count        int
+}
+
+// NewPieceTracker instantiates a new gc piece tracker to be subscribed to the metainfo loop
+func NewPieceTracker(log *zap.Logger, config Config) *PieceTracker {
+	return &PieceTracker{
+		log:          log,
+		config:       config,
+		creationDate: time.Now().UTC(),
+		RetainInfos:  make(map[storj.NodeID]*RetainInfo),
+	}
+}
+
+// RemoteSegment takes a remote segment found in metainfo and adds pieces to bloom filters
+func (tracker *PieceTracker) RemoteSegment(ctx context.Context, path storj.Path, pointer *pb.Pointer) (err error) {
+	remote := pointer.GetRemote()
+	for _, piece := range remote.GetRemotePieces() {
+		pieceID := remote.RootPieceId.Derive(piece.NodeId)
+		tracker.add(piece.NodeId, pieceID)
+	}
+	return nil
+}
+
+// RemoteObject is called for the last segment of every remote object, its pieces are already tracked by RemoteSegment
+func (tracker *PieceTracker) RemoteObject(ctx context.Context, path storj.Path, pointer *pb.Pointer) (err error) {
+	return nil
+}
+
+// InlineSegment is called for every inline segment, inline segments don't have pieces on storage nodes
+func (tracker *PieceTracker) InlineSegment(ctx context.Context, path storj.Path, pointer *pb.Pointer) (err error) {
+	return nil
+}
+
+// add adds a pieceID to the relevant node's RetainInfo
+func (tracker *PieceTracker) add(nodeID storj.NodeID, pieceID storj.PieceID) {
+	info := tracker.addNode(nodeID)
+	info.Filter.Add(pieceID)
+	info.Count++
+}
+
+// addNode returns the RetainInfo for the node, creating an empty one when the node hasn't been seen yet
+func (tracker *PieceTracker) addNode(nodeID storj.NodeID) *RetainInfo {
+	info, ok := tracker.RetainInfos[nodeID]
+	if !ok {
+		info = &RetainInfo{
+			Filter:       bloomfilter.NewOptimal(tracker.config.InitialPieces, tracker.config.FalsePositiveRate),
+			CreationDate: tracker.creationDate,
+		}
+		tracker.RetainInfos[nodeID] = info
+	}
+	return info
+}
@@ -0,0 +1,201 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gc
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/internal/sync2"
+	"storj.io/storj/pkg/auth/signing"
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/transport"
+	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/uplink/piecestore"
+)
+
+var (
+	// Error defines the gc service errors class
+	Error = errs.Class("gc service error")
+	mon   = monkit.Package()
+)
+
+// Config contains configurable values for garbage collection
+type Config struct {
+	Interval          time.Duration `help:"the time between each send of garbage collection filters to storage nodes" default:"168h"`
+	Enabled           bool          `help:"set if garbage collection is enabled or not" default:"false"`
+	InitialPieces     int           `help:"the initial number of pieces expected for a storage node to have, used for creating a filter" default:"400000"`
+	FalsePositiveRate float64       `help:"the false positive rate used for creating a garbage collection bloom filter" default:"0.1"`
+	ConcurrentSends   int           `help:"the number of nodes to concurrently send garbage collection bloom filters to" default:"1"`
+	RetainSendTimeout time.Duration `help:"the amount of time to allow a node to handle a retain request" default:"1m"`
+}
+
+// Service implements the garbage collection service
+//
+// Once per interval it joins the metainfo loop, builds a bloom filter of
+// the pieces every storage node should have and sends it to the node.
+type Service struct {
+	log    *zap.Logger
+	config Config
+	Loop   sync2.Cycle
+
+	transport    transport.Client
+	overlay      *overlay.Cache
+	metainfoLoop *metainfo.Loop
+}
+
+// NewService creates a new instance of the gc service
+func NewService(log *zap.Logger, config Config, transport transport.Client, overlay *overlay.Cache, loop *metainfo.Loop) *Service {
+	return &Service{
+		log:    log,
+		config: config,
+		Loop:   *sync2.NewCycle(config.Interval),
+
+		transport:    transport,
+		overlay:      overlay,
+		metainfoLoop: loop,
+	}
+}
+
+// Run starts the gc loop service
+func (service *Service) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !service.config.Enabled {
+		return nil
+	}
+
+	return service.Loop.Run(ctx, func(ctx context.Context) error {
+		err := service.Collect(ctx)
+		if err != nil {
+			service.log.Error("error collecting garbage", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Close halts the gc loop
+func (service *Service) Close() error {
+	service.Loop.Close()
+	return nil
+}
+
+// Collect builds bloom filters for all storage nodes and sends them out
+func (service *Service) Collect(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	pieceTracker := NewPieceTracker(service.log.Named("gc observer"), service.config)
+
+	// collect things to retain
+	err = service.metainfoLoop.Join(ctx, pieceTracker)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	// nodes without any pieces still need a filter to delete their garbage
+	err = service.addEmptyFilters(ctx, pieceTracker)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	concurrentSends := service.config.ConcurrentSends
+	if concurrentSends <= 0 {
+		concurrentSends = 1
+	}
+
+	// send retain requests
+	limiter := sync2.NewLimiter(concurrentSends)
+	for nodeID, info := range pieceTracker.RetainInfos {
+		nodeID, info := nodeID, info
+		limiter.Go(ctx, func() {
+			err := service.sendRetainRequest(ctx, nodeID, info)
+			if err != nil {
+				service.log.Error("error sending retain info to node", zap.Stringer("node ID", nodeID), zap.Error(err))
+			}
+		})
+	}
+	limiter.Wait()
+
+	mon.IntVal("nodes_sent_retain_requests").Observe(int64(len(pieceTracker.RetainInfos)))
+	return nil
+}
+
+// addEmptyFilters adds empty filters for the storage nodes which weren't seen during the metainfo loop
+func (service *Service) addEmptyFilters(ctx context.Context, pieceTracker *PieceTracker) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	const limit = 1000
+	var offset int64
+	for {
+		nodes, more, err := service.overlay.Paginate(ctx, offset, limit)
+		if err != nil {
+			return err
+		}
+
+		for _, node := range nodes {
+			if node.Type != pb.NodeType_STORAGE {
+				continue
+			}
+			pieceTracker.addNode(node.Id)
+		}
+
+		if !more {
+			return nil
+		}
+		offset += int64(len(nodes))
+	}
+}
+
+// sendRetainRequest sends the bloom filter to a single storage node
+func (service *Service) sendRetainRequest(ctx context.Context, nodeID storj.NodeID, info *RetainInfo) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dossier, err := service.overlay.Get(ctx, nodeID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	creationDate, err := ptypes.TimestampProto(info.CreationDate)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	if service.config.RetainSendTimeout > 0 {
+		var cancel func()
+		ctx, cancel = context.WithTimeout(ctx, service.config.RetainSendTimeout)
+		defer cancel()
+	}
+
+	conn, err := service.transport.DialNode(ctx, &pb.Node{
+		Id:      nodeID,
+		Address: dossier.Address,
+		Type:    pb.NodeType_STORAGE,
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	client := piecestore.NewClient(
+		service.log.Named(nodeID.String()),
+		signing.SignerFromFullIdentity(service.transport.Identity()),
+		conn,
+		piecestore.DefaultConfig,
+	)
+	defer func() {
+		err = errs.Combine(err, Error.Wrap(client.Close()))
+	}()
+
+	err = client.Retain(ctx, &pb.RetainRequest{
+		CreationDate: creationDate,
+		Filter:       info.Filter.Bytes(),
+	})
+	return Error.Wrap(err)
+}
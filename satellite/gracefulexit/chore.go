@@ -0,0 +1,106 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gracefulexit
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/sync2"
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite/metainfo"
+)
+
+// Chore queues the pieces of exiting nodes for transfer
+//
+// Once per interval it joins the metainfo loop when there are exiting nodes
+// whose pieces haven't been queued yet and marks their loop as completed.
+type Chore struct {
+	log    *zap.Logger
+	config Config
+	Loop   sync2.Cycle
+
+	db           DB
+	overlay      *overlay.Cache
+	metainfoLoop *metainfo.Loop
+}
+
+// NewChore creates a new graceful exit chore
+func NewChore(log *zap.Logger, config Config, db DB, overlay *overlay.Cache, loop *metainfo.Loop) *Chore {
+	return &Chore{
+		log:    log,
+		config: config,
+		Loop:   *sync2.NewCycle(config.ChoreInterval),
+
+		db:           db,
+		overlay:      overlay,
+		metainfoLoop: loop,
+	}
+}
+
+// Run starts the chore
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return chore.Loop.Run(ctx, func(ctx context.Context) error {
+		err := chore.QueuePieces(ctx)
+		if err != nil {
+			chore.log.Error("error queuing pieces of exiting nodes", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// QueuePieces adds the pieces of the exiting nodes, which haven't been queued yet, to the transfer queue
+func (chore *Chore) QueuePieces(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	exiting, err := chore.overlay.GetExitingNodes(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	var nodeIDs storj.NodeIDList
+	for _, status := range exiting {
+		if status.ExitLoopCompletedAt == nil {
+			nodeIDs = append(nodeIDs, status.NodeID)
+		}
+	}
+	if len(nodeIDs) == 0 {
+		return nil
+	}
+
+	collector := NewPathCollector(chore.db, nodeIDs, chore.config.ChoreBatchSize)
+	err = chore.metainfoLoop.Join(ctx, collector)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	err = collector.Flush(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	for _, nodeID := range nodeIDs {
+		_, err = chore.overlay.UpdateExitStatus(ctx, &overlay.ExitStatusRequest{
+			NodeID:              nodeID,
+			ExitLoopCompletedAt: now,
+		})
+		if err != nil {
+			chore.log.Error("error marking exit loop as completed", zap.Stringer("node ID", nodeID), zap.Error(err))
+		}
+	}
+
+	mon.IntVal("exiting_nodes_queued").Observe(int64(len(nodeIDs)))
+	return nil
+}
+
+// Close halts the chore
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
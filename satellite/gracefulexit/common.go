@@ -0,0 +1,27 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gracefulexit
+
+import (
+	"time"
+
+	"github.com/zeebo/errs"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+)
+
+var (
+	// Error is the default error class for graceful exit package.
+	Error = errs.Class("graceful exit")
+
+	mon = monkit.Package()
+)
+
+// Config for the graceful exit service
+type Config struct {
+	ChoreInterval       time.Duration `help:"how often to queue the pieces of nodes that initiated a graceful exit" default:"15m" devDefault:"10s"`
+	ChoreBatchSize      int           `help:"the number of pieces added to the transfer queue at once" default:"500"`
+	EndpointBatchSize   int           `help:"the maximum number of transfers handed out to an exiting node in a single request" default:"100"`
+	MaxFailuresPerPiece int           `help:"the number of failed transfers after which a piece is given up" default:"3"`
+	MaxFailurePercent   int           `help:"the maximum percentage of pieces that may fail for the exit to be successful" default:"10"`
+}
@@ -0,0 +1,51 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gracefulexit
+
+import (
+	"context"
+	"time"
+
+	"storj.io/storj/pkg/storj"
+)
+
+// Progress contains the transfer statistics of an exiting node
+type Progress struct {
+	NodeID            storj.NodeID
+	BytesTransferred  int64
+	PiecesTransferred int64
+	PiecesFailed      int64
+	UpdatedAt         time.Time
+}
+
+// TransferQueueItem is a piece that needs to be transferred off an exiting node
+type TransferQueueItem struct {
+	NodeID       storj.NodeID
+	Path         []byte
+	PieceNum     int32
+	QueuedAt     time.Time
+	FailedCount  int
+	LastFailedAt *time.Time
+}
+
+// DB stores the progress and the transfer queues of exiting nodes
+type DB interface {
+	// IncrementProgress adds the transferred bytes and pieces to the progress of the node
+	IncrementProgress(ctx context.Context, nodeID storj.NodeID, bytes, piecesTransferred, piecesFailed int64) error
+	// GetProgress returns the progress of the node, a zero progress when nothing has been transferred yet
+	GetProgress(ctx context.Context, nodeID storj.NodeID) (*Progress, error)
+
+	// Enqueue adds items to the transfer queue, items that are already queued are ignored
+	Enqueue(ctx context.Context, items []TransferQueueItem) error
+	// GetTransferQueueItem returns a single queued item
+	GetTransferQueueItem(ctx context.Context, nodeID storj.NodeID, path []byte, pieceNum int32) (*TransferQueueItem, error)
+	// GetIncomplete returns at most limit queued items of the node that have failed fewer than maxFailures times
+	GetIncomplete(ctx context.Context, nodeID storj.NodeID, maxFailures int, limit int) ([]*TransferQueueItem, error)
+	// IncrementFailures records a failed transfer of the item and returns the new failure count
+	IncrementFailures(ctx context.Context, nodeID storj.NodeID, path []byte, pieceNum int32) (failedCount int, err error)
+	// DeleteTransferQueueItem removes a single item from the queue
+	DeleteTransferQueueItem(ctx context.Context, nodeID storj.NodeID, path []byte, pieceNum int32) error
+	// DeleteTransferQueueItems removes all queued items of the node
+	DeleteTransferQueueItems(ctx context.Context, nodeID storj.NodeID) error
+}
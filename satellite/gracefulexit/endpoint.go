@@ -0,0 +1,372 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gracefulexit
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/pkg/auth/signing"
+	"storj.io/storj/pkg/eestream"
+	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/kademlia"
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/pointerdb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite/orders"
+	"storj.io/storj/storage"
+)
+
+// errPieceGone is returned when a queued piece is no longer stored on the exiting node
+var errPieceGone = errs.Class("piece no longer on node")
+
+// Endpoint hands out transfers to exiting nodes and updates the pointers of transferred pieces
+type Endpoint struct {
+	log        *zap.Logger
+	config     Config
+	db         DB
+	overlay    *overlay.Cache
+	kademlia   *kademlia.Kademlia
+	pointerdb  *pointerdb.Service
+	orders     *orders.Service
+	placements overlay.BucketPlacements
+}
+
+// NewEndpoint creates a new graceful exit endpoint
+func NewEndpoint(log *zap.Logger, config Config, db DB, overlay *overlay.Cache, kademlia *kademlia.Kademlia, pointerdb *pointerdb.Service, orders *orders.Service, placements overlay.BucketPlacements) *Endpoint {
+	return &Endpoint{
+		log:        log,
+		config:     config,
+		db:         db,
+		overlay:    overlay,
+		kademlia:   kademlia,
+		pointerdb:  pointerdb,
+		orders:     orders,
+		placements: placements,
+	}
+}
+
+// InitiateExit marks the calling node as exiting, new pieces won't be stored on it anymore
+func (endpoint *Endpoint) InitiateExit(ctx context.Context, req *pb.InitiateExitRequest) (_ *pb.InitiateExitResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	node, err := endpoint.overlay.Get(ctx, peer.ID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, Error.Wrap(err).Error())
+	}
+	if node.Reputation.Disqualified != nil {
+		return nil, status.Error(codes.FailedPrecondition, "disqualified nodes cannot exit gracefully")
+	}
+
+	initiatedAt := node.ExitStatus.ExitInitiatedAt
+	if initiatedAt == nil {
+		node, err = endpoint.overlay.UpdateExitStatus(ctx, &overlay.ExitStatusRequest{
+			NodeID:          peer.ID,
+			ExitInitiatedAt: time.Now().UTC(),
+		})
+		if err != nil {
+			return nil, status.Error(codes.Internal, Error.Wrap(err).Error())
+		}
+		initiatedAt = node.ExitStatus.ExitInitiatedAt
+		endpoint.log.Info("graceful exit initiated", zap.Stringer("node ID", peer.ID))
+	}
+
+	timestamp, err := ptypes.TimestampProto(*initiatedAt)
+	if err != nil {
+		return nil, status.Error(codes.Internal, Error.Wrap(err).Error())
+	}
+	return &pb.InitiateExitResponse{ExitInitiatedAt: timestamp}, nil
+}
+
+// GetTransfers returns the pieces the calling node should transfer to replacement nodes
+//
+// The exit is finished once all pieces have been transferred or given up.
+func (endpoint *Endpoint) GetTransfers(ctx context.Context, req *pb.GetTransfersRequest) (_ *pb.GetTransfersResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	node, err := endpoint.overlay.Get(ctx, peer.ID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, Error.Wrap(err).Error())
+	}
+
+	exit := node.ExitStatus
+	switch {
+	case !exit.Exiting():
+		return nil, status.Error(codes.FailedPrecondition, "graceful exit not initiated")
+	case exit.Finished():
+		return &pb.GetTransfersResponse{Finished: true, Successful: exit.ExitSuccess}, nil
+	case exit.ExitLoopCompletedAt == nil:
+		// the pieces haven't been queued yet
+		return &pb.GetTransfersResponse{}, nil
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 || limit > endpoint.config.EndpointBatchSize {
+		limit = endpoint.config.EndpointBatchSize
+	}
+
+	items, err := endpoint.db.GetIncomplete(ctx, peer.ID, endpoint.config.MaxFailuresPerPiece, limit)
+	if err != nil {
+		return nil, status.Error(codes.Internal, Error.Wrap(err).Error())
+	}
+
+	if len(items) == 0 {
+		successful, err := endpoint.finish(ctx, peer.ID)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		return &pb.GetTransfersResponse{Finished: true, Successful: successful}, nil
+	}
+
+	response := &pb.GetTransfersResponse{}
+	for _, item := range items {
+		transfer, err := endpoint.createTransfer(ctx, peer, item)
+		if errPieceGone.Has(err) {
+			// the segment was deleted or repaired in the meantime
+			err = endpoint.db.DeleteTransferQueueItem(ctx, peer.ID, item.Path, item.PieceNum)
+		}
+		if err != nil {
+			endpoint.log.Error("unable to create transfer", zap.Stringer("node ID", peer.ID), zap.ByteString("path", item.Path), zap.Error(err))
+			continue
+		}
+		if transfer != nil {
+			response.Transfers = append(response.Transfers, transfer)
+		}
+	}
+
+	return response, nil
+}
+
+// ReportTransfer updates the pointer of a successfully transferred piece or records the failure
+func (endpoint *Endpoint) ReportTransfer(ctx context.Context, req *pb.ReportTransferRequest) (_ *pb.ReportTransferResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	item, err := endpoint.db.GetTransferQueueItem(ctx, peer.ID, req.Path, req.PieceNum)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, Error.Wrap(err).Error())
+	}
+
+	if !req.Success {
+		endpoint.log.Debug("piece transfer failed", zap.Stringer("node ID", peer.ID), zap.ByteString("path", item.Path), zap.String("error", req.Error))
+		if err := endpoint.recordFailure(ctx, item); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		return &pb.ReportTransferResponse{}, nil
+	}
+
+	limit, hash := req.OrderLimit, req.ReplacementPieceHash
+	if limit == nil || hash == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing order limit or piece hash")
+	}
+	if err := endpoint.orders.VerifyOrderLimitSignature(limit); err != nil {
+		return nil, status.Error(codes.PermissionDenied, Error.Wrap(err).Error())
+	}
+	if limit.UplinkId != peer.ID || limit.Action != pb.PieceAction_PUT_REPAIR || hash.PieceId != limit.PieceId {
+		return nil, status.Error(codes.InvalidArgument, "order limit or piece hash doesn't match the transfer")
+	}
+
+	// the piece hash has to be signed by the node the piece was transferred to
+	replacement, err := endpoint.kademlia.FetchPeerIdentity(ctx, limit.StorageNodeId)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, Error.Wrap(err).Error())
+	}
+	if err := signing.VerifyPieceHashSignature(signing.SigneeFromPeerIdentity(replacement), hash); err != nil {
+		return nil, status.Error(codes.PermissionDenied, Error.Wrap(err).Error())
+	}
+
+	err = endpoint.updatePointer(ctx, item, limit, hash)
+	if errPieceGone.Has(err) {
+		// the segment was deleted or repaired in the meantime, the transferred piece is garbage
+		return &pb.ReportTransferResponse{}, Error.Wrap(endpoint.db.DeleteTransferQueueItem(ctx, peer.ID, item.Path, item.PieceNum))
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.ReportTransferResponse{}, nil
+}
+
+// createTransfer creates the order limit for uploading the queued piece to a replacement node
+func (endpoint *Endpoint) createTransfer(ctx context.Context, peer *identity.PeerIdentity, item *TransferQueueItem) (_ *pb.TransferPiece, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	path := storj.Path(item.Path)
+	pointer, remote, err := endpoint.getPiece(ctx, item)
+	if err != nil {
+		return nil, err
+	}
+
+	redundancy, err := eestream.NewRedundancyStrategyFromProto(remote.GetRedundancy())
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	pieceSize := eestream.CalcPieceSize(pointer.GetSegmentSize(), redundancy)
+
+	var excludedNodes storj.NodeIDList
+	for _, piece := range remote.GetRemotePieces() {
+		excludedNodes = append(excludedNodes, piece.NodeId)
+	}
+
+	projectID, bucket, err := overlay.SplitBucketPath(path)
+	if err != nil {
+		return nil, err
+	}
+	placement, err := endpoint.placements.GetBucketPlacement(ctx, projectID, bucket)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	newNodes, err := endpoint.overlay.FindStorageNodes(ctx, overlay.FindStorageNodesRequest{
+		RequestedCount: 1,
+		FreeBandwidth:  pieceSize,
+		FreeDisk:       pieceSize,
+		ExcludedNodes:  excludedNodes,
+		Placement:      placement,
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	bucketID := []byte(storj.JoinPaths(projectID.String(), string(bucket)))
+	limit, err := endpoint.orders.CreateGracefulExitPutOrderLimit(ctx, peer, bucketID, pointer, item.PieceNum, newNodes[0])
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return &pb.TransferPiece{
+		Path:                item.Path,
+		PieceNum:            item.PieceNum,
+		OriginalPieceId:     remote.RootPieceId.Derive(item.NodeID),
+		AddressedOrderLimit: limit,
+	}, nil
+}
+
+// updatePointer replaces the exiting node in the pointer with the node the piece was transferred to
+func (endpoint *Endpoint) updatePointer(ctx context.Context, item *TransferQueueItem, limit *pb.OrderLimit2, hash *pb.PieceHash) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// the pointer is only replaced when it wasn't modified by a concurrent repair or delete
+	pointer, err := endpoint.pointerdb.Update(string(item.Path), func(pointer *pb.Pointer) error {
+		remote := pointer.GetRemote()
+		if remote == nil || remote.RootPieceId.Derive(limit.StorageNodeId) != limit.PieceId {
+			return errPieceGone.New("order limit for a different segment")
+		}
+
+		for _, piece := range remote.GetRemotePieces() {
+			if piece.PieceNum == item.PieceNum && piece.NodeId == item.NodeID {
+				piece.NodeId = limit.StorageNodeId
+				piece.Hash = hash
+				return nil
+			}
+		}
+		return errPieceGone.New("piece %d of %s", item.PieceNum, item.Path)
+	})
+	if storage.ErrKeyNotFound.Has(err) {
+		return errPieceGone.Wrap(err)
+	}
+	if err != nil {
+		if errPieceGone.Has(err) {
+			return err
+		}
+		return Error.Wrap(err)
+	}
+
+	redundancy, err := eestream.NewRedundancyStrategyFromProto(pointer.GetRemote().GetRedundancy())
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	pieceSize := eestream.CalcPieceSize(pointer.GetSegmentSize(), redundancy)
+
+	err = endpoint.db.IncrementProgress(ctx, item.NodeID, pieceSize, 1, 0)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	return Error.Wrap(endpoint.db.DeleteTransferQueueItem(ctx, item.NodeID, item.Path, item.PieceNum))
+}
+
+// getPiece returns the pointer of the queued item, errPieceGone when the piece isn't stored on the exiting node anymore
+func (endpoint *Endpoint) getPiece(ctx context.Context, item *TransferQueueItem) (*pb.Pointer, *pb.RemoteSegment, error) {
+	pointer, err := endpoint.pointerdb.Get(string(item.Path))
+	if storage.ErrKeyNotFound.Has(err) {
+		return nil, nil, errPieceGone.Wrap(err)
+	}
+	if err != nil {
+		return nil, nil, Error.Wrap(err)
+	}
+
+	remote := pointer.GetRemote()
+	for _, piece := range remote.GetRemotePieces() {
+		if piece.PieceNum == item.PieceNum && piece.NodeId == item.NodeID {
+			return pointer, remote, nil
+		}
+	}
+	return nil, nil, errPieceGone.New("piece %d of %s", item.PieceNum, item.Path)
+}
+
+// recordFailure records a failed transfer, the piece is given up after too many failures
+func (endpoint *Endpoint) recordFailure(ctx context.Context, item *TransferQueueItem) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	failedCount, err := endpoint.db.IncrementFailures(ctx, item.NodeID, item.Path, item.PieceNum)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if failedCount >= endpoint.config.MaxFailuresPerPiece {
+		return Error.Wrap(endpoint.db.IncrementProgress(ctx, item.NodeID, 0, 0, 1))
+	}
+	return nil
+}
+
+// finish records the outcome of the exit in the overlay, the exit is successful when few enough pieces failed
+func (endpoint *Endpoint) finish(ctx context.Context, nodeID storj.NodeID) (successful bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	progress, err := endpoint.db.GetProgress(ctx, nodeID)
+	if err != nil {
+		return false, Error.Wrap(err)
+	}
+
+	total := progress.PiecesTransferred + progress.PiecesFailed
+	successful = progress.PiecesFailed*100 <= total*int64(endpoint.config.MaxFailurePercent)
+
+	err = endpoint.db.DeleteTransferQueueItems(ctx, nodeID)
+	if err != nil {
+		return false, Error.Wrap(err)
+	}
+
+	_, err = endpoint.overlay.UpdateExitStatus(ctx, &overlay.ExitStatusRequest{
+		NodeID:         nodeID,
+		ExitFinishedAt: time.Now().UTC(),
+		ExitSuccess:    successful,
+	})
+	if err != nil {
+		return false, Error.Wrap(err)
+	}
+
+	endpoint.log.Info("graceful exit finished", zap.Stringer("node ID", nodeID), zap.Bool("successful", successful),
+		zap.Int64("pieces transferred", progress.PiecesTransferred), zap.Int64("pieces failed", progress.PiecesFailed))
+	return successful, nil
+}
@@ -0,0 +1,104 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gracefulexit_test
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/pointerdb/pointerdbtest"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storagenode"
+)
+
+// TestGracefulExit does the following:
+// * uploads an object
+// * initiates a graceful exit of a node storing one of its pieces
+// * queues the pieces of the exiting node and lets the node transfer them
+// * checks that the pointers don't reference the exiting node anymore and the exit succeeded
+func TestGracefulExit(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 6, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		satellite.GracefulExit.Chore.Loop.Pause()
+		for _, node := range planet.StorageNodes {
+			node.GracefulExit.Worker.Loop.Pause()
+		}
+
+		upl := planet.Uplinks[0]
+		data := make([]byte, 10*memory.KiB)
+		_, err := rand.Read(data)
+		require.NoError(t, err)
+		err = upl.Upload(ctx, satellite, "testbucket", "path", data)
+		require.NoError(t, err)
+
+		pointers := pointerdbtest.ListRemotePointers(t, satellite.Metainfo.Service)
+		require.NotEmpty(t, pointers)
+
+		exitingNode := findNode(t, planet, pointers)
+		progress, err := exitingNode.GracefulExit.Endpoint.InitiateGracefulExit(ctx, &pb.InitiateGracefulExitRequest{
+			SatelliteId: satellite.ID(),
+		})
+		require.NoError(t, err)
+		require.Nil(t, progress.FinishedAt)
+
+		dossier, err := satellite.Overlay.Service.Get(ctx, exitingNode.ID())
+		require.NoError(t, err)
+		require.True(t, dossier.ExitStatus.Exiting())
+
+		err = satellite.GracefulExit.Chore.QueuePieces(ctx)
+		require.NoError(t, err)
+
+		err = exitingNode.GracefulExit.Worker.ProcessExits(ctx)
+		require.NoError(t, err)
+
+		for _, pointer := range pointerdbtest.ListRemotePointers(t, satellite.Metainfo.Service) {
+			for _, piece := range pointer.GetRemote().GetRemotePieces() {
+				require.NotEqual(t, exitingNode.ID(), piece.NodeId)
+			}
+		}
+
+		dossier, err = satellite.Overlay.Service.Get(ctx, exitingNode.ID())
+		require.NoError(t, err)
+		require.True(t, dossier.ExitStatus.Finished())
+		require.True(t, dossier.ExitStatus.ExitSuccess)
+
+		exits, err := exitingNode.DB.GracefulExit().ListExits(ctx)
+		require.NoError(t, err)
+		require.Len(t, exits, 1)
+		require.NotNil(t, exits[0].FinishedAt)
+		require.True(t, exits[0].Successful)
+		require.NotZero(t, exits[0].PiecesTransferred)
+
+		// the object is still downloadable without the exiting node
+		downloaded, err := upl.Download(ctx, satellite, "testbucket", "path")
+		require.NoError(t, err)
+		require.Equal(t, data, downloaded)
+	})
+}
+
+// findNode returns a storage node that stores one of the pieces of the pointers
+func findNode(t *testing.T, planet *testplanet.Planet, pointers map[string]*pb.Pointer) *storagenode.Peer {
+	nodes := map[storj.NodeID]*storagenode.Peer{}
+	for _, node := range planet.StorageNodes {
+		nodes[node.ID()] = node
+	}
+
+	for _, pointer := range pointers {
+		for _, piece := range pointer.GetRemote().GetRemotePieces() {
+			if node, ok := nodes[piece.NodeId]; ok {
+				return node
+			}
+		}
+	}
+	t.Fatal("no node stores any of the pieces")
+	return nil
+}
@@ -0,0 +1,78 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gracefulexit
+
+import (
+	"context"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite/metainfo"
+)
+
+var _ metainfo.Observer = (*PathCollector)(nil)
+
+// PathCollector implements the metainfo loop observer interface for graceful exit
+//
+// It adds every piece stored on one of the exiting nodes to the transfer queue.
+type PathCollector struct {
+	db        DB
+	nodeIDs   map[storj.NodeID]struct{}
+	buffer    []TransferQueueItem
+	batchSize int
+}
+
+// NewPathCollector instantiates a path collector for the exiting nodes
+func NewPathCollector(db DB, nodeIDs storj.NodeIDList, batchSize int) *PathCollector {
+	collector := &PathCollector{
+		db:        db,
+		nodeIDs:   make(map[storj.NodeID]struct{}, len(nodeIDs)),
+		batchSize: batchSize,
+	}
+	for _, nodeID := range nodeIDs {
+		collector.nodeIDs[nodeID] = struct{}{}
+	}
+	return collector
+}
+
+// Flush enqueues the items that are still buffered
+func (collector *PathCollector) Flush(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(collector.buffer) == 0 {
+		return nil
+	}
+	err = collector.db.Enqueue(ctx, collector.buffer)
+	collector.buffer = collector.buffer[:0]
+	return Error.Wrap(err)
+}
+
+// RemoteSegment queues the pieces of the segment that are stored on exiting nodes
+func (collector *PathCollector) RemoteSegment(ctx context.Context, path storj.Path, pointer *pb.Pointer) (err error) {
+	for _, piece := range pointer.GetRemote().GetRemotePieces() {
+		if _, ok := collector.nodeIDs[piece.NodeId]; !ok {
+			continue
+		}
+		collector.buffer = append(collector.buffer, TransferQueueItem{
+			NodeID:   piece.NodeId,
+			Path:     []byte(path),
+			PieceNum: piece.PieceNum,
+		})
+	}
+
+	if len(collector.buffer) >= collector.batchSize {
+		return collector.Flush(ctx)
+	}
+	return nil
+}
+
+// RemoteObject is called for the last segment of every remote object, its pieces are already queued by RemoteSegment
+func (collector *PathCollector) RemoteObject(ctx context.Context, path storj.Path, pointer *pb.Pointer) (err error) {
+	return nil
+}
+
+// InlineSegment is called for every inline segment, inline segments don't have pieces on storage nodes
+func (collector *PathCollector) InlineSegment(ctx context.Context, path storj.Path, pointer *pb.Pointer) (err error) {
+	return nil
+}
@@ -0,0 +1,250 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/pointerdb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storage"
+)
+
+var (
+	// LoopError is a standard error class for this component.
+	LoopError = errs.Class("metainfo loop error")
+	// LoopClosedError is a loop closed error
+	LoopClosedError = LoopError.New("loop closed")
+)
+
+// Observer is an interface defining an observer that can subscribe to the metainfo loop.
+//
+// The pointer passed to the observer is shared between all observers and must not be modified.
+type Observer interface {
+	RemoteSegment(ctx context.Context, path storj.Path, pointer *pb.Pointer) error
+	RemoteObject(ctx context.Context, path storj.Path, pointer *pb.Pointer) error
+	InlineSegment(ctx context.Context, path storj.Path, pointer *pb.Pointer) error
+}
+
+// LoopConfig contains configurable values for the metainfo loop
+type LoopConfig struct {
+	CoalesceDuration time.Duration `help:"how long to wait for new observers before starting iteration" default:"5s"`
+}
+
+// observerContext keeps track of the observer and the channel used to report back its result.
+type observerContext struct {
+	observer Observer
+	ctx      context.Context
+	done     chan error
+}
+
+// Finish reports that the observer has seen the full cycle.
+func (observer *observerContext) Finish() {
+	close(observer.done)
+}
+
+// HandleError reports the error to the observer, it returns true when the error was not nil.
+func (observer *observerContext) HandleError(err error) bool {
+	if err != nil {
+		observer.done <- err
+		observer.Finish()
+		return true
+	}
+	return false
+}
+
+// Wait waits until the observer has finished.
+func (observer *observerContext) Wait() error {
+	return <-observer.done
+}
+
+// Loop is a metainfo loop service
+//
+// Loop iterates over all pointers once per cycle and streams them to the observers
+// that have joined the cycle, so pointerdb is scanned once instead of once per service.
+type Loop struct {
+	config    LoopConfig
+	pointerdb *pointerdb.Service
+	join      chan *observerContext
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// NewLoop creates a new metainfo loop service
+func NewLoop(config LoopConfig, pointerdb *pointerdb.Service) *Loop {
+	return &Loop{
+		config:    config,
+		pointerdb: pointerdb,
+		join:      make(chan *observerContext),
+		done:      make(chan struct{}),
+	}
+}
+
+// Join will join the observer to the next cycle of the loop.
+//
+// Join blocks until the observer has seen every pointer exactly once,
+// the observer returns an error or the context is canceled.
+func (loop *Loop) Join(ctx context.Context, observer Observer) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	obsContext := &observerContext{
+		observer: observer,
+		ctx:      ctx,
+		done:     make(chan error, 1),
+	}
+
+	select {
+	case loop.join <- obsContext:
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-loop.done:
+		return LoopClosedError
+	}
+
+	return obsContext.Wait()
+}
+
+// Run starts the looping service.
+func (loop *Loop) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for {
+		err := loop.runOnce(ctx)
+		if err == LoopClosedError {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// runOnce waits for the observers to join and then iterates over pointerdb once.
+func (loop *Loop) runOnce(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var observers []*observerContext
+
+	// wait for the first observer, or exit because the loop is closed
+	select {
+	case observer := <-loop.join:
+		observers = append(observers, observer)
+	case <-loop.done:
+		return LoopClosedError
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	defer func() {
+		for _, observer := range observers {
+			if err != nil {
+				observer.HandleError(err)
+				continue
+			}
+			// observers that were canceled did not see the full cycle
+			if !observer.HandleError(observer.ctx.Err()) {
+				observer.Finish()
+			}
+		}
+	}()
+
+	// give other observers a chance to join the same cycle
+	timer := time.NewTimer(loop.config.CoalesceDuration)
+	defer timer.Stop()
+
+waitformore:
+	for {
+		select {
+		case observer := <-loop.join:
+			observers = append(observers, observer)
+		case <-timer.C:
+			break waitformore
+		case <-loop.done:
+			return LoopClosedError
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	var pointersIterated int64
+	err = loop.pointerdb.Iterate("", "", true, false,
+		func(it storage.Iterator) error {
+			var item storage.ListItem
+			for it.Next(&item) {
+				if err := ctx.Err(); err != nil {
+					return err
+				}
+
+				observers = withObservers(observers, func(observer *observerContext) bool {
+					return !observer.HandleError(observer.ctx.Err())
+				})
+				if len(observers) == 0 {
+					return nil
+				}
+
+				pointer := &pb.Pointer{}
+				if err := proto.Unmarshal(item.Value, pointer); err != nil {
+					return LoopError.New("error unmarshalling pointer %s", err)
+				}
+				pointersIterated++
+
+				path := storj.Path(item.Key)
+				observers = withObservers(observers, func(observer *observerContext) bool {
+					return !observer.HandleError(handlePointer(observer, path, pointer))
+				})
+			}
+			return nil
+		},
+	)
+	mon.IntVal("pointers_iterated").Observe(pointersIterated)
+
+	return LoopError.Wrap(err)
+}
+
+// handlePointer passes the pointer to the matching observer callbacks.
+func handlePointer(observer *observerContext, path storj.Path, pointer *pb.Pointer) error {
+	ctx := observer.ctx
+	if pointer.GetRemote() == nil {
+		return observer.observer.InlineSegment(ctx, path, pointer)
+	}
+
+	if err := observer.observer.RemoteSegment(ctx, path, pointer); err != nil {
+		return err
+	}
+
+	pathElements := storj.SplitPath(path)
+	if len(pathElements) >= 2 && pathElements[1] == "l" {
+		return observer.observer.RemoteObject(ctx, path, pointer)
+	}
+	return nil
+}
+
+// withObservers keeps only the observers for which keep returns true.
+func withObservers(observers []*observerContext, keep func(observer *observerContext) bool) []*observerContext {
+	kept := observers[:0]
+	for _, observer := range observers {
+		if keep(observer) {
+			kept = append(kept, observer)
+		}
+	}
+	return kept
+}
+
+// Close closes the looping service and stops all pending joins.
+func (loop *Loop) Close() (err error) {
+	loop.closeOnce.Do(func() {
+		close(loop.done)
+	})
+	return nil
+}
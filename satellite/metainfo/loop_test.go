@@ -0,0 +1,121 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zeebo/errs"
+	"golang.org/x/sync/errgroup"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+)
+
+// TestLoop does the following
+// * puts remote and inline segments into pointerdb
+// * joins several observers to the metainfo loop at the same time
+// * checks that every observer saw every segment exactly once
+// * checks that a failing observer doesn't affect the others
+func TestLoop(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		pointerdb := satellite.Metainfo.Service
+		metaLoop := satellite.Metainfo.Loop
+
+		remote := &pb.Pointer{
+			Type: pb.Pointer_REMOTE,
+			Remote: &pb.RemoteSegment{
+				Redundancy: &pb.RedundancyScheme{MinReq: 1, RepairThreshold: 2},
+			},
+		}
+		inline := &pb.Pointer{
+			Type:          pb.Pointer_INLINE,
+			InlineSegment: []byte("inline"),
+		}
+
+		require.NoError(t, pointerdb.Put("project/l/bucket/remote-object", remote))
+		require.NoError(t, pointerdb.Put("project/s0/bucket/remote-object", remote))
+		require.NoError(t, pointerdb.Put("project/l/bucket/inline-object", inline))
+
+		observers := []*testObserver{newTestObserver(nil), newTestObserver(nil)}
+		failing := newTestObserver(errs.New("observer failed"))
+
+		var group errgroup.Group
+		for _, observer := range observers {
+			observer := observer
+			group.Go(func() error {
+				return metaLoop.Join(ctx, observer)
+			})
+		}
+		var failingErr error
+		group.Go(func() error {
+			failingErr = metaLoop.Join(ctx, failing)
+			return nil
+		})
+		require.NoError(t, group.Wait())
+
+		require.Error(t, failingErr)
+		for _, observer := range observers {
+			assert.Equal(t, 2, observer.remoteSegments)
+			assert.Equal(t, 1, observer.remoteObjects)
+			assert.Equal(t, 1, observer.inlineSegments)
+			assert.Len(t, observer.paths, 3)
+		}
+	})
+}
+
+// TestLoopCancel checks that joining with a canceled context doesn't block
+func TestLoopCancel(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		metaLoop := planet.Satellites[0].Metainfo.Loop
+
+		canceledCtx, cancel := context.WithCancel(ctx)
+		cancel()
+
+		err := metaLoop.Join(canceledCtx, newTestObserver(nil))
+		require.Error(t, err)
+	})
+}
+
+type testObserver struct {
+	remoteSegments int
+	remoteObjects  int
+	inlineSegments int
+	paths          map[storj.Path]struct{}
+	err            error
+}
+
+func newTestObserver(err error) *testObserver {
+	return &testObserver{
+		paths: make(map[storj.Path]struct{}),
+		err:   err,
+	}
+}
+
+func (observer *testObserver) RemoteSegment(ctx context.Context, path storj.Path, pointer *pb.Pointer) error {
+	observer.remoteSegments++
+	observer.paths[path] = struct{}{}
+	return observer.err
+}
+
+func (observer *testObserver) RemoteObject(ctx context.Context, path storj.Path, pointer *pb.Pointer) error {
+	observer.remoteObjects++
+	return observer.err
+}
+
+func (observer *testObserver) InlineSegment(ctx context.Context, path storj.Path, pointer *pb.Pointer) error {
+	observer.inlineSegments++
+	observer.paths[path] = struct{}{}
+	return observer.err
+}
@@ -49,12 +49,13 @@ type Endpoint struct {
 	orders        *orders.Service
 	cache         *overlay.Cache
 	apiKeys       APIKeys
+	placements    overlay.BucketPlacements
 	accountingDB  accounting.DB
 	maxAlphaUsage memory.Size
 }
 
 // NewEndpoint creates new metainfo endpoint instance
-func NewEndpoint(log *zap.Logger, pointerdb *pointerdb.Service, orders *orders.Service, cache *overlay.Cache, apiKeys APIKeys, acctDB accounting.DB, maxAlphaUsage memory.Size) *Endpoint {
+func NewEndpoint(log *zap.Logger, pointerdb *pointerdb.Service, orders *orders.Service, cache *overlay.Cache, apiKeys APIKeys, placements overlay.BucketPlacements, acctDB accounting.DB, maxAlphaUsage memory.Size) *Endpoint {
 	// TODO do something with too many params
 	return &Endpoint{
 		log:           log,
@@ -62,6 +63,7 @@ func NewEndpoint(log *zap.Logger, pointerdb *pointerdb.Service, orders *orders.S
 		orders:        orders,
 		cache:         cache,
 		apiKeys:       apiKeys,
+		placements:    placements,
 		accountingDB:  acctDB,
 		maxAlphaUsage: maxAlphaUsage,
 	}
@@ -166,12 +168,18 @@ func (endpoint *Endpoint) CreateSegment(ctx context.Context, req *pb.SegmentWrit
 
 	maxPieceSize := eestream.CalcPieceSize(req.GetMaxEncryptedSegmentSize(), redundancy)
 
+	placement, err := endpoint.placements.GetBucketPlacement(ctx, keyInfo.ProjectID, req.Bucket)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
 	request := overlay.FindStorageNodesRequest{
 		RequestedCount: int(req.Redundancy.Total),
 		FreeBandwidth:  maxPieceSize,
 		FreeDisk:       maxPieceSize,
+		Placement:      placement,
 	}
-	nodes, err := endpoint.cache.FindStorageNodes(ctx, request)
+	nodes, err := endpoint.cache.FindStorageNodesForUpload(ctx, request)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
@@ -390,6 +398,33 @@ func (endpoint *Endpoint) ListSegments(ctx context.Context, req *pb.ListSegments
 	return &pb.ListSegmentsResponse{Items: segmentItems, More: more}, nil
 }
 
+// SetBucketPlacement sets the placement constraint that restricts where the segments of the bucket are stored
+func (endpoint *Endpoint) SetBucketPlacement(ctx context.Context, req *pb.SetBucketPlacementRequest) (resp *pb.SetBucketPlacementResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := endpoint.validateAuth(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, err.Error())
+	}
+
+	err = endpoint.validateBucket(req.Bucket)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	placement, err := overlay.ParsePlacement(req.Placement)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	err = endpoint.placements.SetBucketPlacement(ctx, keyInfo.ProjectID, req.Bucket, placement)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return &pb.SetBucketPlacementResponse{}, nil
+}
+
 func createBucketID(projectID uuid.UUID, bucket []byte) []byte {
 	entries := make([]string, 0)
 	entries = append(entries, projectID.String())
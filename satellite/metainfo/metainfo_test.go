@@ -225,3 +225,81 @@ func TestCommitSegment(t *testing.T) {
 		require.Contains(t, err.Error(), "Number of valid pieces is lower then repair threshold")
 	}
 }
+
+func TestBucketPlacement(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	planet, err := testplanet.New(t, 1, 6, 1)
+	require.NoError(t, err)
+	defer ctx.Check(planet.Shutdown)
+
+	planet.Start(ctx)
+
+	satellite := planet.Satellites[0]
+
+	projects, err := satellite.DB.Console().Projects().GetAll(ctx)
+	require.NoError(t, err)
+	apiKey := console.APIKeyFromBytes([]byte(projects[0].Name)).String()
+
+	metainfo, err := planet.Uplinks[0].DialMetainfo(ctx, satellite, apiKey)
+	require.NoError(t, err)
+
+	// half of the nodes are located in Germany and the rest in the United States
+	germanNodes := map[storj.NodeID]bool{}
+	for i, node := range planet.StorageNodes {
+		countryCode := "US"
+		if i%2 == 0 {
+			countryCode = "DE"
+			germanNodes[node.ID()] = true
+		}
+		_, err := satellite.Overlay.Service.UpdateNodeInfo(ctx, node.ID(), &pb.InfoResponse{
+			Operator: &pb.NodeOperator{CountryCode: countryCode},
+		})
+		require.NoError(t, err)
+	}
+
+	err = metainfo.SetBucketPlacement(ctx, "bucket", "mars")
+	require.Error(t, err)
+
+	err = metainfo.SetBucketPlacement(ctx, "bucket/storj", "EU")
+	require.Error(t, err)
+
+	err = metainfo.SetBucketPlacement(ctx, "bucket", "eu")
+	require.NoError(t, err)
+
+	placement, err := satellite.DB.BucketPlacements().GetBucketPlacement(ctx, projects[0].ID, []byte("bucket"))
+	require.NoError(t, err)
+	assert.Equal(t, "EU", string(placement))
+
+	redundancy := &pb.RedundancyScheme{
+		MinReq:           1,
+		RepairThreshold:  2,
+		SuccessThreshold: 3,
+		Total:            3,
+		ErasureShareSize: 10,
+	}
+
+	limits, _, err := metainfo.CreateSegment(ctx, "bucket", "path", -1, redundancy, 1000, time.Now())
+	require.NoError(t, err)
+	require.Len(t, limits, 3)
+	for _, limit := range limits {
+		assert.True(t, germanNodes[limit.Limit.StorageNodeId])
+	}
+
+	// there are not enough nodes in the EU for a larger segment
+	redundancy.SuccessThreshold, redundancy.Total = 4, 4
+	_, _, err = metainfo.CreateSegment(ctx, "bucket", "path", -1, redundancy, 1000, time.Now())
+	require.Error(t, err)
+
+	// other buckets are not constrained
+	_, _, err = metainfo.CreateSegment(ctx, "otherbucket", "path", -1, redundancy, 1000, time.Now())
+	require.NoError(t, err)
+
+	// removing the constraint allows all nodes again
+	err = metainfo.SetBucketPlacement(ctx, "bucket", "")
+	require.NoError(t, err)
+
+	_, _, err = metainfo.CreateSegment(ctx, "bucket", "path", -1, redundancy, 1000, time.Now())
+	require.NoError(t, err)
+}
@@ -0,0 +1,21 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nodeadmin
+
+import (
+	"github.com/zeebo/errs"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+)
+
+var (
+	// Error is the default error class for node admin package.
+	Error = errs.Class("node admin")
+
+	mon = monkit.Package()
+)
+
+// Config for the node admin endpoint
+type Config struct {
+	Operators string `help:"comma-separated list of the node IDs of the identities allowed to manage nodes" default:""`
+}
@@ -0,0 +1,46 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nodeadmin
+
+import (
+	"context"
+	"time"
+
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/storj"
+)
+
+// Action is a change made to a node by an operator
+type Action string
+
+const (
+	// ActionQuarantine excludes the node from new uploads
+	ActionQuarantine = Action("quarantine")
+	// ActionDisqualify disqualifies the node permanently
+	ActionDisqualify = Action("disqualify")
+	// ActionReinstate lifts the disqualification, suspension and quarantine of the node
+	ActionReinstate = Action("reinstate")
+	// ActionAnnotate adds a note about the node
+	ActionAnnotate = Action("annotate")
+)
+
+// LogEntry records who made a change to a node
+type LogEntry struct {
+	NodeID   storj.NodeID
+	Action   Action
+	Operator string
+	// OperatorID is the identity the change was made with, zero when unknown
+	OperatorID storj.NodeID
+	Details    string
+	CreatedAt  time.Time
+}
+
+// DB stores the changes made to nodes by operators
+type DB interface {
+	// Apply makes the change of the entry to the node and adds the entry to the admin log in one transaction.
+	// Reinstated nodes start over with the initial reputation of defaults.
+	Apply(ctx context.Context, entry *LogEntry, defaults overlay.NodeSelectionConfig) (*overlay.NodeDossier, error)
+	// List returns at most limit entries of the node, newest first
+	List(ctx context.Context, nodeID storj.NodeID, limit int) ([]*LogEntry, error)
+}
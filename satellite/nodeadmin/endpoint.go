@@ -0,0 +1,229 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nodeadmin
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+)
+
+// defaultLogLimit is the number of log entries returned when the request has no limit
+const defaultLogLimit = 50
+
+// Endpoint lets operators manage nodes and records every change in the admin log
+type Endpoint struct {
+	log       *zap.Logger
+	db        DB
+	overlay   *overlay.Cache
+	defaults  overlay.NodeSelectionConfig
+	operators map[storj.NodeID]bool
+}
+
+// NewEndpoint creates a new node admin endpoint, reinstated nodes start over with the initial reputation of defaults
+func NewEndpoint(log *zap.Logger, config Config, db DB, overlay *overlay.Cache, defaults overlay.NodeSelectionConfig) (*Endpoint, error) {
+	operators := map[storj.NodeID]bool{}
+	for _, s := range strings.Split(config.Operators, ",") {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+		operatorID, err := storj.NodeIDFromString(s)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		operators[operatorID] = true
+	}
+
+	return &Endpoint{
+		log:       log,
+		db:        db,
+		overlay:   overlay,
+		defaults:  defaults,
+		operators: operators,
+	}, nil
+}
+
+// QuarantineNode excludes a node from new uploads
+func (endpoint *Endpoint) QuarantineNode(ctx context.Context, req *pb.NodeAdminRequest) (_ *pb.NodeAdminResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return endpoint.change(ctx, req, ActionQuarantine)
+}
+
+// DisqualifyNode disqualifies a node permanently
+func (endpoint *Endpoint) DisqualifyNode(ctx context.Context, req *pb.NodeAdminRequest) (_ *pb.NodeAdminResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return endpoint.change(ctx, req, ActionDisqualify)
+}
+
+// ReinstateNode lifts the disqualification, suspension and quarantine of a node and resets its reputation
+func (endpoint *Endpoint) ReinstateNode(ctx context.Context, req *pb.NodeAdminRequest) (_ *pb.NodeAdminResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return endpoint.change(ctx, req, ActionReinstate)
+}
+
+// AnnotateNode adds a note about a node to the admin log
+func (endpoint *Endpoint) AnnotateNode(ctx context.Context, req *pb.NodeAdminRequest) (_ *pb.NodeAdminResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if req.Details == "" {
+		return nil, status.Error(codes.InvalidArgument, "note is required")
+	}
+	return endpoint.change(ctx, req, ActionAnnotate)
+}
+
+// authorize checks that the request was made by an operator. The endpoint is served on the private
+// address, which only operators can reach; when the request carries a peer identity it must also be
+// one of the configured operators
+func (endpoint *Endpoint) authorize(ctx context.Context) (storj.NodeID, error) {
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return storj.NodeID{}, nil
+	}
+	if !endpoint.operators[peer.ID] {
+		return storj.NodeID{}, status.Errorf(codes.PermissionDenied, "%s is not an operator", peer.ID)
+	}
+	return peer.ID, nil
+}
+
+// change applies the change to the node and records it in the admin log
+func (endpoint *Endpoint) change(ctx context.Context, req *pb.NodeAdminRequest, action Action) (_ *pb.NodeAdminResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	operatorID, err := endpoint.authorize(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// the identity of the operator takes precedence over the name in the request
+	operator := req.Operator
+	if !operatorID.IsZero() {
+		operator = operatorID.String()
+	}
+	if operator == "" {
+		return nil, status.Error(codes.InvalidArgument, "operator is required")
+	}
+
+	entry := &LogEntry{
+		NodeID:     req.NodeId,
+		Action:     action,
+		Operator:   operator,
+		OperatorID: operatorID,
+		Details:    req.Details,
+	}
+
+	node, err := endpoint.db.Apply(ctx, entry, endpoint.defaults)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	endpoint.log.Info("node changed by operator",
+		zap.Stringer("node ID", req.NodeId),
+		zap.String("action", string(action)),
+		zap.String("operator", operator),
+		zap.String("details", req.Details))
+
+	return &pb.NodeAdminResponse{
+		Disqualified: timestampProto(node.Reputation.Disqualified),
+		Suspended:    timestampProto(node.Suspended),
+		Quarantined:  timestampProto(node.Quarantined),
+	}, nil
+}
+
+// GetNodeLog returns the changes made to a node by operators, newest first
+func (endpoint *Endpoint) GetNodeLog(ctx context.Context, req *pb.GetNodeLogRequest) (_ *pb.GetNodeLogResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if _, err := endpoint.authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultLogLimit
+	}
+
+	entries, err := endpoint.db.List(ctx, req.NodeId, limit)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	response := &pb.GetNodeLogResponse{}
+	for _, entry := range entries {
+		response.Entries = append(response.Entries, &pb.NodeAdminLogEntry{
+			NodeId:     entry.NodeID,
+			Action:     string(entry.Action),
+			Operator:   entry.Operator,
+			OperatorId: entry.OperatorID,
+			Details:    entry.Details,
+			CreatedAt:  timestampProto(&entry.CreatedAt),
+		})
+	}
+	return response, nil
+}
+
+// ExportNodes returns a page of the node table
+func (endpoint *Endpoint) ExportNodes(ctx context.Context, req *pb.ExportNodesRequest) (_ *pb.ExportNodesResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if _, err := endpoint.authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	nodes, more, err := endpoint.overlay.Paginate(ctx, req.Offset, int(req.Limit))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	response := &pb.ExportNodesResponse{More: more}
+	for _, node := range nodes {
+		response.Nodes = append(response.Nodes, &pb.ExportNodesResponse_Node{
+			NodeId:             node.Id,
+			Address:            node.GetAddress().GetAddress(),
+			LastNet:            node.LastNet,
+			CountryCode:        node.CountryCode,
+			Email:              node.Operator.Email,
+			Wallet:             node.Operator.Wallet,
+			FreeBandwidth:      node.Capacity.FreeBandwidth,
+			FreeDisk:           node.Capacity.FreeDisk,
+			Version:            node.Version.Version,
+			AuditCount:         node.Reputation.AuditCount,
+			AuditSuccessCount:  node.Reputation.AuditSuccessCount,
+			UptimeCount:        node.Reputation.UptimeCount,
+			UptimeSuccessCount: node.Reputation.UptimeSuccessCount,
+			AuditReputation:    node.Reputation.AuditReputation(),
+			UptimeReputation:   node.Reputation.UptimeReputation(),
+			LastContactSuccess: timestampProto(&node.Reputation.LastContactSuccess),
+			LastContactFailure: timestampProto(&node.Reputation.LastContactFailure),
+			Disqualified:       timestampProto(node.Reputation.Disqualified),
+			Suspended:          timestampProto(node.Suspended),
+			Quarantined:        timestampProto(node.Quarantined),
+			ExitInitiatedAt:    timestampProto(node.ExitStatus.ExitInitiatedAt),
+			ExitFinishedAt:     timestampProto(node.ExitStatus.ExitFinishedAt),
+		})
+	}
+	return response, nil
+}
+
+// timestampProto converts t to a protobuf timestamp, nil and out of range times are converted to nil
+func timestampProto(t *time.Time) *timestamp.Timestamp {
+	if t == nil {
+		return nil
+	}
+	ts, err := ptypes.TimestampProto(*t)
+	if err != nil {
+		return nil
+	}
+	return ts
+}
@@ -0,0 +1,195 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nodeadmin_test
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testidentity"
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/nodeadmin"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestEndpoint(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		log := zaptest.NewLogger(t)
+		cache := overlay.NewCache(log, db.OverlayCache(), overlay.NodeSelectionConfig{})
+
+		alice, aliceID := operatorContext(ctx, t)
+		bob, bobID := operatorContext(ctx, t)
+		mallory, _ := operatorContext(ctx, t)
+
+		endpoint, err := nodeadmin.NewEndpoint(log, nodeadmin.Config{
+			Operators: aliceID.String() + ", " + bobID.String(),
+		}, db.NodeAdminLog(), cache, overlay.NodeSelectionConfig{})
+		require.NoError(t, err)
+
+		nodeID := storj.NodeID{1, 2, 3}
+		require.NoError(t, cache.Put(ctx, nodeID, pb.Node{Id: nodeID}))
+
+		// requests without an identity come from the private address and must name the operator
+		_, err = endpoint.QuarantineNode(ctx, &pb.NodeAdminRequest{NodeId: nodeID})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = endpoint.QuarantineNode(mallory, &pb.NodeAdminRequest{NodeId: nodeID})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		_, err = endpoint.GetNodeLog(mallory, &pb.GetNodeLogRequest{NodeId: nodeID})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		_, err = endpoint.AnnotateNode(alice, &pb.NodeAdminRequest{NodeId: nodeID})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		res, err := endpoint.QuarantineNode(alice, &pb.NodeAdminRequest{NodeId: nodeID, Details: "corrupted pieces"})
+		require.NoError(t, err)
+		assert.NotNil(t, res.Quarantined)
+		assert.Nil(t, res.Disqualified)
+
+		// the operator is taken from the identity, not from the request
+		res, err = endpoint.DisqualifyNode(bob, &pb.NodeAdminRequest{NodeId: nodeID, Operator: "alice"})
+		require.NoError(t, err)
+		assert.NotNil(t, res.Disqualified)
+
+		_, err = endpoint.AnnotateNode(bob, &pb.NodeAdminRequest{NodeId: nodeID, Details: "operator contacted"})
+		require.NoError(t, err)
+
+		_, err = endpoint.AnnotateNode(ctx, &pb.NodeAdminRequest{NodeId: nodeID, Operator: "carol", Details: "operator replied"})
+		require.NoError(t, err)
+
+		res, err = endpoint.ReinstateNode(alice, &pb.NodeAdminRequest{NodeId: nodeID, Details: "fixed"})
+		require.NoError(t, err)
+		assert.Nil(t, res.Quarantined)
+		assert.Nil(t, res.Disqualified)
+
+		node, err := cache.Get(ctx, nodeID)
+		require.NoError(t, err)
+		assert.False(t, node.IsQuarantined())
+		assert.False(t, node.Disqualified())
+
+		// changes of unknown nodes aren't logged
+		_, err = endpoint.QuarantineNode(alice, &pb.NodeAdminRequest{NodeId: storj.NodeID{4}})
+		require.Error(t, err)
+		unknownLog, err := endpoint.GetNodeLog(alice, &pb.GetNodeLogRequest{NodeId: storj.NodeID{4}})
+		require.NoError(t, err)
+		require.Empty(t, unknownLog.Entries)
+
+		nodeLog, err := endpoint.GetNodeLog(alice, &pb.GetNodeLogRequest{NodeId: nodeID})
+		require.NoError(t, err)
+		require.Len(t, nodeLog.Entries, 5)
+
+		expected := []struct {
+			action     nodeadmin.Action
+			operator   string
+			operatorID storj.NodeID
+			details    string
+		}{
+			{nodeadmin.ActionReinstate, aliceID.String(), aliceID, "fixed"},
+			{nodeadmin.ActionAnnotate, "carol", storj.NodeID{}, "operator replied"},
+			{nodeadmin.ActionAnnotate, bobID.String(), bobID, "operator contacted"},
+			{nodeadmin.ActionDisqualify, bobID.String(), bobID, ""},
+			{nodeadmin.ActionQuarantine, aliceID.String(), aliceID, "corrupted pieces"},
+		}
+		for i, entry := range nodeLog.Entries {
+			assert.Equal(t, nodeID, entry.NodeId)
+			assert.Equal(t, string(expected[i].action), entry.Action)
+			assert.Equal(t, expected[i].operator, entry.Operator)
+			assert.Equal(t, expected[i].operatorID, entry.OperatorId)
+			assert.Equal(t, expected[i].details, entry.Details)
+		}
+
+		nodeLog, err = endpoint.GetNodeLog(alice, &pb.GetNodeLogRequest{NodeId: nodeID, Limit: 1})
+		require.NoError(t, err)
+		require.Len(t, nodeLog.Entries, 1)
+
+		export, err := endpoint.ExportNodes(alice, &pb.ExportNodesRequest{})
+		require.NoError(t, err)
+		require.Len(t, export.Nodes, 1)
+		assert.Equal(t, nodeID, export.Nodes[0].NodeId)
+		assert.False(t, export.More)
+	})
+}
+
+func TestReinstateResetsReputation(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		config := overlay.NodeSelectionConfig{
+			AuditReputationAlpha0:  20,
+			AuditReputationLambda:  0.95,
+			AuditReputationWeight:  1,
+			AuditReputationDQ:      0.6,
+			UptimeReputationAlpha0: 100,
+			UptimeReputationLambda: 0.99,
+			UptimeReputationWeight: 1,
+		}
+
+		log := zaptest.NewLogger(t)
+		cache := overlay.NewCache(log, db.OverlayCache(), config)
+
+		alice, aliceID := operatorContext(ctx, t)
+		endpoint, err := nodeadmin.NewEndpoint(log, nodeadmin.Config{Operators: aliceID.String()}, db.NodeAdminLog(), cache, config)
+		require.NoError(t, err)
+
+		nodeID := storj.NodeID{1, 2, 3}
+		require.NoError(t, cache.Put(ctx, nodeID, pb.Node{Id: nodeID}))
+
+		// the node fails audits until it's disqualified
+		for i := 0; ; i++ {
+			require.True(t, i < 100, "node wasn't disqualified")
+			stats, err := cache.UpdateStats(ctx, &overlay.UpdateRequest{NodeID: nodeID, IsUp: true})
+			require.NoError(t, err)
+			if stats.Disqualified != nil {
+				break
+			}
+		}
+
+		res, err := endpoint.ReinstateNode(alice, &pb.NodeAdminRequest{NodeId: nodeID, Details: "false positive"})
+		require.NoError(t, err)
+		require.Nil(t, res.Disqualified)
+
+		// the reinstated node survives its next failed audit
+		stats, err := cache.UpdateStats(ctx, &overlay.UpdateRequest{NodeID: nodeID, IsUp: true})
+		require.NoError(t, err)
+		assert.Nil(t, stats.Disqualified)
+		assert.Equal(t, 19.0, stats.AuditReputationAlpha)
+		assert.Equal(t, 1.0, stats.AuditReputationBeta)
+	})
+}
+
+// operatorContext returns a context authenticated with a new identity
+func operatorContext(ctx context.Context, t *testing.T) (context.Context, storj.NodeID) {
+	ident, err := testidentity.NewTestIdentity(ctx)
+	require.NoError(t, err)
+
+	grpcPeer := &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.ParseIP("1.2.3.4"), Port: 5},
+		AuthInfo: credentials.TLSInfo{
+			State: tls.ConnectionState{
+				PeerCertificates: []*x509.Certificate{ident.Leaf, ident.CA},
+			},
+		},
+	}
+	return peer.NewContext(ctx, grpcPeer), ident.ID
+}
@@ -546,6 +546,67 @@ func (service *Service) CreatePutRepairOrderLimits(ctx context.Context, repairer
 	return limits, nil
 }
 
+// CreateGracefulExitPutOrderLimit creates an order limit for the exiting node to upload its piece pieceNum of pointer to newNode.
+func (service *Service) CreateGracefulExitPutOrderLimit(ctx context.Context, exiting *identity.PeerIdentity, bucketID []byte, pointer *pb.Pointer, pieceNum int32, newNode *pb.Node) (_ *pb.AddressedOrderLimit, err error) {
+	rootPieceID := pointer.GetRemote().RootPieceId
+	redundancy, err := eestream.NewRedundancyStrategyFromProto(pointer.GetRemote().GetRedundancy())
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	pieceSize := eestream.CalcPieceSize(pointer.GetSegmentSize(), redundancy)
+
+	// convert orderExpiration from duration to timestamp
+	orderExpirationTime := time.Now().UTC().Add(service.orderExpiration)
+	orderExpiration, err := ptypes.TimestampProto(orderExpirationTime)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	serialNumber, err := service.createSerial(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	newNode.Type.DPanicOnInvalid("order service graceful exit put order limit")
+
+	orderLimit, err := signing.SignOrderLimit(service.satellite, &pb.OrderLimit2{
+		SerialNumber:    serialNumber,
+		SatelliteId:     service.satellite.ID(),
+		UplinkId:        exiting.ID,
+		StorageNodeId:   newNode.Id,
+		PieceId:         rootPieceID.Derive(newNode.Id),
+		Action:          pb.PieceAction_PUT_REPAIR,
+		Limit:           pieceSize,
+		PieceExpiration: pointer.ExpirationDate,
+		OrderExpiration: orderExpiration,
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	limit := &pb.AddressedOrderLimit{
+		Limit:              orderLimit,
+		StorageNodeAddress: newNode.Address,
+	}
+
+	err = service.saveSerial(ctx, serialNumber, bucketID, orderExpirationTime)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	// the replacement node settles the orders signed by the exiting node
+	err = service.certdb.SavePublicKey(ctx, exiting.ID, exiting.Leaf.PublicKey)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if err := service.updateBandwidth(ctx, bucketID, []*pb.AddressedOrderLimit{limit}); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return limit, nil
+}
+
 // UpdateGetInlineOrder updates amount of inline GET bandwidth for given bucket
 func (service *Service) UpdateGetInlineOrder(ctx context.Context, bucketID []byte, amount int64) (err error) {
 	now := time.Now().UTC()
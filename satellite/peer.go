@@ -46,10 +46,15 @@ import (
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/consoleauth"
 	"storj.io/storj/satellite/console/consoleweb"
+	"storj.io/storj/satellite/contact"
+	"storj.io/storj/satellite/downtime"
+	"storj.io/storj/satellite/gc"
+	"storj.io/storj/satellite/gracefulexit"
 	"storj.io/storj/satellite/inspector"
 	"storj.io/storj/satellite/mailservice"
 	"storj.io/storj/satellite/mailservice/simulate"
 	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/satellite/nodeadmin"
 	"storj.io/storj/satellite/orders"
 	"storj.io/storj/storage"
 	"storj.io/storj/storage/boltdb"
@@ -84,6 +89,12 @@ type DB interface {
 	Console() console.DB
 	// Orders returns database for orders
 	Orders() orders.DB
+	// BucketPlacements returns database for the placement constraints of buckets
+	BucketPlacements() overlay.BucketPlacements
+	// GracefulExit returns database for graceful exit
+	GracefulExit() gracefulexit.DB
+	// NodeAdminLog returns database for the changes made to nodes by operators
+	NodeAdminLog() nodeadmin.DB
 }
 
 // Config is the global config satellite
@@ -97,12 +108,19 @@ type Config struct {
 	Overlay   overlay.Config
 	Discovery discovery.Config
 
-	PointerDB   pointerdb.Config
-	BwAgreement bwagreement.Config // TODO: decide whether to keep empty configs for consistency
+	PointerDB    pointerdb.Config
+	MetainfoLoop metainfo.LoopConfig
+	BwAgreement  bwagreement.Config // TODO: decide whether to keep empty configs for consistency
 
-	Checker  checker.Config
-	Repairer repairer.Config
-	Audit    audit.Config
+	Checker     checker.Config
+	Repairer    repairer.Config
+	Irreparable irreparable.Config
+	Audit       audit.Config
+
+	GarbageCollection gc.Config
+	GracefulExit      gracefulexit.Config
+	Downtime          downtime.Config
+	NodeAdmin         nodeadmin.Config
 
 	Tally  tally.Config
 	Rollup rollup.Config
@@ -145,10 +163,15 @@ type Peer struct {
 		Service *discovery.Discovery
 	}
 
+	Contact struct {
+		Endpoint *contact.Endpoint
+	}
+
 	Metainfo struct {
 		Database  storage.KeyValueStore // TODO: move into pointerDB
 		Service   *pointerdb.Service
 		Endpoint2 *metainfo.Endpoint
+		Loop      *metainfo.Loop
 	}
 
 	Inspector struct {
@@ -165,14 +188,32 @@ type Peer struct {
 	}
 
 	Repair struct {
-		Checker   *checker.Checker
-		Repairer  *repairer.Service
-		Inspector *irreparable.Inspector
+		Checker     *checker.Checker
+		Repairer    *repairer.Service
+		Irreparable *irreparable.Service
+		Inspector   *irreparable.Inspector
 	}
 	Audit struct {
 		Service *audit.Service
 	}
 
+	GarbageCollection struct {
+		Service *gc.Service
+	}
+
+	GracefulExit struct {
+		Chore    *gracefulexit.Chore
+		Endpoint *gracefulexit.Endpoint
+	}
+
+	NodeAdmin struct {
+		Endpoint *nodeadmin.Endpoint
+	}
+
+	Downtime struct {
+		Chore *downtime.Chore
+	}
+
 	Accounting struct {
 		Tally  *tally.Service
 		Rollup *rollup.Service
@@ -229,6 +270,13 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config, ve
 		config := config.Overlay
 
 		peer.Overlay.Service = overlay.NewCache(peer.Log.Named("overlay"), peer.DB.OverlayCache(), config.Node)
+		if config.GeoIPDatabase != "" {
+			geoip, err := overlay.LoadGeoIP(config.GeoIPDatabase)
+			if err != nil {
+				return nil, errs.Combine(err, peer.Close())
+			}
+			peer.Overlay.Service.SetGeoIP(geoip)
+		}
 		peer.Transport = peer.Transport.WithObservers(peer.Overlay.Service)
 
 		peer.Overlay.Inspector = overlay.NewInspector(peer.Overlay.Service)
@@ -302,6 +350,12 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config, ve
 		peer.Discovery.Service = discovery.New(peer.Log.Named("discovery"), peer.Overlay.Service, peer.Kademlia.Service, config)
 	}
 
+	{ // setup contact
+		log.Debug("Setting up contact")
+		peer.Contact.Endpoint = contact.NewEndpoint(peer.Log.Named("contact:endpoint"), peer.Overlay.Service, peer.Kademlia.Service)
+		pb.RegisterNodeServer(peer.Server.GRPC(), peer.Contact.Endpoint)
+	}
+
 	{ // setup orders
 		log.Debug("Setting up orders")
 		satelliteSignee := signing.SigneeFromPeerIdentity(peer.Identity.PeerIdentity())
@@ -338,11 +392,14 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config, ve
 			peer.Orders.Service,
 			peer.Overlay.Service,
 			peer.DB.Console().APIKeys(),
+			peer.DB.BucketPlacements(),
 			peer.DB.Accounting(),
 			config.Rollup.MaxAlphaUsage,
 		)
 
 		pb.RegisterMetainfoServer(peer.Server.GRPC(), peer.Metainfo.Endpoint2)
+
+		peer.Metainfo.Loop = metainfo.NewLoop(config.MetainfoLoop, peer.Metainfo.Service)
 	}
 
 	{ // setup agreements
@@ -356,7 +413,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config, ve
 		log.Debug("Setting up datarepair")
 		// TODO: simplify argument list somehow
 		peer.Repair.Checker = checker.NewChecker(
-			peer.Metainfo.Service,
+			peer.Metainfo.Loop,
 			peer.DB.RepairQueue(),
 			peer.Overlay.Service, peer.DB.Irreparable(),
 			0, peer.Log.Named("checker"),
@@ -371,10 +428,8 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config, ve
 			peer.Metainfo.Service,
 			peer.Orders.Service,
 			peer.Overlay.Service,
+			peer.DB.BucketPlacements(),
 		)
-
-		peer.Repair.Inspector = irreparable.NewInspector(peer.DB.Irreparable())
-		pb.RegisterIrreparableInspectorServer(peer.Server.PrivateGRPC(), peer.Repair.Inspector)
 	}
 
 	{ // setup audit
@@ -384,6 +439,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config, ve
 		peer.Audit.Service, err = audit.NewService(peer.Log.Named("audit"),
 			config,
 			peer.Metainfo.Service,
+			peer.Metainfo.Loop,
 			peer.Orders.Service,
 			peer.Transport,
 			peer.Overlay.Service,
@@ -394,9 +450,68 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config, ve
 		}
 	}
 
+	{ // setup garbage collection
+		log.Debug("Setting up garbage collection")
+		peer.GarbageCollection.Service = gc.NewService(
+			peer.Log.Named("garbage collection"),
+			config.GarbageCollection,
+			peer.Transport,
+			peer.Overlay.Service,
+			peer.Metainfo.Loop,
+		)
+	}
+
+	{ // setup graceful exit
+		log.Debug("Setting up graceful exit")
+		config := config.GracefulExit
+
+		peer.GracefulExit.Chore = gracefulexit.NewChore(
+			peer.Log.Named("graceful exit chore"),
+			config,
+			peer.DB.GracefulExit(),
+			peer.Overlay.Service,
+			peer.Metainfo.Loop,
+		)
+		peer.GracefulExit.Endpoint = gracefulexit.NewEndpoint(
+			peer.Log.Named("graceful exit:endpoint"),
+			config,
+			peer.DB.GracefulExit(),
+			peer.Overlay.Service,
+			peer.Kademlia.Service,
+			peer.Metainfo.Service,
+			peer.Orders.Service,
+			peer.DB.BucketPlacements(),
+		)
+		pb.RegisterSatelliteGracefulExitServer(peer.Server.GRPC(), peer.GracefulExit.Endpoint)
+	}
+
+	{ // setup node admin
+		log.Debug("Setting up node admin")
+		peer.NodeAdmin.Endpoint, err = nodeadmin.NewEndpoint(
+			peer.Log.Named("node admin"),
+			config.NodeAdmin,
+			peer.DB.NodeAdminLog(),
+			peer.Overlay.Service,
+			config.Overlay.Node,
+		)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+		pb.RegisterOverlayAdminServer(peer.Server.PrivateGRPC(), peer.NodeAdmin.Endpoint)
+	}
+
+	{ // setup downtime tracking
+		log.Debug("Setting up downtime tracking")
+		peer.Downtime.Chore = downtime.NewChore(
+			peer.Log.Named("downtime chore"),
+			config.Downtime,
+			peer.Overlay.Service,
+		)
+	}
+
 	{ // setup accounting
 		log.Debug("Setting up accounting")
-		peer.Accounting.Tally = tally.New(peer.Log.Named("tally"), peer.DB.Accounting(), peer.Metainfo.Service, peer.Overlay.Service, 0, config.Tally.Interval)
+		peer.Accounting.Tally = tally.New(peer.Log.Named("tally"), peer.DB.Accounting(), peer.Metainfo.Loop, peer.Overlay.Service, 0, config.Tally.Interval)
 		peer.Accounting.Rollup = rollup.New(peer.Log.Named("rollup"), peer.DB.Accounting(), config.Rollup.Interval)
 	}
 
@@ -479,6 +594,24 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config, ve
 		}
 	}
 
+	{ // setup irreparable
+		log.Debug("Setting up irreparable")
+		peer.Repair.Irreparable = irreparable.NewService(
+			peer.Log.Named("irreparable"),
+			config.Irreparable,
+			peer.DB.Irreparable(),
+			peer.Metainfo.Service,
+			peer.Overlay.Service,
+			peer.DB.RepairQueue(),
+			peer.DB.Console(),
+			peer.Mail.Service,
+		)
+		peer.Overlay.Service.AddReturnObserver(peer.Repair.Irreparable)
+
+		peer.Repair.Inspector = irreparable.NewInspector(peer.DB.Irreparable(), peer.Repair.Irreparable)
+		pb.RegisterIrreparableInspectorServer(peer.Server.PrivateGRPC(), peer.Repair.Inspector)
+	}
+
 	{ // setup console
 		log.Debug("Setting up console")
 		consoleConfig := config.Console
@@ -525,15 +658,24 @@ func (peer *Peer) Run(ctx context.Context) error {
 	group.Go(func() error {
 		return errs2.IgnoreCanceled(peer.Kademlia.Service.Run(ctx))
 	})
+	group.Go(func() error {
+		return errs2.IgnoreCanceled(peer.Overlay.Service.Run(ctx))
+	})
 	group.Go(func() error {
 		return errs2.IgnoreCanceled(peer.Discovery.Service.Run(ctx))
 	})
+	group.Go(func() error {
+		return errs2.IgnoreCanceled(peer.Metainfo.Loop.Run(ctx))
+	})
 	group.Go(func() error {
 		return errs2.IgnoreCanceled(peer.Repair.Checker.Run(ctx))
 	})
 	group.Go(func() error {
 		return errs2.IgnoreCanceled(peer.Repair.Repairer.Run(ctx))
 	})
+	group.Go(func() error {
+		return errs2.IgnoreCanceled(peer.Repair.Irreparable.Run(ctx))
+	})
 	group.Go(func() error {
 		return errs2.IgnoreCanceled(peer.Accounting.Tally.Run(ctx))
 	})
@@ -543,6 +685,15 @@ func (peer *Peer) Run(ctx context.Context) error {
 	group.Go(func() error {
 		return errs2.IgnoreCanceled(peer.Audit.Service.Run(ctx))
 	})
+	group.Go(func() error {
+		return errs2.IgnoreCanceled(peer.GarbageCollection.Service.Run(ctx))
+	})
+	group.Go(func() error {
+		return errs2.IgnoreCanceled(peer.GracefulExit.Chore.Run(ctx))
+	})
+	group.Go(func() error {
+		return errs2.IgnoreCanceled(peer.Downtime.Chore.Run(ctx))
+	})
 	group.Go(func() error {
 		// TODO: move the message into Server instead
 		// Don't change the format of this comment, it is used to figure out the node id.
@@ -578,6 +729,18 @@ func (peer *Peer) Close() error {
 	}
 
 	// close services in reverse initialization order
+	if peer.Downtime.Chore != nil {
+		errlist.Add(peer.Downtime.Chore.Close())
+	}
+	if peer.GracefulExit.Chore != nil {
+		errlist.Add(peer.GracefulExit.Chore.Close())
+	}
+	if peer.GarbageCollection.Service != nil {
+		errlist.Add(peer.GarbageCollection.Service.Close())
+	}
+	if peer.Repair.Irreparable != nil {
+		errlist.Add(peer.Repair.Irreparable.Close())
+	}
 	if peer.Repair.Repairer != nil {
 		errlist.Add(peer.Repair.Repairer.Close())
 	}
@@ -589,6 +752,9 @@ func (peer *Peer) Close() error {
 		errlist.Add(peer.Agreements.Endpoint.Close())
 	}
 
+	if peer.Metainfo.Loop != nil {
+		errlist.Add(peer.Metainfo.Loop.Close())
+	}
 	if peer.Metainfo.Database != nil {
 		errlist.Add(peer.Metainfo.Database.Close())
 	}
@@ -0,0 +1,51 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+
+	"storj.io/storj/pkg/overlay"
+	dbx "storj.io/storj/satellite/satellitedb/dbx"
+)
+
+type bucketPlacements struct {
+	db *dbx.DB
+}
+
+// SetBucketPlacement sets the placement constraint of a bucket, an empty placement removes the constraint
+func (db *bucketPlacements) SetBucketPlacement(ctx context.Context, projectID uuid.UUID, bucket []byte, placement overlay.Placement) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return Error.Wrap(db.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		_, err := tx.Tx.ExecContext(ctx, db.db.Rebind(`DELETE FROM bucket_placements WHERE project_id = ? AND bucket_name = ?`), projectID[:], bucket)
+		if err != nil || placement == "" {
+			return err
+		}
+
+		_, err = tx.Tx.ExecContext(ctx, db.db.Rebind(`
+			INSERT INTO bucket_placements ( project_id, bucket_name, placement, created_at ) VALUES ( ?, ?, ?, ? )`),
+			projectID[:], bucket, string(placement), time.Now().UTC())
+		return err
+	}))
+}
+
+// GetBucketPlacement returns the placement constraint of a bucket, an empty placement when it has none
+func (db *bucketPlacements) GetBucketPlacement(ctx context.Context, projectID uuid.UUID, bucket []byte) (_ overlay.Placement, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var placement string
+	err = db.db.QueryRowContext(ctx, db.db.Rebind(`SELECT placement FROM bucket_placements WHERE project_id = ? AND bucket_name = ?`), projectID[:], bucket).Scan(&placement)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+	return overlay.Placement(placement), nil
+}
@@ -17,6 +17,8 @@ import (
 	"storj.io/storj/pkg/overlay"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/gracefulexit"
+	"storj.io/storj/satellite/nodeadmin"
 	"storj.io/storj/satellite/orders"
 	dbx "storj.io/storj/satellite/satellitedb/dbx"
 )
@@ -132,6 +134,21 @@ func (db *DB) Console() console.DB {
 	}
 }
 
+// BucketPlacements returns database for storing the placement constraints of buckets
+func (db *DB) BucketPlacements() overlay.BucketPlacements {
+	return &bucketPlacements{db: db.db}
+}
+
+// GracefulExit returns database for storing the progress of graceful exits
+func (db *DB) GracefulExit() gracefulexit.DB {
+	return &gracefulexitDB{db: db.db}
+}
+
+// NodeAdminLog returns database for the changes made to nodes by operators
+func (db *DB) NodeAdminLog() nodeadmin.DB {
+	return &nodeAdminLogs{db: db.db}
+}
+
 // Orders returns database for storing orders
 func (db *DB) Orders() orders.DB {
 	return &ordersDB{db: db.db}
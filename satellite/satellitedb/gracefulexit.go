@@ -0,0 +1,157 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite/gracefulexit"
+	dbx "storj.io/storj/satellite/satellitedb/dbx"
+)
+
+type gracefulexitDB struct {
+	db *dbx.DB
+}
+
+// IncrementProgress adds the transferred bytes and pieces to the progress of the node
+func (db *gracefulexitDB) IncrementProgress(ctx context.Context, nodeID storj.NodeID, bytes, piecesTransferred, piecesFailed int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, db.db.Rebind(
+		`INSERT INTO graceful_exit_progress (node_id, bytes_transferred, pieces_transferred, pieces_failed, updated_at)
+		VALUES (?, ?, ?, ?, ?)
+		ON CONFLICT(node_id)
+		DO UPDATE SET bytes_transferred = graceful_exit_progress.bytes_transferred + excluded.bytes_transferred,
+			pieces_transferred = graceful_exit_progress.pieces_transferred + excluded.pieces_transferred,
+			pieces_failed = graceful_exit_progress.pieces_failed + excluded.pieces_failed,
+			updated_at = excluded.updated_at`),
+		nodeID.Bytes(), bytes, piecesTransferred, piecesFailed, time.Now().UTC())
+	return Error.Wrap(err)
+}
+
+// GetProgress returns the progress of the node, a zero progress when nothing has been transferred yet
+func (db *gracefulexitDB) GetProgress(ctx context.Context, nodeID storj.NodeID) (_ *gracefulexit.Progress, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	progress := &gracefulexit.Progress{NodeID: nodeID}
+	err = db.db.QueryRowContext(ctx, db.db.Rebind(
+		`SELECT bytes_transferred, pieces_transferred, pieces_failed, updated_at
+		FROM graceful_exit_progress WHERE node_id = ?`), nodeID.Bytes()).
+		Scan(&progress.BytesTransferred, &progress.PiecesTransferred, &progress.PiecesFailed, &progress.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return progress, nil
+	}
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return progress, nil
+}
+
+// Enqueue adds items to the transfer queue, items that are already queued are ignored
+func (db *gracefulexitDB) Enqueue(ctx context.Context, items []gracefulexit.TransferQueueItem) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := time.Now().UTC()
+	return Error.Wrap(db.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		for _, item := range items {
+			_, err := tx.Tx.ExecContext(ctx, db.db.Rebind(
+				`INSERT INTO graceful_exit_transfer_queue (node_id, path, piece_num, queued_at, failed_count)
+				VALUES (?, ?, ?, ?, 0)
+				ON CONFLICT DO NOTHING`),
+				item.NodeID.Bytes(), item.Path, item.PieceNum, now)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+}
+
+// GetTransferQueueItem returns a single queued item
+func (db *gracefulexitDB) GetTransferQueueItem(ctx context.Context, nodeID storj.NodeID, path []byte, pieceNum int32) (_ *gracefulexit.TransferQueueItem, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	item := &gracefulexit.TransferQueueItem{NodeID: nodeID, Path: path, PieceNum: pieceNum}
+	err = db.db.QueryRowContext(ctx, db.db.Rebind(
+		`SELECT queued_at, failed_count, last_failed_at
+		FROM graceful_exit_transfer_queue WHERE node_id = ? AND path = ? AND piece_num = ?`),
+		nodeID.Bytes(), path, pieceNum).Scan(&item.QueuedAt, &item.FailedCount, &item.LastFailedAt)
+	if err == sql.ErrNoRows {
+		return nil, Error.New("transfer queue item not found")
+	}
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return item, nil
+}
+
+// GetIncomplete returns at most limit queued items of the node that have failed fewer than maxFailures times
+func (db *gracefulexitDB) GetIncomplete(ctx context.Context, nodeID storj.NodeID, maxFailures int, limit int) (items []*gracefulexit.TransferQueueItem, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.QueryContext(ctx, db.db.Rebind(
+		`SELECT path, piece_num, queued_at, failed_count, last_failed_at
+		FROM graceful_exit_transfer_queue
+		WHERE node_id = ? AND failed_count < ?
+		ORDER BY queued_at, path, piece_num
+		LIMIT ?`), nodeID.Bytes(), maxFailures, limit)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		item := &gracefulexit.TransferQueueItem{NodeID: nodeID}
+		err = rows.Scan(&item.Path, &item.PieceNum, &item.QueuedAt, &item.FailedCount, &item.LastFailedAt)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		items = append(items, item)
+	}
+	return items, Error.Wrap(rows.Err())
+}
+
+// IncrementFailures records a failed transfer of the item and returns the new failure count
+func (db *gracefulexitDB) IncrementFailures(ctx context.Context, nodeID storj.NodeID, path []byte, pieceNum int32) (failedCount int, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = db.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		_, err := tx.Tx.ExecContext(ctx, db.db.Rebind(
+			`UPDATE graceful_exit_transfer_queue SET failed_count = failed_count + 1, last_failed_at = ?
+			WHERE node_id = ? AND path = ? AND piece_num = ?`),
+			time.Now().UTC(), nodeID.Bytes(), path, pieceNum)
+		if err != nil {
+			return err
+		}
+
+		return tx.Tx.QueryRowContext(ctx, db.db.Rebind(
+			`SELECT failed_count FROM graceful_exit_transfer_queue WHERE node_id = ? AND path = ? AND piece_num = ?`),
+			nodeID.Bytes(), path, pieceNum).Scan(&failedCount)
+	})
+	return failedCount, Error.Wrap(err)
+}
+
+// DeleteTransferQueueItem removes a single item from the queue
+func (db *gracefulexitDB) DeleteTransferQueueItem(ctx context.Context, nodeID storj.NodeID, path []byte, pieceNum int32) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, db.db.Rebind(
+		`DELETE FROM graceful_exit_transfer_queue WHERE node_id = ? AND path = ? AND piece_num = ?`),
+		nodeID.Bytes(), path, pieceNum)
+	return Error.Wrap(err)
+}
+
+// DeleteTransferQueueItems removes all queued items of the node
+func (db *gracefulexitDB) DeleteTransferQueueItems(ctx context.Context, nodeID storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, db.db.Rebind(
+		`DELETE FROM graceful_exit_transfer_queue WHERE node_id = ?`), nodeID.Bytes())
+	return Error.Wrap(err)
+}
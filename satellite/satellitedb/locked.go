@@ -23,6 +23,8 @@ import (
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/gracefulexit"
+	"storj.io/storj/satellite/nodeadmin"
 	"storj.io/storj/satellite/orders"
 )
 
@@ -182,6 +184,33 @@ func (m *lockedBandwidthAgreement) SaveOrder(ctx context.Context, a1 *pb.RenterB
 	return m.db.SaveOrder(ctx, a1)
 }
 
+// BucketPlacements returns database for the placement constraints of buckets
+func (m *locked) BucketPlacements() overlay.BucketPlacements {
+	m.Lock()
+	defer m.Unlock()
+	return &lockedBucketPlacements{m.Locker, m.db.BucketPlacements()}
+}
+
+// lockedBucketPlacements implements locking wrapper for overlay.BucketPlacements
+type lockedBucketPlacements struct {
+	sync.Locker
+	db overlay.BucketPlacements
+}
+
+// GetBucketPlacement returns the placement constraint of a bucket, an empty placement when it has none
+func (m *lockedBucketPlacements) GetBucketPlacement(ctx context.Context, projectID uuid.UUID, bucket []byte) (overlay.Placement, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetBucketPlacement(ctx, projectID, bucket)
+}
+
+// SetBucketPlacement sets the placement constraint of a bucket, an empty placement removes the constraint
+func (m *lockedBucketPlacements) SetBucketPlacement(ctx context.Context, projectID uuid.UUID, bucket []byte, placement overlay.Placement) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.SetBucketPlacement(ctx, projectID, bucket, placement)
+}
+
 // CertDB returns database for storing uplink's public key & ID
 func (m *locked) CertDB() certdb.DB {
 	m.Lock()
@@ -552,6 +581,75 @@ func (m *locked) DropSchema(schema string) error {
 	return m.db.DropSchema(schema)
 }
 
+// GracefulExit returns database for graceful exit
+func (m *locked) GracefulExit() gracefulexit.DB {
+	m.Lock()
+	defer m.Unlock()
+	return &lockedGracefulExit{m.Locker, m.db.GracefulExit()}
+}
+
+// lockedGracefulExit implements locking wrapper for gracefulexit.DB
+type lockedGracefulExit struct {
+	sync.Locker
+	db gracefulexit.DB
+}
+
+// DeleteTransferQueueItem removes a single item from the queue
+func (m *lockedGracefulExit) DeleteTransferQueueItem(ctx context.Context, nodeID storj.NodeID, path []byte, pieceNum int32) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.DeleteTransferQueueItem(ctx, nodeID, path, pieceNum)
+}
+
+// DeleteTransferQueueItems removes all queued items of the node
+func (m *lockedGracefulExit) DeleteTransferQueueItems(ctx context.Context, nodeID storj.NodeID) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.DeleteTransferQueueItems(ctx, nodeID)
+}
+
+// Enqueue adds items to the transfer queue, items that are already queued are ignored
+func (m *lockedGracefulExit) Enqueue(ctx context.Context, items []gracefulexit.TransferQueueItem) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Enqueue(ctx, items)
+}
+
+// GetIncomplete returns at most limit queued items of the node that have failed fewer than maxFailures times
+func (m *lockedGracefulExit) GetIncomplete(ctx context.Context, nodeID storj.NodeID, maxFailures int, limit int) ([]*gracefulexit.TransferQueueItem, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetIncomplete(ctx, nodeID, maxFailures, limit)
+}
+
+// GetProgress returns the progress of the node, a zero progress when nothing has been transferred yet
+func (m *lockedGracefulExit) GetProgress(ctx context.Context, nodeID storj.NodeID) (*gracefulexit.Progress, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetProgress(ctx, nodeID)
+}
+
+// GetTransferQueueItem returns a single queued item
+func (m *lockedGracefulExit) GetTransferQueueItem(ctx context.Context, nodeID storj.NodeID, path []byte, pieceNum int32) (*gracefulexit.TransferQueueItem, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetTransferQueueItem(ctx, nodeID, path, pieceNum)
+}
+
+// IncrementFailures records a failed transfer of the item and returns the new failure count
+func (m *lockedGracefulExit) IncrementFailures(ctx context.Context, nodeID storj.NodeID, path []byte, pieceNum int32) (failedCount int, err error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.IncrementFailures(ctx, nodeID, path, pieceNum)
+}
+
+// IncrementProgress adds the transferred bytes and pieces to the progress of the node
+func (m *lockedGracefulExit) IncrementProgress(ctx context.Context, nodeID storj.NodeID, bytes int64, piecesTransferred int64, piecesFailed int64) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.IncrementProgress(ctx, nodeID, bytes, piecesTransferred, piecesFailed)
+}
+
 // Irreparable returns database for failed repairs
 func (m *locked) Irreparable() irreparable.DB {
 	m.Lock()
@@ -593,6 +691,34 @@ func (m *lockedIrreparable) IncrementRepairAttempts(ctx context.Context, segment
 	return m.db.IncrementRepairAttempts(ctx, segmentInfo)
 }
 
+// NodeAdminLog returns database for the changes made to nodes by operators
+func (m *locked) NodeAdminLog() nodeadmin.DB {
+	m.Lock()
+	defer m.Unlock()
+	return &lockedNodeAdminLog{m.Locker, m.db.NodeAdminLog()}
+}
+
+// lockedNodeAdminLog implements locking wrapper for nodeadmin.DB
+type lockedNodeAdminLog struct {
+	sync.Locker
+	db nodeadmin.DB
+}
+
+// List returns at most limit entries of the node, newest first
+func (m *lockedNodeAdminLog) List(ctx context.Context, nodeID storj.NodeID, limit int) ([]*nodeadmin.LogEntry, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.List(ctx, nodeID, limit)
+}
+
+// Apply makes the change of the entry to the node and adds the entry to the admin log in one transaction.
+// Reinstated nodes start over with the initial reputation of defaults.
+func (m *lockedNodeAdminLog) Apply(ctx context.Context, entry *nodeadmin.LogEntry, defaults overlay.NodeSelectionConfig) (*overlay.NodeDossier, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Apply(ctx, entry, defaults)
+}
+
 // Orders returns database for orders
 func (m *locked) Orders() orders.DB {
 	m.Lock()
@@ -696,11 +822,25 @@ func (m *lockedOverlayCache) CreateStats(ctx context.Context, nodeID storj.NodeI
 	return m.db.CreateStats(ctx, nodeID, initial)
 }
 
-// FindInvalidNodes finds a subset of storagenodes that have stats below provided reputation requirements.
-func (m *lockedOverlayCache) FindInvalidNodes(ctx context.Context, nodeIDs storj.NodeIDList, maxStats *overlay.NodeStats) (invalid storj.NodeIDList, err error) {
+// DeleteContactAttemptsBefore deletes the contact attempts older than before.
+func (m *lockedOverlayCache) DeleteContactAttemptsBefore(ctx context.Context, before time.Time) (deleted int64, err error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.DeleteContactAttemptsBefore(ctx, before)
+}
+
+// DisqualifyNode disqualifies the node permanently.
+func (m *lockedOverlayCache) DisqualifyNode(ctx context.Context, nodeID storj.NodeID) (stats *overlay.NodeDossier, err error) {
 	m.Lock()
 	defer m.Unlock()
-	return m.db.FindInvalidNodes(ctx, nodeIDs, maxStats)
+	return m.db.DisqualifyNode(ctx, nodeID)
+}
+
+// FindInvalidNodes finds a subset of storagenodes that are disqualified or have a reputation below the criteria.
+func (m *lockedOverlayCache) FindInvalidNodes(ctx context.Context, nodeIDs storj.NodeIDList, criteria *overlay.NodeCriteria) (invalid storj.NodeIDList, err error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.FindInvalidNodes(ctx, nodeIDs, criteria)
 }
 
 // Get looks up the node by nodeID
@@ -717,6 +857,21 @@ func (m *lockedOverlayCache) GetAll(ctx context.Context, nodeIDs storj.NodeIDLis
 	return m.db.GetAll(ctx, nodeIDs)
 }
 
+// GetContactAttempts returns the contact attempts of the nodes since the given time, sorted by time.
+// The last attempt before since is included to know whether the node was offline at that time.
+func (m *lockedOverlayCache) GetContactAttempts(ctx context.Context, nodeIDs storj.NodeIDList, since time.Time) (attempts map[storj.NodeID][]overlay.ContactAttempt, err error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetContactAttempts(ctx, nodeIDs, since)
+}
+
+// GetExitingNodes returns the exit status of nodes that have initiated a graceful exit, but have not finished it.
+func (m *lockedOverlayCache) GetExitingNodes(ctx context.Context) (exiting []*overlay.ExitStatus, err error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetExitingNodes(ctx)
+}
+
 // List lists nodes starting from cursor
 func (m *lockedOverlayCache) List(ctx context.Context, cursor storj.NodeID, limit int) ([]*overlay.NodeDossier, error) {
 	m.Lock()
@@ -731,6 +886,13 @@ func (m *lockedOverlayCache) Paginate(ctx context.Context, offset int64, limit i
 	return m.db.Paginate(ctx, offset, limit)
 }
 
+// SelectAllStorageNodesUpload returns all nodes that are eligible for uploads, separated into reputable and new nodes
+func (m *lockedOverlayCache) SelectAllStorageNodesUpload(ctx context.Context, preferences overlay.NodeSelectionConfig) (reputable []*overlay.SelectedNode, new []*overlay.SelectedNode, err error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.SelectAllStorageNodesUpload(ctx, preferences)
+}
+
 // SelectNewStorageNodes looks up nodes based on new node criteria
 func (m *lockedOverlayCache) SelectNewStorageNodes(ctx context.Context, count int, criteria *overlay.NewNodeCriteria) ([]*pb.Node, error) {
 	m.Lock()
@@ -745,11 +907,19 @@ func (m *lockedOverlayCache) SelectStorageNodes(ctx context.Context, count int,
 	return m.db.SelectStorageNodes(ctx, count, criteria)
 }
 
-// Update updates node information
-func (m *lockedOverlayCache) Update(ctx context.Context, value *pb.Node) error {
+// Update updates node information, lastNet is the network the node was last seen in
+// and defaults contains the initial reputation values for new nodes
+func (m *lockedOverlayCache) Update(ctx context.Context, value *pb.Node, lastNet, countryCode string, defaults overlay.NodeSelectionConfig) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Update(ctx, value, lastNet, countryCode, defaults)
+}
+
+// UpdateExitStatus updates the graceful exit status of a node.
+func (m *lockedOverlayCache) UpdateExitStatus(ctx context.Context, request *overlay.ExitStatusRequest) (stats *overlay.NodeDossier, err error) {
 	m.Lock()
 	defer m.Unlock()
-	return m.db.Update(ctx, value)
+	return m.db.UpdateExitStatus(ctx, request)
 }
 
 // UpdateOperator updates the email and wallet for a given node ID for satellite payments.
@@ -766,11 +936,18 @@ func (m *lockedOverlayCache) UpdateStats(ctx context.Context, request *overlay.U
 	return m.db.UpdateStats(ctx, request)
 }
 
+// UpdateSuspended suspends the node from new uploads since suspended, nil unsuspends the node.
+func (m *lockedOverlayCache) UpdateSuspended(ctx context.Context, nodeID storj.NodeID, suspended *time.Time) (stats *overlay.NodeDossier, err error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.UpdateSuspended(ctx, nodeID, suspended)
+}
+
 // UpdateUptime updates a single storagenode's uptime stats.
-func (m *lockedOverlayCache) UpdateUptime(ctx context.Context, nodeID storj.NodeID, isUp bool) (stats *overlay.NodeStats, err error) {
+func (m *lockedOverlayCache) UpdateUptime(ctx context.Context, nodeID storj.NodeID, isUp bool, lambda, weight float64) (stats *overlay.NodeStats, err error) {
 	m.Lock()
 	defer m.Unlock()
-	return m.db.UpdateUptime(ctx, nodeID, isUp)
+	return m.db.UpdateUptime(ctx, nodeID, isUp, lambda, weight)
 }
 
 // RepairQueue returns queue for segments that need repairing
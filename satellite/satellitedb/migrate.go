@@ -601,6 +601,110 @@ func (db *DB) PostgresMigration() *migrate.Migration {
 					return nil
 				}),
 			},
+			{
+				Description: "Add last_net to nodes for selecting nodes in distinct networks",
+				Version:     17,
+				Action: migrate.SQL{
+					`ALTER TABLE nodes ADD last_net TEXT;
+					 UPDATE nodes SET last_net = '';
+					 ALTER TABLE nodes ALTER COLUMN last_net SET NOT NULL;`,
+				},
+			},
+			{
+				Description: "Add disqualified to nodes",
+				Version:     18,
+				Action: migrate.SQL{
+					`ALTER TABLE nodes ADD disqualified TIMESTAMP WITH TIME ZONE;`,
+				},
+			},
+			{
+				Description: "Add reputation alpha and beta values to nodes",
+				Version:     19,
+				Action: migrate.SQL{
+					// existing nodes start with the default initial reputation of a new node,
+					// so they are not disqualified by their first failed audit
+					`ALTER TABLE nodes ADD audit_reputation_alpha double precision NOT NULL DEFAULT 20;
+					 ALTER TABLE nodes ADD audit_reputation_beta double precision NOT NULL DEFAULT 0;
+					 ALTER TABLE nodes ADD uptime_reputation_alpha double precision NOT NULL DEFAULT 100;
+					 ALTER TABLE nodes ADD uptime_reputation_beta double precision NOT NULL DEFAULT 0;`,
+				},
+			},
+			{
+				Description: "Add country_code to nodes and bucket_placements for placement constraints",
+				Version:     20,
+				Action: migrate.SQL{
+					`ALTER TABLE nodes ADD country_code TEXT;
+					 UPDATE nodes SET country_code = '';
+					 ALTER TABLE nodes ALTER COLUMN country_code SET NOT NULL;`,
+					`CREATE TABLE bucket_placements (
+						project_id bytea NOT NULL,
+						bucket_name bytea NOT NULL,
+						placement text NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( project_id, bucket_name )
+					);`,
+				},
+			},
+			{
+				Description: "Add graceful exit status to nodes and graceful exit tables",
+				Version:     21,
+				Action: migrate.SQL{
+					`ALTER TABLE nodes ADD exit_initiated_at timestamp with time zone;
+					 ALTER TABLE nodes ADD exit_loop_completed_at timestamp with time zone;
+					 ALTER TABLE nodes ADD exit_finished_at timestamp with time zone;
+					 ALTER TABLE nodes ADD exit_success boolean NOT NULL DEFAULT FALSE;`,
+					`CREATE TABLE graceful_exit_progress (
+						node_id bytea NOT NULL,
+						bytes_transferred bigint NOT NULL,
+						pieces_transferred bigint NOT NULL,
+						pieces_failed bigint NOT NULL,
+						updated_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( node_id )
+					);`,
+					`CREATE TABLE graceful_exit_transfer_queue (
+						node_id bytea NOT NULL,
+						path bytea NOT NULL,
+						piece_num integer NOT NULL,
+						queued_at timestamp with time zone NOT NULL,
+						failed_count integer NOT NULL,
+						last_failed_at timestamp with time zone,
+						PRIMARY KEY ( node_id, path, piece_num )
+					);`,
+				},
+			},
+			{
+				Description: "Add node contact attempts and suspension",
+				Version:     22,
+				Action: migrate.SQL{
+					`ALTER TABLE nodes ADD suspended timestamp with time zone;`,
+					`CREATE TABLE node_contact_attempts (
+						id bigserial NOT NULL,
+						node_id bytea NOT NULL,
+						attempted_at timestamp with time zone NOT NULL,
+						success boolean NOT NULL,
+						PRIMARY KEY ( id )
+					);`,
+					`CREATE INDEX node_contact_attempts_node_id_attempted_at ON node_contact_attempts ( node_id, attempted_at );`,
+				},
+			},
+			{
+				Description: "Add node quarantine and node admin logs",
+				Version:     23,
+				Action: migrate.SQL{
+					`ALTER TABLE nodes ADD quarantined timestamp with time zone;`,
+					`CREATE TABLE node_admin_logs (
+						id bigserial NOT NULL,
+						node_id bytea NOT NULL,
+						action text NOT NULL,
+						operator text NOT NULL,
+						operator_id bytea,
+						details text NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( id )
+					);`,
+					`CREATE INDEX node_admin_logs_node_id_created_at ON node_admin_logs ( node_id, created_at );`,
+				},
+			},
 		},
 	}
 }
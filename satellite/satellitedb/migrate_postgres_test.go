@@ -17,6 +17,9 @@ import (
 
 	"storj.io/storj/internal/dbutil/dbschema"
 	"storj.io/storj/internal/dbutil/pgutil"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/storj"
 	"storj.io/storj/satellite/satellitedb"
 	"storj.io/storj/satellite/satellitedb/satellitedbtest"
 )
@@ -177,3 +180,63 @@ func TestMigratePostgres(t *testing.T) {
 		})
 	}
 }
+
+func TestMigrateReputationPostgres(t *testing.T) {
+	if *satellitedbtest.TestPostgres == "" {
+		t.Skip("Postgres flag missing, example: -postgres-test-db=" + satellitedbtest.DefaultPostgresConn)
+	}
+
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	snapshots, err := loadSnapshots(*satellitedbtest.TestPostgres)
+	require.NoError(t, err)
+	base, ok := snapshots.FindVersion(minBaseVersion)
+	require.True(t, ok)
+
+	log := zaptest.NewLogger(t)
+	schemaName := "migrate/satellite/reputation" + pgutil.CreateRandomTestingSchemaName(8)
+	connstr := pgutil.ConnstrWithSchema(*satellitedbtest.TestPostgres, schemaName)
+
+	db, err := satellitedb.New(log, connstr)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, db.Close()) }()
+
+	require.NoError(t, db.CreateSchema(schemaName))
+	defer func() { require.NoError(t, db.DropSchema(schemaName)) }()
+
+	rawdb := db.(*satellitedb.DB).TestDBAccess()
+	_, err = rawdb.Exec(base.Script)
+	require.NoError(t, err)
+
+	// create a node before reputation alpha and beta were added
+	migrations := db.(*satellitedb.DB).PostgresMigration()
+	require.NoError(t, migrations.TargetVersion(18).Run(log.Named("migrate"), rawdb))
+
+	nodeID := storj.NodeID{1, 2, 3}
+	_, err = rawdb.Exec(`INSERT INTO nodes (id, address, last_net, protocol, type, email, wallet, free_bandwidth, free_disk,
+		major, minor, patch, hash, timestamp, release, latency_90,
+		audit_success_count, total_audit_count, audit_success_ratio, uptime_success_count, total_uptime_count, uptime_ratio,
+		created_at, updated_at, last_contact_success, last_contact_failure)
+		VALUES ($1, '127.0.0.1:55518', '', 0, 4, '', '', -1, -1, 0, 1, 0, '', 'epoch', false, 0,
+		3, 3, 1, 3, 3, 1, 'epoch', 'epoch', 'epoch', 'epoch')`, nodeID.Bytes())
+	require.NoError(t, err)
+
+	require.NoError(t, migrations.Run(log.Named("migrate"), rawdb))
+
+	// with the default reputation config the node survives a failed audit
+	cache := overlay.NewCache(log, db.OverlayCache(), overlay.NodeSelectionConfig{
+		AuditReputationLambda:  0.95,
+		AuditReputationWeight:  1,
+		AuditReputationDQ:      0.6,
+		UptimeReputationLambda: 0.99,
+		UptimeReputationWeight: 1,
+	})
+	stats, err := cache.UpdateStats(ctx, &overlay.UpdateRequest{
+		NodeID:       nodeID,
+		IsUp:         true,
+		AuditSuccess: false,
+	})
+	require.NoError(t, err)
+	require.Nil(t, stats.Disqualified)
+}
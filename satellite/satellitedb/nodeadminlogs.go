@@ -0,0 +1,123 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite/nodeadmin"
+	dbx "storj.io/storj/satellite/satellitedb/dbx"
+)
+
+type nodeAdminLogs struct {
+	db *dbx.DB
+}
+
+// Apply makes the change of the entry to the node and adds the entry to the admin log in one transaction.
+// Reinstated nodes start over with the initial reputation of defaults.
+func (db *nodeAdminLogs) Apply(ctx context.Context, entry *nodeadmin.LogEntry, defaults overlay.NodeSelectionConfig) (node *overlay.NodeDossier, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := time.Now().UTC()
+	err = db.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		nodeID := dbx.Node_Id(entry.NodeID.Bytes())
+
+		var dbNode *dbx.Node
+		var err error
+		switch entry.Action {
+		case nodeadmin.ActionQuarantine:
+			dbNode, err = tx.Update_Node_By_Id(ctx, nodeID, dbx.Node_Update_Fields{
+				Quarantined: dbx.Node_Quarantined(now),
+			})
+		case nodeadmin.ActionDisqualify:
+			dbNode, err = tx.Update_Node_By_Id(ctx, nodeID, dbx.Node_Update_Fields{
+				Disqualified: dbx.Node_Disqualified(now),
+			})
+		case nodeadmin.ActionReinstate:
+			dbNode, err = tx.Update_Node_By_Id(ctx, nodeID, reinstateFields(defaults))
+		case nodeadmin.ActionAnnotate:
+			dbNode, err = tx.Get_Node_By_Id(ctx, nodeID)
+		default:
+			return errs.New("unknown action %q", entry.Action)
+		}
+		if err != nil {
+			return err
+		}
+		if dbNode == nil {
+			return errs.New("unable to get node by ID: %s", entry.NodeID.String())
+		}
+
+		var operatorID []byte
+		if !entry.OperatorID.IsZero() {
+			operatorID = entry.OperatorID.Bytes()
+		}
+
+		_, err = tx.Tx.ExecContext(ctx, db.db.Rebind(`
+			INSERT INTO node_admin_logs ( node_id, action, operator, operator_id, details, created_at )
+			VALUES ( ?, ?, ?, ?, ?, ? )`),
+			entry.NodeID.Bytes(), string(entry.Action), entry.Operator, operatorID, entry.Details, now)
+		if err != nil {
+			return err
+		}
+
+		node, err = convertDBNode(dbNode)
+		return err
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return node, nil
+}
+
+// reinstateFields lifts the disqualification, suspension and quarantine and resets the reputation to
+// the initial values, otherwise the next failed audit or uptime check would disqualify the node again.
+func reinstateFields(defaults overlay.NodeSelectionConfig) dbx.Node_Update_Fields {
+	return dbx.Node_Update_Fields{
+		Disqualified:          dbx.Node_Disqualified_Null(),
+		Suspended:             dbx.Node_Suspended_Null(),
+		Quarantined:           dbx.Node_Quarantined_Null(),
+		AuditReputationAlpha:  dbx.Node_AuditReputationAlpha(defaults.AuditReputationAlpha0),
+		AuditReputationBeta:   dbx.Node_AuditReputationBeta(defaults.AuditReputationBeta0),
+		UptimeReputationAlpha: dbx.Node_UptimeReputationAlpha(defaults.UptimeReputationAlpha0),
+		UptimeReputationBeta:  dbx.Node_UptimeReputationBeta(defaults.UptimeReputationBeta0),
+	}
+}
+
+// List returns at most limit entries of the node, newest first
+func (db *nodeAdminLogs) List(ctx context.Context, nodeID storj.NodeID, limit int) (entries []*nodeadmin.LogEntry, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.Query(db.db.Rebind(`
+		SELECT action, operator, operator_id, details, created_at
+		FROM node_admin_logs
+		WHERE node_id = ?
+		ORDER BY created_at DESC, id DESC
+		LIMIT ?`), nodeID.Bytes(), limit)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		entry := &nodeadmin.LogEntry{NodeID: nodeID}
+		var operatorID []byte
+		err = rows.Scan(&entry.Action, &entry.Operator, &operatorID, &entry.Details, &entry.CreatedAt)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		if operatorID != nil {
+			entry.OperatorID, err = storj.NodeIDFromBytes(operatorID)
+			if err != nil {
+				return nil, Error.Wrap(err)
+			}
+		}
+		entries = append(entries, entry)
+	}
+	return entries, Error.Wrap(rows.Err())
+}
@@ -10,6 +10,8 @@ import (
 	"time"
 
 	"github.com/golang/protobuf/ptypes"
+	"github.com/lib/pq"
+	sqlite3 "github.com/mattn/go-sqlite3"
 	"github.com/zeebo/errs"
 	monkit "gopkg.in/spacemonkeygo/monkit.v2"
 
@@ -39,14 +41,19 @@ func (cache *overlaycache) SelectStorageNodes(ctx context.Context, count int, cr
 	safeQuery := `
 		WHERE type = ? AND free_bandwidth >= ? AND free_disk >= ?
 		  AND total_audit_count >= ?
-		  AND audit_success_ratio >= ?
 		  AND total_uptime_count >= ?
-		  AND uptime_ratio >= ?
+		  AND audit_reputation_alpha >= ? * (audit_reputation_alpha + audit_reputation_beta)
+		  AND uptime_reputation_alpha >= ? * (uptime_reputation_alpha + uptime_reputation_beta)
 		  AND last_contact_success > ?
-		  AND last_contact_success > last_contact_failure`
+		  AND last_contact_success > last_contact_failure
+		  AND disqualified IS NULL
+		  AND suspended IS NULL
+		  AND quarantined IS NULL
+		  AND exit_initiated_at IS NULL`
 	args := append(make([]interface{}, 0, 13),
 		nodeType, criteria.FreeBandwidth, criteria.FreeDisk,
-		criteria.AuditCount, criteria.AuditSuccessRatio, criteria.UptimeCount, criteria.UptimeSuccessRatio,
+		criteria.AuditCount, criteria.UptimeCount,
+		criteria.MinimumAuditReputation, criteria.MinimumUptimeReputation,
 		time.Now().Add(-overlay.OnlineWindow))
 
 	if criteria.MinimumVersion != "" {
@@ -60,7 +67,9 @@ func (cache *overlaycache) SelectStorageNodes(ctx context.Context, count int, cr
 		args = append(args, v.Major, v.Major, v.Minor, v.Minor, v.Patch)
 	}
 
-	return cache.queryFilteredNodes(ctx, criteria.Excluded, count, safeQuery, args...)
+	safeQuery, args = filterCountries(safeQuery, args, criteria.CountryCodes)
+
+	return cache.queryFilteredNodes(ctx, criteria.Excluded, criteria.DistinctIP, count, safeQuery, args...)
 }
 
 func (cache *overlaycache) SelectNewStorageNodes(ctx context.Context, count int, criteria *overlay.NewNodeCriteria) ([]*pb.Node, error) {
@@ -70,7 +79,11 @@ func (cache *overlaycache) SelectNewStorageNodes(ctx context.Context, count int,
 		WHERE type = ? AND free_bandwidth >= ? AND free_disk >= ?
 		  AND total_audit_count < ?
 		  AND last_contact_success > ?
-		  AND last_contact_success > last_contact_failure`
+		  AND last_contact_success > last_contact_failure
+		  AND disqualified IS NULL
+		  AND suspended IS NULL
+		  AND quarantined IS NULL
+		  AND exit_initiated_at IS NULL`
 	args := append(make([]interface{}, 0, 10),
 		nodeType, criteria.FreeBandwidth, criteria.FreeDisk, criteria.AuditThreshold, time.Now().Add(-overlay.OnlineWindow))
 
@@ -85,10 +98,110 @@ func (cache *overlaycache) SelectNewStorageNodes(ctx context.Context, count int,
 		args = append(args, v.Major, v.Major, v.Minor, v.Minor, v.Patch)
 	}
 
-	return cache.queryFilteredNodes(ctx, criteria.Excluded, count, safeQuery, args...)
+	safeQuery, args = filterCountries(safeQuery, args, criteria.CountryCodes)
+
+	return cache.queryFilteredNodes(ctx, criteria.Excluded, criteria.DistinctIP, count, safeQuery, args...)
+}
+
+// filterCountries restricts the query to nodes in the specified countries, no countries allows any country
+func filterCountries(safeQuery string, args []interface{}, countryCodes []string) (string, []interface{}) {
+	if len(countryCodes) == 0 {
+		return safeQuery, args
+	}
+
+	safeQuery += `
+		  AND country_code IN (?` + strings.Repeat(", ?", len(countryCodes)-1) + `)`
+	for _, code := range countryCodes {
+		args = append(args, code)
+	}
+	return safeQuery, args
 }
 
-func (cache *overlaycache) queryFilteredNodes(ctx context.Context, excluded []storj.NodeID, count int, safeQuery string, args ...interface{}) (_ []*pb.Node, err error) {
+// SelectAllStorageNodesUpload returns all nodes that are eligible for uploads, separated into reputable and new nodes
+func (cache *overlaycache) SelectAllStorageNodesUpload(ctx context.Context, preferences overlay.NodeSelectionConfig) (reputable, new []*overlay.SelectedNode, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	auditCount := preferences.AuditCount
+	if auditCount < preferences.NewNodeAuditThreshold {
+		auditCount = preferences.NewNodeAuditThreshold
+	}
+
+	rows, err := cache.db.Query(cache.db.Rebind(`
+		SELECT id, address, last_net, country_code, protocol, free_bandwidth, free_disk,
+			major, minor, patch, release,
+			total_audit_count, total_uptime_count,
+			audit_reputation_alpha, audit_reputation_beta,
+			uptime_reputation_alpha, uptime_reputation_beta
+		FROM nodes
+		WHERE type = ? AND free_bandwidth >= 0 AND free_disk >= 0
+		  AND last_contact_success > ?
+		  AND last_contact_success > last_contact_failure
+		  AND disqualified IS NULL
+		  AND suspended IS NULL
+		  AND quarantined IS NULL
+		  AND exit_initiated_at IS NULL`),
+		int(pb.NodeType_STORAGE), time.Now().Add(-overlay.OnlineWindow))
+	if err != nil {
+		return nil, nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var dbNode dbx.Node
+		err = rows.Scan(&dbNode.Id, &dbNode.Address, &dbNode.LastNet, &dbNode.CountryCode, &dbNode.Protocol,
+			&dbNode.FreeBandwidth, &dbNode.FreeDisk,
+			&dbNode.Major, &dbNode.Minor, &dbNode.Patch, &dbNode.Release,
+			&dbNode.TotalAuditCount, &dbNode.TotalUptimeCount,
+			&dbNode.AuditReputationAlpha, &dbNode.AuditReputationBeta,
+			&dbNode.UptimeReputationAlpha, &dbNode.UptimeReputationBeta)
+		if err != nil {
+			return nil, nil, Error.Wrap(err)
+		}
+
+		id, err := storj.NodeIDFromBytes(dbNode.Id)
+		if err != nil {
+			return nil, nil, Error.Wrap(err)
+		}
+
+		node := &overlay.SelectedNode{
+			Node: &pb.Node{
+				Id:   id,
+				Type: pb.NodeType_STORAGE,
+				Address: &pb.NodeAddress{
+					Address:   dbNode.Address,
+					Transport: pb.NodeTransport(dbNode.Protocol),
+				},
+				Restrictions: &pb.NodeRestrictions{
+					FreeBandwidth: dbNode.FreeBandwidth,
+					FreeDisk:      dbNode.FreeDisk,
+				},
+			},
+			LastNet:     dbNode.LastNet,
+			CountryCode: dbNode.CountryCode,
+			Version: version.SemVer{
+				Major: dbNode.Major,
+				Minor: dbNode.Minor,
+				Patch: dbNode.Patch,
+			},
+			Release: dbNode.Release,
+		}
+
+		// n.b. these must match the conditions in SelectStorageNodes and SelectNewStorageNodes
+		switch {
+		case dbNode.TotalAuditCount < preferences.NewNodeAuditThreshold:
+			new = append(new, node)
+		case dbNode.TotalAuditCount >= auditCount &&
+			dbNode.TotalUptimeCount >= preferences.UptimeCount &&
+			dbNode.AuditReputationAlpha >= preferences.MinimumAuditReputation*(dbNode.AuditReputationAlpha+dbNode.AuditReputationBeta) &&
+			dbNode.UptimeReputationAlpha >= preferences.MinimumUptimeReputation*(dbNode.UptimeReputationAlpha+dbNode.UptimeReputationBeta):
+			reputable = append(reputable, node)
+		}
+	}
+
+	return reputable, new, rows.Err()
+}
+
+func (cache *overlaycache) queryFilteredNodes(ctx context.Context, excluded []storj.NodeID, distinctIP bool, count int, safeQuery string, args ...interface{}) (_ []*pb.Node, err error) {
 	if count == 0 {
 		return nil, nil
 	}
@@ -100,16 +213,54 @@ func (cache *overlaycache) queryFilteredNodes(ctx context.Context, excluded []st
 	for _, id := range excluded {
 		args = append(args, id.Bytes())
 	}
+
+	if distinctIP && len(excluded) > 0 {
+		// nodes in the same network as an excluded node are excluded as well,
+		// an unknown network doesn't exclude anything
+		safeExcludeNodes += ` AND last_net NOT IN (SELECT last_net FROM nodes WHERE last_net <> '' AND id IN (?` + strings.Repeat(", ?", len(excluded)-1) + `))`
+		for _, id := range excluded {
+			args = append(args, id.Bytes())
+		}
+	}
 	args = append(args, count)
 
-	rows, err := cache.db.Query(cache.db.Rebind(`SELECT id,
+	const safeColumns = `id,
 		type, address, free_bandwidth, free_disk, audit_success_ratio,
 		uptime_ratio, total_audit_count, audit_success_count, total_uptime_count,
-		uptime_success_count
+		uptime_success_count`
+
+	query := `SELECT ` + safeColumns + `
 		FROM nodes
-		`+safeQuery+safeExcludeNodes+`
+		` + safeQuery + safeExcludeNodes + `
 		ORDER BY RANDOM()
-		LIMIT ?`), args...)
+		LIMIT ?`
+
+	if distinctIP {
+		// nodes with an unknown network are each treated as a separate network
+		const safeNetwork = `last_net, CASE WHEN last_net = '' THEN id END`
+		switch t := cache.db.DB.Driver().(type) {
+		case *sqlite3.SQLiteDriver:
+			query = `SELECT ` + safeColumns + `
+				FROM nodes
+				` + safeQuery + safeExcludeNodes + `
+				GROUP BY ` + safeNetwork + `
+				ORDER BY RANDOM()
+				LIMIT ?`
+		case *pq.Driver:
+			query = `SELECT ` + safeColumns + ` FROM (
+				SELECT DISTINCT ON (` + safeNetwork + `) ` + safeColumns + `
+				FROM nodes
+				` + safeQuery + safeExcludeNodes + `
+				ORDER BY ` + safeNetwork + `, RANDOM()
+			) filtered
+			ORDER BY RANDOM()
+			LIMIT ?`
+		default:
+			return nil, Error.New("unsupported database %T", t)
+		}
+	}
+
+	rows, err := cache.db.Query(cache.db.Rebind(query), args...)
 	if err != nil {
 		return nil, err
 	}
@@ -221,7 +372,7 @@ func (cache *overlaycache) Paginate(ctx context.Context, offset int64, limit int
 }
 
 // Update updates node information
-func (cache *overlaycache) Update(ctx context.Context, info *pb.Node) (err error) {
+func (cache *overlaycache) Update(ctx context.Context, info *pb.Node, lastNet, countryCode string, defaults overlay.NodeSelectionConfig) (err error) {
 	if info == nil || info.Id.IsZero() {
 		return overlay.ErrEmptyNode
 	}
@@ -277,6 +428,7 @@ func (cache *overlaycache) Update(ctx context.Context, info *pb.Node) (err error
 			ctx,
 			dbx.Node_Id(info.Id.Bytes()),
 			dbx.Node_Address(address.Address),
+			dbx.Node_LastNet(lastNet),
 			dbx.Node_Protocol(int(address.Transport)),
 			dbx.Node_Type(int(info.Type)),
 			dbx.Node_Email(metadata.Email),
@@ -299,6 +451,13 @@ func (cache *overlaycache) Update(ctx context.Context, info *pb.Node) (err error
 			dbx.Node_UptimeRatio(reputation.UptimeRatio),
 			dbx.Node_LastContactSuccess(time.Now()),
 			dbx.Node_LastContactFailure(time.Time{}),
+			dbx.Node_AuditReputationAlpha(defaults.AuditReputationAlpha0),
+			dbx.Node_AuditReputationBeta(defaults.AuditReputationBeta0),
+			dbx.Node_UptimeReputationAlpha(defaults.UptimeReputationAlpha0),
+			dbx.Node_UptimeReputationBeta(defaults.UptimeReputationBeta0),
+			dbx.Node_CountryCode(countryCode),
+			dbx.Node_ExitSuccess(false),
+			dbx.Node_Create_Fields{},
 		)
 		if err != nil {
 			return Error.Wrap(errs.Combine(err, tx.Rollback()))
@@ -310,6 +469,16 @@ func (cache *overlaycache) Update(ctx context.Context, info *pb.Node) (err error
 			Protocol: dbx.Node_Protocol(int(address.Transport)),
 		}
 
+		// keep the previous network when the address couldn't be resolved
+		if lastNet != "" {
+			update.LastNet = dbx.Node_LastNet(lastNet)
+		}
+
+		// keep the previous country when it couldn't be resolved
+		if countryCode != "" {
+			update.CountryCode = dbx.Node_CountryCode(countryCode)
+		}
+
 		if info.Reputation != nil {
 			update.Latency90 = dbx.Node_Latency90(info.Reputation.Latency_90)
 			update.AuditSuccessRatio = dbx.Node_AuditSuccessRatio(info.Reputation.AuditSuccessRatio)
@@ -372,6 +541,15 @@ func (cache *overlaycache) CreateStats(ctx context.Context, nodeID storj.NodeID,
 			UptimeRatio:        dbx.Node_UptimeRatio(uptimeRatio),
 		}
 
+		if startingStats.AuditReputationAlpha != 0 || startingStats.AuditReputationBeta != 0 {
+			updateFields.AuditReputationAlpha = dbx.Node_AuditReputationAlpha(startingStats.AuditReputationAlpha)
+			updateFields.AuditReputationBeta = dbx.Node_AuditReputationBeta(startingStats.AuditReputationBeta)
+		}
+		if startingStats.UptimeReputationAlpha != 0 || startingStats.UptimeReputationBeta != 0 {
+			updateFields.UptimeReputationAlpha = dbx.Node_UptimeReputationAlpha(startingStats.UptimeReputationAlpha)
+			updateFields.UptimeReputationBeta = dbx.Node_UptimeReputationBeta(startingStats.UptimeReputationBeta)
+		}
+
 		dbNode, err = tx.Update_Node_By_Id(ctx, dbx.Node_Id(nodeID.Bytes()), updateFields)
 		if err != nil {
 			return nil, Error.Wrap(errs.Combine(err, tx.Rollback()))
@@ -387,16 +565,13 @@ func (cache *overlaycache) CreateStats(ctx context.Context, nodeID storj.NodeID,
 	return getNodeStats(dbNode), Error.Wrap(tx.Commit())
 }
 
-// FindInvalidNodes finds a subset of storagenodes that fail to meet minimum reputation requirements
-func (cache *overlaycache) FindInvalidNodes(ctx context.Context, nodeIDs storj.NodeIDList, maxStats *overlay.NodeStats) (invalidIDs storj.NodeIDList, err error) {
+// FindInvalidNodes finds a subset of storagenodes that are disqualified or fail to meet minimum reputation requirements
+func (cache *overlaycache) FindInvalidNodes(ctx context.Context, nodeIDs storj.NodeIDList, criteria *overlay.NodeCriteria) (invalidIDs storj.NodeIDList, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	var invalidIds storj.NodeIDList
 
-	maxAuditSuccess := maxStats.AuditSuccessRatio
-	maxUptime := maxStats.UptimeRatio
-
-	rows, err := cache.findInvalidNodesQuery(nodeIDs, maxAuditSuccess, maxUptime)
+	rows, err := cache.findInvalidNodesQuery(nodeIDs, criteria.MinimumAuditReputation, criteria.MinimumUptimeReputation)
 
 	if err != nil {
 		return nil, err
@@ -407,7 +582,7 @@ func (cache *overlaycache) FindInvalidNodes(ctx context.Context, nodeIDs storj.N
 
 	for rows.Next() {
 		node := &dbx.Node{}
-		err = rows.Scan(&node.Id, &node.TotalAuditCount, &node.TotalUptimeCount, &node.AuditSuccessRatio, &node.UptimeRatio)
+		err = rows.Scan(&node.Id)
 		if err != nil {
 			return nil, err
 		}
@@ -421,23 +596,26 @@ func (cache *overlaycache) FindInvalidNodes(ctx context.Context, nodeIDs storj.N
 	return invalidIds, nil
 }
 
-func (cache *overlaycache) findInvalidNodesQuery(nodeIds storj.NodeIDList, auditSuccess, uptime float64) (*sql.Rows, error) {
+func (cache *overlaycache) findInvalidNodesQuery(nodeIds storj.NodeIDList, auditReputation, uptimeReputation float64) (*sql.Rows, error) {
 	args := make([]interface{}, len(nodeIds))
 	for i, id := range nodeIds {
 		args[i] = id.Bytes()
 	}
-	args = append(args, auditSuccess, uptime)
+	args = append(args, auditReputation, uptimeReputation)
 
-	rows, err := cache.db.Query(cache.db.Rebind(`SELECT nodes.id, nodes.total_audit_count,
-		nodes.total_uptime_count, nodes.audit_success_ratio,
-		nodes.uptime_ratio
+	rows, err := cache.db.Query(cache.db.Rebind(`SELECT nodes.id
 		FROM nodes
 		WHERE nodes.id IN (?`+strings.Repeat(", ?", len(nodeIds)-1)+`)
-		AND nodes.total_audit_count > 0
-		AND nodes.total_uptime_count > 0
 		AND (
-			nodes.audit_success_ratio < ?
-			OR nodes.uptime_ratio < ?
+			nodes.disqualified IS NOT NULL
+			OR (
+				nodes.total_audit_count > 0
+				AND nodes.total_uptime_count > 0
+				AND (
+					nodes.audit_reputation_alpha < ? * (nodes.audit_reputation_alpha + nodes.audit_reputation_beta)
+					OR nodes.uptime_reputation_alpha < ? * (nodes.uptime_reputation_alpha + nodes.uptime_reputation_beta)
+				)
+			)
 		)`), args...)
 
 	return rows, err
@@ -477,13 +655,33 @@ func (cache *overlaycache) UpdateStats(ctx context.Context, updateReq *overlay.U
 		totalUptimeCount,
 	)
 
+	auditAlpha, auditBeta := overlay.UpdateReputation(
+		updateReq.AuditSuccess,
+		dbNode.AuditReputationAlpha,
+		dbNode.AuditReputationBeta,
+		updateReq.AuditLambda,
+		updateReq.AuditWeight,
+	)
+
+	uptimeAlpha, uptimeBeta := overlay.UpdateReputation(
+		updateReq.IsUp,
+		dbNode.UptimeReputationAlpha,
+		dbNode.UptimeReputationBeta,
+		updateReq.UptimeLambda,
+		updateReq.UptimeWeight,
+	)
+
 	updateFields := dbx.Node_Update_Fields{
-		AuditSuccessCount:  dbx.Node_AuditSuccessCount(auditSuccessCount),
-		TotalAuditCount:    dbx.Node_TotalAuditCount(totalAuditCount),
-		AuditSuccessRatio:  dbx.Node_AuditSuccessRatio(auditSuccessRatio),
-		UptimeSuccessCount: dbx.Node_UptimeSuccessCount(uptimeSuccessCount),
-		TotalUptimeCount:   dbx.Node_TotalUptimeCount(totalUptimeCount),
-		UptimeRatio:        dbx.Node_UptimeRatio(uptimeRatio),
+		AuditSuccessCount:     dbx.Node_AuditSuccessCount(auditSuccessCount),
+		TotalAuditCount:       dbx.Node_TotalAuditCount(totalAuditCount),
+		AuditSuccessRatio:     dbx.Node_AuditSuccessRatio(auditSuccessRatio),
+		UptimeSuccessCount:    dbx.Node_UptimeSuccessCount(uptimeSuccessCount),
+		TotalUptimeCount:      dbx.Node_TotalUptimeCount(totalUptimeCount),
+		UptimeRatio:           dbx.Node_UptimeRatio(uptimeRatio),
+		AuditReputationAlpha:  dbx.Node_AuditReputationAlpha(auditAlpha),
+		AuditReputationBeta:   dbx.Node_AuditReputationBeta(auditBeta),
+		UptimeReputationAlpha: dbx.Node_UptimeReputationAlpha(uptimeAlpha),
+		UptimeReputationBeta:  dbx.Node_UptimeReputationBeta(uptimeBeta),
 	}
 
 	if updateReq.IsUp {
@@ -492,6 +690,17 @@ func (cache *overlaycache) UpdateStats(ctx context.Context, updateReq *overlay.U
 		updateFields.LastContactFailure = dbx.Node_LastContactFailure(time.Now())
 	}
 
+	err = cache.addContactAttempt(ctx, tx, nodeID, updateReq.IsUp)
+	if err != nil {
+		return nil, Error.Wrap(errs.Combine(err, tx.Rollback()))
+	}
+
+	// disqualification is permanent
+	auditReputation := (&overlay.NodeStats{AuditReputationAlpha: auditAlpha, AuditReputationBeta: auditBeta}).AuditReputation()
+	if dbNode.Disqualified == nil && auditReputation < updateReq.AuditDQ {
+		updateFields.Disqualified = dbx.Node_Disqualified(time.Now().UTC())
+	}
+
 	dbNode, err = tx.Update_Node_By_Id(ctx, dbx.Node_Id(nodeID.Bytes()), updateFields)
 	if err != nil {
 		return nil, Error.Wrap(errs.Combine(err, tx.Rollback()))
@@ -516,6 +725,9 @@ func (cache *overlaycache) UpdateNodeInfo(ctx context.Context, nodeID storj.Node
 		if nodeInfo.GetOperator() != nil {
 			updateFields.Wallet = dbx.Node_Wallet(nodeInfo.GetOperator().GetWallet())
 			updateFields.Email = dbx.Node_Email(nodeInfo.GetOperator().GetEmail())
+			if countryCode := nodeInfo.GetOperator().GetCountryCode(); countryCode != "" {
+				updateFields.CountryCode = dbx.Node_CountryCode(strings.ToUpper(countryCode))
+			}
 		}
 		if nodeInfo.GetCapacity() != nil {
 			updateFields.FreeDisk = dbx.Node_FreeDisk(nodeInfo.GetCapacity().GetFreeDisk())
@@ -548,7 +760,7 @@ func (cache *overlaycache) UpdateNodeInfo(ctx context.Context, nodeID storj.Node
 }
 
 // UpdateUptime updates a single storagenode's uptime stats in the db
-func (cache *overlaycache) UpdateUptime(ctx context.Context, nodeID storj.NodeID, isUp bool) (stats *overlay.NodeStats, err error) {
+func (cache *overlaycache) UpdateUptime(ctx context.Context, nodeID storj.NodeID, isUp bool, lambda, weight float64) (stats *overlay.NodeStats, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	tx, err := cache.db.Open(ctx)
@@ -560,6 +772,9 @@ func (cache *overlaycache) UpdateUptime(ctx context.Context, nodeID storj.NodeID
 		return nil, Error.Wrap(errs.Combine(err, tx.Rollback()))
 	}
 
+	wasOnline := time.Since(dbNode.LastContactSuccess) < overlay.OnlineWindow &&
+		dbNode.LastContactSuccess.After(dbNode.LastContactFailure)
+
 	uptimeSuccessCount := dbNode.UptimeSuccessCount
 	totalUptimeCount := dbNode.TotalUptimeCount
 	var uptimeRatio float64
@@ -576,12 +791,21 @@ func (cache *overlaycache) UpdateUptime(ctx context.Context, nodeID storj.NodeID
 	updateFields.TotalUptimeCount = dbx.Node_TotalUptimeCount(totalUptimeCount)
 	updateFields.UptimeRatio = dbx.Node_UptimeRatio(uptimeRatio)
 
+	uptimeAlpha, uptimeBeta := overlay.UpdateReputation(isUp, dbNode.UptimeReputationAlpha, dbNode.UptimeReputationBeta, lambda, weight)
+	updateFields.UptimeReputationAlpha = dbx.Node_UptimeReputationAlpha(uptimeAlpha)
+	updateFields.UptimeReputationBeta = dbx.Node_UptimeReputationBeta(uptimeBeta)
+
 	if isUp {
 		updateFields.LastContactSuccess = dbx.Node_LastContactSuccess(time.Now())
 	} else {
 		updateFields.LastContactFailure = dbx.Node_LastContactFailure(time.Now())
 	}
 
+	err = cache.addContactAttempt(ctx, tx, nodeID, isUp)
+	if err != nil {
+		return nil, Error.Wrap(errs.Combine(err, tx.Rollback()))
+	}
+
 	dbNode, err = tx.Update_Node_By_Id(ctx, dbx.Node_Id(nodeID.Bytes()), updateFields)
 	if err != nil {
 		return nil, Error.Wrap(errs.Combine(err, tx.Rollback()))
@@ -593,7 +817,161 @@ func (cache *overlaycache) UpdateUptime(ctx context.Context, nodeID storj.NodeID
 		return nil, Error.Wrap(errs.New("unable to get node by ID: %s", nodeID.String()))
 	}
 
-	return getNodeStats(dbNode), Error.Wrap(tx.Commit())
+	stats = getNodeStats(dbNode)
+	stats.Returned = isUp && !wasOnline
+	return stats, Error.Wrap(tx.Commit())
+}
+
+// GetExitingNodes returns the exit status of nodes that have initiated a graceful exit, but have not finished it.
+func (cache *overlaycache) GetExitingNodes(ctx context.Context) (exiting []*overlay.ExitStatus, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := cache.db.Query(cache.db.Rebind(`
+		SELECT id, exit_initiated_at, exit_loop_completed_at, exit_finished_at, exit_success
+		FROM nodes
+		WHERE exit_initiated_at IS NOT NULL
+		  AND exit_finished_at IS NULL`))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		status := &overlay.ExitStatus{}
+		err = rows.Scan(&status.NodeID, &status.ExitInitiatedAt, &status.ExitLoopCompletedAt, &status.ExitFinishedAt, &status.ExitSuccess)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		exiting = append(exiting, status)
+	}
+	return exiting, Error.Wrap(rows.Err())
+}
+
+// UpdateExitStatus updates the graceful exit status of a node.
+func (cache *overlaycache) UpdateExitStatus(ctx context.Context, request *overlay.ExitStatusRequest) (stats *overlay.NodeDossier, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var updateFields dbx.Node_Update_Fields
+	if !request.ExitInitiatedAt.IsZero() {
+		updateFields.ExitInitiatedAt = dbx.Node_ExitInitiatedAt(request.ExitInitiatedAt)
+	}
+	if !request.ExitLoopCompletedAt.IsZero() {
+		updateFields.ExitLoopCompletedAt = dbx.Node_ExitLoopCompletedAt(request.ExitLoopCompletedAt)
+	}
+	if !request.ExitFinishedAt.IsZero() {
+		updateFields.ExitFinishedAt = dbx.Node_ExitFinishedAt(request.ExitFinishedAt)
+		updateFields.ExitSuccess = dbx.Node_ExitSuccess(request.ExitSuccess)
+	}
+
+	dbNode, err := cache.db.Update_Node_By_Id(ctx, dbx.Node_Id(request.NodeID.Bytes()), updateFields)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if dbNode == nil {
+		return nil, Error.New("unable to get node by ID: %s", request.NodeID.String())
+	}
+
+	return convertDBNode(dbNode)
+}
+
+// addContactAttempt records an attempt to contact the node for the uptime history.
+func (cache *overlaycache) addContactAttempt(ctx context.Context, tx *dbx.Tx, nodeID storj.NodeID, success bool) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = tx.Tx.ExecContext(ctx, cache.db.Rebind(`
+		INSERT INTO node_contact_attempts ( node_id, attempted_at, success ) VALUES ( ?, ?, ? )`),
+		nodeID.Bytes(), time.Now().UTC(), success)
+	return err
+}
+
+// GetContactAttempts returns the contact attempts of the nodes since the given time, sorted by time.
+// The last attempt before since is included to know whether the node was offline at that time.
+func (cache *overlaycache) GetContactAttempts(ctx context.Context, nodeIDs storj.NodeIDList, since time.Time) (attempts map[storj.NodeID][]overlay.ContactAttempt, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	attempts = make(map[storj.NodeID][]overlay.ContactAttempt, len(nodeIDs))
+	if len(nodeIDs) == 0 {
+		return attempts, nil
+	}
+
+	args := make([]interface{}, 0, len(nodeIDs)+2)
+	for _, id := range nodeIDs {
+		args = append(args, id.Bytes())
+	}
+	args = append(args, since.UTC(), since.UTC())
+
+	rows, err := cache.db.Query(cache.db.Rebind(`
+		SELECT attempts.node_id, attempts.attempted_at, attempts.success
+		FROM node_contact_attempts attempts
+		WHERE attempts.node_id IN (?`+strings.Repeat(", ?", len(nodeIDs)-1)+`)
+		  AND attempts.attempted_at >= COALESCE((
+			SELECT MAX(previous.attempted_at) FROM node_contact_attempts previous
+			WHERE previous.node_id = attempts.node_id AND previous.attempted_at < ?
+		  ), ?)
+		ORDER BY attempts.node_id, attempts.attempted_at, attempts.id`),
+		args...)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var nodeID storj.NodeID
+		var attempt overlay.ContactAttempt
+		err = rows.Scan(&nodeID, &attempt.AttemptedAt, &attempt.Success)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		attempts[nodeID] = append(attempts[nodeID], attempt)
+	}
+	return attempts, Error.Wrap(rows.Err())
+}
+
+// DeleteContactAttemptsBefore deletes the contact attempts older than before.
+func (cache *overlaycache) DeleteContactAttemptsBefore(ctx context.Context, before time.Time) (deleted int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := cache.db.ExecContext(ctx, cache.db.Rebind(`
+		DELETE FROM node_contact_attempts WHERE attempted_at < ?`), before.UTC())
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+	deleted, err = result.RowsAffected()
+	return deleted, Error.Wrap(err)
+}
+
+// UpdateSuspended suspends the node from new uploads since suspended, nil unsuspends the node.
+func (cache *overlaycache) UpdateSuspended(ctx context.Context, nodeID storj.NodeID, suspended *time.Time) (stats *overlay.NodeDossier, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbNode, err := cache.db.Update_Node_By_Id(ctx, dbx.Node_Id(nodeID.Bytes()), dbx.Node_Update_Fields{
+		Suspended: dbx.Node_Suspended_Raw(suspended),
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if dbNode == nil {
+		return nil, Error.New("unable to get node by ID: %s", nodeID.String())
+	}
+
+	return convertDBNode(dbNode)
+}
+
+// DisqualifyNode disqualifies the node permanently.
+func (cache *overlaycache) DisqualifyNode(ctx context.Context, nodeID storj.NodeID) (stats *overlay.NodeDossier, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbNode, err := cache.db.Update_Node_By_Id(ctx, dbx.Node_Id(nodeID.Bytes()), dbx.Node_Update_Fields{
+		Disqualified: dbx.Node_Disqualified(time.Now().UTC()),
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if dbNode == nil {
+		return nil, Error.New("unable to get node by ID: %s", nodeID.String())
+	}
+
+	return convertDBNode(dbNode)
 }
 
 func convertDBNode(info *dbx.Node) (*overlay.NodeDossier, error) {
@@ -627,8 +1005,9 @@ func convertDBNode(info *dbx.Node) (*overlay.NodeDossier, error) {
 		},
 		Type: pb.NodeType(info.Type),
 		Operator: pb.NodeOperator{
-			Email:  info.Email,
-			Wallet: info.Wallet,
+			Email:       info.Email,
+			Wallet:      info.Wallet,
+			CountryCode: info.CountryCode,
 		},
 		Capacity: pb.NodeCapacity{
 			FreeBandwidth: info.FreeBandwidth,
@@ -644,6 +1023,12 @@ func convertDBNode(info *dbx.Node) (*overlay.NodeDossier, error) {
 			UptimeSuccessCount: info.UptimeSuccessCount,
 			LastContactSuccess: info.LastContactSuccess,
 			LastContactFailure: info.LastContactFailure,
+			Disqualified:       info.Disqualified,
+
+			AuditReputationAlpha:  info.AuditReputationAlpha,
+			AuditReputationBeta:   info.AuditReputationBeta,
+			UptimeReputationAlpha: info.UptimeReputationAlpha,
+			UptimeReputationBeta:  info.UptimeReputationBeta,
 		},
 		Version: pb.NodeVersion{
 			Version:    ver.String(),
@@ -651,6 +1036,17 @@ func convertDBNode(info *dbx.Node) (*overlay.NodeDossier, error) {
 			Timestamp:  pbts,
 			Release:    info.Release,
 		},
+		LastNet:     info.LastNet,
+		CountryCode: info.CountryCode,
+		ExitStatus: overlay.ExitStatus{
+			NodeID:              id,
+			ExitInitiatedAt:     info.ExitInitiatedAt,
+			ExitLoopCompletedAt: info.ExitLoopCompletedAt,
+			ExitFinishedAt:      info.ExitFinishedAt,
+			ExitSuccess:         info.ExitSuccess,
+		},
+		Suspended:   info.Suspended,
+		Quarantined: info.Quarantined,
 	}
 
 	if time.Now().Sub(info.LastContactSuccess) < 1*time.Hour && info.LastContactSuccess.After(info.LastContactFailure) {
@@ -671,6 +1067,12 @@ func getNodeStats(dbNode *dbx.Node) *overlay.NodeStats {
 		UptimeCount:        dbNode.TotalUptimeCount,
 		LastContactSuccess: dbNode.LastContactSuccess,
 		LastContactFailure: dbNode.LastContactFailure,
+		Disqualified:       dbNode.Disqualified,
+
+		AuditReputationAlpha:  dbNode.AuditReputationAlpha,
+		AuditReputationBeta:   dbNode.AuditReputationBeta,
+		UptimeReputationAlpha: dbNode.UptimeReputationAlpha,
+		UptimeReputationBeta:  dbNode.UptimeReputationBeta,
 	}
 	return nodeStats
 }
@@ -6,6 +6,7 @@ package storage
 import (
 	"context"
 	"io"
+	"time"
 
 	"github.com/zeebo/errs"
 )
@@ -24,6 +25,13 @@ func (ref *BlobRef) IsValid() bool {
 	return len(ref.Namespace) > 0 && len(ref.Key) > 0
 }
 
+// BlobInfo describes a committed blob
+type BlobInfo struct {
+	Ref     BlobRef
+	Size    int64
+	ModTime time.Time
+}
+
 // BlobReader is an interface that groups Read, ReadAt, Seek and Close.
 type BlobReader interface {
 	io.Reader
@@ -56,4 +64,18 @@ type Blobs interface {
 	Delete(ctx context.Context, ref BlobRef) error
 	// FreeSpace return how much free space left for writing
 	FreeSpace() (int64, error)
+	// ListNamespaces returns the namespaces that contain blobs
+	ListNamespaces(ctx context.Context) ([][]byte, error)
+	// WalkNamespace calls fn for every committed blob in the namespace,
+	// iteration stops at the first error returned by fn
+	WalkNamespace(ctx context.Context, namespace []byte, fn func(BlobInfo) error) error
+	// Trash moves the blob with the namespace and key to the trash, from where it can be restored
+	Trash(ctx context.Context, ref BlobRef) error
+	// RestoreTrash moves all trashed blobs of the namespace back and returns their keys
+	RestoreTrash(ctx context.Context, namespace []byte) ([][]byte, error)
+	// EmptyTrash permanently deletes the blobs trashed before trashedBefore and returns the freed bytes
+	EmptyTrash(ctx context.Context, trashedBefore time.Time) (int64, error)
+	// EstimateSpaceUsed estimates the size of all committed blobs by measuring at most samples
+	// directories of every namespace, zero or less measures all of them
+	EstimateSpaceUsed(ctx context.Context, samples int) (int64, error)
 }
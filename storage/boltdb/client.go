@@ -152,6 +152,35 @@ func (client *Client) Delete(key storage.Key) error {
 	})
 }
 
+// CompareAndSwap atomically replaces the value of key with newValue when the current value is oldValue.
+func (client *Client) CompareAndSwap(key storage.Key, oldValue, newValue storage.Value) error {
+	if key.IsZero() {
+		return storage.ErrEmptyKey.New("")
+	}
+
+	return client.update(func(bucket *bolt.Bucket) error {
+		data := bucket.Get([]byte(key))
+		if data == nil {
+			if oldValue != nil {
+				return storage.ErrKeyNotFound.New("%s", key)
+			}
+			if newValue == nil {
+				return nil
+			}
+			return bucket.Put(key, newValue)
+		}
+
+		if oldValue == nil || !bytes.Equal(data, oldValue) {
+			return storage.ErrValueChanged.New("%s", key)
+		}
+
+		if newValue == nil {
+			return bucket.Delete(key)
+		}
+		return bucket.Put(key, newValue)
+	})
+}
+
 // List returns either a list of keys for which boltdb has values or an error.
 func (client *Client) List(first storage.Key, limit int) (storage.Keys, error) {
 	rv, err := storage.ListKeys(client, first, limit)
@@ -8,9 +8,12 @@ import (
 	"io"
 	"io/ioutil"
 	"math"
+	"math/rand"
 	"os"
 	"path/filepath"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/zeebo/errs"
 
@@ -20,6 +23,9 @@ import (
 const (
 	blobPermission = 0600
 	dirPermission  = 0700
+
+	// trashDateFormat is the name format of the trash directories that keep the blobs trashed in a single day
+	trashDateFormat = "2006-01-02"
 )
 
 var pathEncoding = base32.NewEncoding("abcdefghijklmnopqrstuvwxyz234567").WithPadding(base32.NoPadding)
@@ -38,11 +44,27 @@ func NewDir(path string) (*Dir, error) {
 		path: path,
 	}
 
-	return dir, errs.Combine(
+	err := errs.Combine(
 		os.MkdirAll(dir.blobdir(), dirPermission),
 		os.MkdirAll(dir.tempdir(), dirPermission),
 		os.MkdirAll(dir.trashdir(), dirPermission),
 	)
+	if err != nil {
+		return dir, err
+	}
+
+	return dir, dir.recover()
+}
+
+// recover removes leftovers of an unclean shutdown,
+// partially written blobs in the temp directory are never committed
+// and blobs that were being deleted are no longer needed,
+// the dated trash directories are kept so that their blobs can be restored
+func (dir *Dir) recover() error {
+	return errs.Combine(
+		removeAllContent(dir.tempdir()),
+		removeFiles(dir.trashdir()),
+	)
 }
 
 // Path returns the directory path
@@ -81,6 +103,24 @@ func (dir *Dir) DeleteTemporary(file *os.File) error {
 
 // blobToPath converts blob reference to a filepath in permanent storage
 func (dir *Dir) blobToPath(ref storage.BlobRef) (string, error) {
+	relative, err := blobToRelativePath(ref)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir.blobdir(), relative), nil
+}
+
+// blobToDatedTrashPath converts blob reference to a filepath in the trash directory of the day it was trashed
+func (dir *Dir) blobToDatedTrashPath(ref storage.BlobRef, trashedAt time.Time) (string, error) {
+	relative, err := blobToRelativePath(ref)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir.trashdir(), trashedAt.UTC().Format(trashDateFormat), relative), nil
+}
+
+// blobToRelativePath converts blob reference to a filepath relative to the blob or a dated trash directory
+func blobToRelativePath(ref storage.BlobRef) (string, error) {
 	if !ref.IsValid() {
 		return "", storage.ErrInvalidBlobRef.New("")
 	}
@@ -91,7 +131,7 @@ func (dir *Dir) blobToPath(ref storage.BlobRef) (string, error) {
 		// ensure we always have at least
 		key = "11" + key
 	}
-	return filepath.Join(dir.blobdir(), namespace, key[:2], key[2:]), nil
+	return filepath.Join(namespace, key[:2], key[2:]), nil
 }
 
 // blobToTrashPath converts blob reference to a filepath in transient storage
@@ -122,7 +162,12 @@ func (dir *Dir) Commit(file *os.File, ref storage.BlobRef) error {
 		return errs.Combine(err, removeErr)
 	}
 
-	mkdirErr := os.MkdirAll(filepath.Dir(path), dirPermission)
+	// directories created for the blob need to be synced as well
+	parent := filepath.Dir(path)
+	_, statErr := os.Stat(parent)
+	created := os.IsNotExist(statErr)
+
+	mkdirErr := os.MkdirAll(parent, dirPermission)
 	if os.IsExist(mkdirErr) {
 		mkdirErr = nil
 	}
@@ -138,7 +183,13 @@ func (dir *Dir) Commit(file *os.File, ref storage.BlobRef) error {
 		return errs.Combine(renameErr, removeErr)
 	}
 
-	return nil
+	// the rename is durable only after the directory entry is synced
+	syncErr = syncDir(parent)
+	if syncErr == nil && created {
+		namespace := filepath.Dir(parent)
+		syncErr = errs.Combine(syncDir(namespace), syncDir(filepath.Dir(namespace)))
+	}
+	return syncErr
 }
 
 // Open opens the file with the specified ref
@@ -149,6 +200,9 @@ func (dir *Dir) Open(ref storage.BlobRef) (*os.File, error) {
 	}
 	file, err := openFileReadOnly(path, blobPermission)
 	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, err
+		}
 		return nil, Error.New("unable to open %q: %v", path, err)
 	}
 	return file, nil
@@ -224,11 +278,117 @@ func (dir *Dir) GarbageCollect() error {
 		dir.mu.Unlock()
 	}
 
-	// remove anything left in the trashdir
-	_ = removeAllContent(dir.trashdir())
+	// remove anything left in the trashdir, except for the dated trash
+	_ = removeFiles(dir.trashdir())
 	return nil
 }
 
+// Trash moves the file with the specified ref to the trash directory of the day
+func (dir *Dir) Trash(ref storage.BlobRef, trashedAt time.Time) error {
+	path, err := dir.blobToPath(ref)
+	if err != nil {
+		return err
+	}
+
+	trashPath, err := dir.blobToDatedTrashPath(ref, trashedAt)
+	if err != nil {
+		return err
+	}
+
+	mkdirErr := os.MkdirAll(filepath.Dir(trashPath), dirPermission)
+	if mkdirErr != nil && !os.IsExist(mkdirErr) {
+		return mkdirErr
+	}
+
+	err = rename(path, trashPath)
+	// ignore concurrent deletes
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	// the modification time is used for emptying the trash
+	return os.Chtimes(trashPath, trashedAt, trashedAt)
+}
+
+// RestoreTrash moves the trashed files of the namespace back to permanent storage
+// and returns the keys of the restored blobs
+func (dir *Dir) RestoreTrash(namespace []byte) (keys [][]byte, err error) {
+	dates, err := readDirNames(dir.trashdir())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, date := range dates {
+		if _, err := time.Parse(trashDateFormat, date); err != nil {
+			// not a dated trash directory
+			continue
+		}
+
+		namespaceDir := filepath.Join(dir.trashdir(), date, pathEncoding.EncodeToString(namespace))
+		err := walkBlobs(namespaceDir, namespace, func(trashPath string, info storage.BlobInfo) error {
+			path, err := dir.blobToPath(info.Ref)
+			if err != nil {
+				return err
+			}
+
+			mkdirErr := os.MkdirAll(filepath.Dir(path), dirPermission)
+			if mkdirErr != nil && !os.IsExist(mkdirErr) {
+				return mkdirErr
+			}
+
+			if err := rename(trashPath, path); err != nil {
+				return err
+			}
+			keys = append(keys, info.Ref.Key)
+			return nil
+		})
+		if err != nil {
+			return keys, err
+		}
+
+		// only empty directories are left behind
+		_ = os.RemoveAll(namespaceDir)
+	}
+	return keys, nil
+}
+
+// EmptyTrash deletes the files trashed before trashedBefore and returns the number of freed bytes
+func (dir *Dir) EmptyTrash(trashedBefore time.Time) (freed int64, err error) {
+	dates, err := readDirNames(dir.trashdir())
+	if err != nil {
+		return 0, err
+	}
+
+	for _, date := range dates {
+		day, err := time.Parse(trashDateFormat, date)
+		if err != nil {
+			// not a dated trash directory
+			continue
+		}
+		if !day.Before(trashedBefore) {
+			continue
+		}
+
+		path := filepath.Join(dir.trashdir(), date)
+		size, err := removeFilesBefore(path, trashedBefore)
+		freed += size
+		if err != nil {
+			return freed, err
+		}
+
+		// only empty directories are left behind when the whole day is over
+		if !day.AddDate(0, 0, 1).After(trashedBefore) {
+			if err := os.RemoveAll(path); err != nil {
+				return freed, err
+			}
+		}
+	}
+	return freed, nil
+}
+
 // removeAllContent deletes everything in the folder
 func removeAllContent(path string) error {
 	dir, err := os.Open(path)
@@ -251,6 +411,183 @@ func removeAllContent(path string) error {
 	}
 }
 
+// ListNamespaces returns the namespaces that contain blobs
+func (dir *Dir) ListNamespaces() ([][]byte, error) {
+	names, err := readDirNames(dir.blobdir())
+	if err != nil {
+		return nil, err
+	}
+
+	namespaces := make([][]byte, 0, len(names))
+	for _, name := range names {
+		namespace, err := pathEncoding.DecodeString(name)
+		if err != nil {
+			// not created by us
+			continue
+		}
+		namespaces = append(namespaces, namespace)
+	}
+	return namespaces, nil
+}
+
+// WalkNamespace calls fn for every committed blob in the namespace,
+// iteration stops at the first error returned by fn
+func (dir *Dir) WalkNamespace(namespace []byte, fn func(storage.BlobInfo) error) error {
+	namespaceDir := filepath.Join(dir.blobdir(), pathEncoding.EncodeToString(namespace))
+	return walkBlobs(namespaceDir, namespace, func(_ string, info storage.BlobInfo) error {
+		return fn(info)
+	})
+}
+
+// walkBlobs calls fn with the path and information of every blob in the namespace directory
+func walkBlobs(namespaceDir string, namespace []byte, fn func(path string, info storage.BlobInfo) error) error {
+	prefixes, err := readDirNames(namespaceDir)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, prefix := range prefixes {
+		files, err := ioutil.ReadDir(filepath.Join(namespaceDir, prefix))
+		if err != nil {
+			return err
+		}
+
+		for _, file := range files {
+			if file.IsDir() {
+				continue
+			}
+
+			key, ok := pathToKey(prefix + file.Name())
+			if !ok {
+				continue
+			}
+
+			err := fn(filepath.Join(namespaceDir, prefix, file.Name()), storage.BlobInfo{
+				Ref: storage.BlobRef{
+					Namespace: namespace,
+					Key:       key,
+				},
+				Size:    file.Size(),
+				ModTime: file.ModTime(),
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// EstimateSpaceUsed estimates the size of all committed blobs by measuring at most samples
+// prefix directories of every namespace, zero or less measures all of them
+func (dir *Dir) EstimateSpaceUsed(samples int) (total int64, err error) {
+	namespaces, err := readDirNames(dir.blobdir())
+	if err != nil {
+		return 0, err
+	}
+
+	for _, namespace := range namespaces {
+		namespaceDir := filepath.Join(dir.blobdir(), namespace)
+		prefixes, err := readDirNames(namespaceDir)
+		if err != nil {
+			return total, err
+		}
+		if len(prefixes) == 0 {
+			continue
+		}
+
+		sampled := prefixes
+		if samples > 0 && samples < len(prefixes) {
+			sampled = make([]string, samples)
+			for i, k := range rand.Perm(len(prefixes))[:samples] {
+				sampled[i] = prefixes[k]
+			}
+		}
+
+		var size int64
+		for _, prefix := range sampled {
+			files, err := ioutil.ReadDir(filepath.Join(namespaceDir, prefix))
+			if err != nil {
+				return total, err
+			}
+			for _, file := range files {
+				if !file.IsDir() {
+					size += file.Size()
+				}
+			}
+		}
+
+		// blobs are spread evenly between the prefixes
+		total += size * int64(len(prefixes)) / int64(len(sampled))
+	}
+	return total, nil
+}
+
+// pathToKey decodes the key of a blob from its encoded path, see blobToPath
+func pathToKey(encoded string) ([]byte, bool) {
+	// short keys are prefixed with characters that aren't part of the encoding
+	encoded = strings.TrimPrefix(encoded, "11")
+	key, err := pathEncoding.DecodeString(encoded)
+	if err != nil || len(key) == 0 {
+		return nil, false
+	}
+	return key, true
+}
+
+// removeFiles deletes the files in the folder, leaving the subdirectories
+func removeFiles(path string) error {
+	infos, err := ioutil.ReadDir(path)
+	if err != nil {
+		return err
+	}
+
+	for _, info := range infos {
+		if !info.IsDir() {
+			// the file might be still in use, so ignore the error
+			_ = os.Remove(filepath.Join(path, info.Name()))
+		}
+	}
+	return nil
+}
+
+// removeFilesBefore deletes the files in the folder and its subdirectories that were modified before the given time
+// and returns their total size
+func removeFilesBefore(path string, before time.Time) (size int64, err error) {
+	err = filepath.Walk(path, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || !info.ModTime().Before(before) {
+			return nil
+		}
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		size += info.Size()
+		return nil
+	})
+	return size, err
+}
+
+// readDirNames returns the names of the subdirectories of path
+func readDirNames(path string) ([]string, error) {
+	infos, err := ioutil.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(infos))
+	for _, info := range infos {
+		if info.IsDir() {
+			names = append(names, info.Name())
+		}
+	}
+	return names, nil
+}
+
 // DiskInfo contains statistics about this dir
 type DiskInfo struct {
 	ID             string
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/zeebo/errs"
 	"golang.org/x/sys/unix"
 )
 
@@ -40,3 +41,12 @@ func rename(oldpath, newpath string) error {
 func openFileReadOnly(path string, perm os.FileMode) (*os.File, error) {
 	return os.OpenFile(path, os.O_RDONLY, perm)
 }
+
+// syncDir flushes the directory entries of path to disk
+func syncDir(path string) error {
+	dir, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	return errs.Combine(dir.Sync(), dir.Close())
+}
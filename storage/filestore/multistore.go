@@ -0,0 +1,238 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package filestore
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/storage"
+)
+
+// Placement decides in which directory new blobs are stored
+type Placement string
+
+const (
+	// PlacementFillFirst stores new blobs in the first directory that has room for them
+	PlacementFillFirst Placement = "fill-first"
+	// PlacementBalanced stores new blobs in the directory with the most free space
+	PlacementBalanced Placement = "balanced"
+)
+
+// placementReserve is the free space kept in a directory for blobs that are still being written
+const placementReserve = 64 << 20
+
+var _ storage.Blobs = (*MultiStore)(nil)
+
+// MultiStore implements a blob store that spreads blobs over multiple directories,
+// blobs are looked up in every directory, so they can be moved between them
+type MultiStore struct {
+	stores    []*Store
+	placement Placement
+}
+
+// NewMultiStore creates a blob store over the given stores, new blobs are placed according to placement
+func NewMultiStore(stores []*Store, placement Placement) (*MultiStore, error) {
+	if len(stores) == 0 {
+		return nil, Error.New("no directories")
+	}
+	switch placement {
+	case PlacementFillFirst, PlacementBalanced:
+	default:
+		return nil, Error.New("unknown placement %q", placement)
+	}
+	return &MultiStore{
+		stores:    stores,
+		placement: placement,
+	}, nil
+}
+
+// NewMultiStoreAt creates a blob store over the specified directories
+func NewMultiStoreAt(paths []string, placement Placement) (*MultiStore, error) {
+	var stores []*Store
+	for _, path := range paths {
+		store, err := NewAt(path)
+		if err != nil {
+			return nil, err
+		}
+		stores = append(stores, store)
+	}
+	return NewMultiStore(stores, placement)
+}
+
+// Close closes the stores.
+func (multi *MultiStore) Close() error {
+	var group errs.Group
+	for _, store := range multi.stores {
+		group.Add(store.Close())
+	}
+	return group.Err()
+}
+
+// Create creates a new blob in the directory chosen by the placement
+func (multi *MultiStore) Create(ctx context.Context, ref storage.BlobRef, size int64) (storage.BlobWriter, error) {
+	store, err := multi.place(size)
+	if err != nil {
+		return nil, err
+	}
+	return store.Create(ctx, ref, size)
+}
+
+// place returns the store for a new blob of the specified size, -1 is unknown size
+func (multi *MultiStore) place(size int64) (*Store, error) {
+	if size < 0 {
+		size = 0
+	}
+
+	var best *Store
+	var bestFree int64
+	for _, store := range multi.stores {
+		free, err := store.FreeSpace()
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		if multi.placement == PlacementFillFirst && free >= size+placementReserve {
+			return store, nil
+		}
+		if best == nil || free > bestFree {
+			best, bestFree = store, free
+		}
+	}
+	// when every directory is running out of space the one with the most room is used
+	return best, nil
+}
+
+// Open opens the blob from the directory that contains it
+func (multi *MultiStore) Open(ctx context.Context, ref storage.BlobRef) (storage.BlobReader, error) {
+	var lastErr error
+	for _, store := range multi.stores {
+		reader, err := store.Open(ctx, ref)
+		if err == nil {
+			return reader, nil
+		}
+		lastErr = err
+		if !os.IsNotExist(err) {
+			return nil, err
+		}
+	}
+	return nil, lastErr
+}
+
+// Delete deletes the blob from every directory
+func (multi *MultiStore) Delete(ctx context.Context, ref storage.BlobRef) error {
+	var group errs.Group
+	for _, store := range multi.stores {
+		group.Add(store.Delete(ctx, ref))
+	}
+	return group.Err()
+}
+
+// FreeSpace returns how much space is left in all directories,
+// directories on the same disk are counted once
+func (multi *MultiStore) FreeSpace() (int64, error) {
+	var total int64
+	counted := make(map[string]struct{})
+	for _, store := range multi.stores {
+		info, err := store.dir.Info()
+		if err != nil {
+			return 0, err
+		}
+		if _, ok := counted[info.ID]; ok {
+			continue
+		}
+		counted[info.ID] = struct{}{}
+		total += info.AvailableSpace
+	}
+	return total, nil
+}
+
+// ListNamespaces returns the namespaces that contain blobs in any of the directories
+func (multi *MultiStore) ListNamespaces(ctx context.Context) ([][]byte, error) {
+	var namespaces [][]byte
+	for _, store := range multi.stores {
+		list, err := store.ListNamespaces(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, namespace := range list {
+			if !containsKey(namespaces, namespace) {
+				namespaces = append(namespaces, namespace)
+			}
+		}
+	}
+	return namespaces, nil
+}
+
+// WalkNamespace calls fn for every committed blob of the namespace in every directory,
+// iteration stops at the first error returned by fn
+func (multi *MultiStore) WalkNamespace(ctx context.Context, namespace []byte, fn func(storage.BlobInfo) error) error {
+	for _, store := range multi.stores {
+		if err := store.WalkNamespace(ctx, namespace, fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Trash moves the blob to the trash of the directory that contains it
+func (multi *MultiStore) Trash(ctx context.Context, ref storage.BlobRef) error {
+	var group errs.Group
+	for _, store := range multi.stores {
+		group.Add(store.Trash(ctx, ref))
+	}
+	return group.Err()
+}
+
+// RestoreTrash moves all trashed blobs of the namespace back and returns their keys
+func (multi *MultiStore) RestoreTrash(ctx context.Context, namespace []byte) ([][]byte, error) {
+	var keys [][]byte
+	for _, store := range multi.stores {
+		restored, err := store.RestoreTrash(ctx, namespace)
+		keys = append(keys, restored...)
+		if err != nil {
+			return keys, err
+		}
+	}
+	return keys, nil
+}
+
+// EmptyTrash permanently deletes the blobs trashed before trashedBefore and returns the freed bytes
+func (multi *MultiStore) EmptyTrash(ctx context.Context, trashedBefore time.Time) (int64, error) {
+	var total int64
+	for _, store := range multi.stores {
+		freed, err := store.EmptyTrash(ctx, trashedBefore)
+		total += freed
+		if err != nil {
+			return total, err
+		}
+	}
+	return total, nil
+}
+
+// EstimateSpaceUsed estimates the size of all committed blobs in every directory
+func (multi *MultiStore) EstimateSpaceUsed(ctx context.Context, samples int) (int64, error) {
+	var total int64
+	for _, store := range multi.stores {
+		size, err := store.EstimateSpaceUsed(ctx, samples)
+		if err != nil {
+			return 0, err
+		}
+		total += size
+	}
+	return total, nil
+}
+
+// containsKey returns whether keys contains key
+func containsKey(keys [][]byte, key []byte) bool {
+	for _, k := range keys {
+		if bytes.Equal(k, key) {
+			return true
+		}
+	}
+	return false
+}
@@ -6,6 +6,7 @@ package filestore
 import (
 	"context"
 	"os"
+	"time"
 
 	"github.com/zeebo/errs"
 
@@ -73,6 +74,44 @@ func (store *Store) Create(ctx context.Context, ref storage.BlobRef, size int64)
 	return newBlobWriter(ref, store, file), nil
 }
 
+// ListNamespaces returns the namespaces that contain blobs
+func (store *Store) ListNamespaces(ctx context.Context) ([][]byte, error) {
+	namespaces, err := store.dir.ListNamespaces()
+	return namespaces, Error.Wrap(err)
+}
+
+// WalkNamespace calls fn for every committed blob in the namespace,
+// iteration stops at the first error returned by fn
+func (store *Store) WalkNamespace(ctx context.Context, namespace []byte, fn func(storage.BlobInfo) error) error {
+	err := store.dir.WalkNamespace(namespace, fn)
+	return Error.Wrap(err)
+}
+
+// Trash moves the blob with the specified ref to the trash
+func (store *Store) Trash(ctx context.Context, ref storage.BlobRef) error {
+	err := store.dir.Trash(ref, time.Now())
+	return Error.Wrap(err)
+}
+
+// RestoreTrash moves all trashed blobs of the namespace back and returns their keys
+func (store *Store) RestoreTrash(ctx context.Context, namespace []byte) ([][]byte, error) {
+	keys, err := store.dir.RestoreTrash(namespace)
+	return keys, Error.Wrap(err)
+}
+
+// EmptyTrash permanently deletes the blobs trashed before trashedBefore and returns the freed bytes
+func (store *Store) EmptyTrash(ctx context.Context, trashedBefore time.Time) (int64, error) {
+	freed, err := store.dir.EmptyTrash(trashedBefore)
+	return freed, Error.Wrap(err)
+}
+
+// EstimateSpaceUsed estimates the size of all committed blobs by measuring at most samples
+// directories of every namespace, zero or less measures all of them
+func (store *Store) EstimateSpaceUsed(ctx context.Context, samples int) (int64, error) {
+	size, err := store.dir.EstimateSpaceUsed(samples)
+	return size, Error.Wrap(err)
+}
+
 // FreeSpace returns how much space left in underlying directory
 func (store *Store) FreeSpace() (int64, error) {
 	info, err := store.dir.Info()
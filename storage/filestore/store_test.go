@@ -4,6 +4,7 @@
 package filestore_test
 
 import (
+	"bytes"
 	"errors"
 	"io"
 	"io/ioutil"
@@ -11,6 +12,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -226,3 +228,260 @@ func TestDeleteWhileReading(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestRecover(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	store, err := filestore.NewAt(ctx.Dir("store"))
+	require.NoError(t, err)
+
+	ref := storage.BlobRef{
+		Namespace: randomValue(),
+		Key:       randomValue(),
+	}
+
+	writer, err := store.Create(ctx, ref, -1)
+	require.NoError(t, err)
+	_, err = writer.Write([]byte{1, 2, 3})
+	require.NoError(t, err)
+	require.NoError(t, writer.Commit())
+
+	// simulate an unclean shutdown with a partial upload and an unfinished delete
+	require.NoError(t, ioutil.WriteFile(filepath.Join(ctx.Dir("store", "tmp"), "partial"), []byte{1}, 0600))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(ctx.Dir("store", "trash"), "deleted"), []byte{1}, 0600))
+
+	store, err = filestore.NewAt(ctx.Dir("store"))
+	require.NoError(t, err)
+
+	for _, dir := range []string{"tmp", "trash"} {
+		files, err := ioutil.ReadDir(ctx.Dir("store", dir))
+		require.NoError(t, err)
+		require.Empty(t, files, dir)
+	}
+
+	// committed blobs must survive recovery
+	reader, err := store.Open(ctx, ref)
+	require.NoError(t, err)
+	require.NoError(t, reader.Close())
+}
+
+func TestWalkNamespace(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	store, err := filestore.NewAt(ctx.Dir("store"))
+	require.NoError(t, err)
+
+	namespaces := [][]byte{randomValue(), randomValue()}
+	expected := map[string][]byte{}
+	for _, namespace := range namespaces {
+		// include a short key, which is stored with a padded path
+		for _, key := range [][]byte{randomValue(), randomValue(), {1}} {
+			ref := storage.BlobRef{Namespace: namespace, Key: key}
+
+			writer, err := store.Create(ctx, ref, -1)
+			require.NoError(t, err)
+			_, err = writer.Write(key)
+			require.NoError(t, err)
+			require.NoError(t, writer.Commit())
+
+			if bytes.Equal(namespace, namespaces[0]) {
+				expected[string(key)] = key
+			}
+		}
+	}
+
+	listed, err := store.ListNamespaces(ctx)
+	require.NoError(t, err)
+	require.ElementsMatch(t, namespaces, listed)
+
+	walked := map[string][]byte{}
+	err = store.WalkNamespace(ctx, namespaces[0], func(info storage.BlobInfo) error {
+		require.Equal(t, namespaces[0], info.Ref.Namespace)
+		require.Equal(t, int64(len(info.Ref.Key)), info.Size)
+		require.False(t, info.ModTime.IsZero())
+		walked[string(info.Ref.Key)] = info.Ref.Key
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, expected, walked)
+
+	// walking an unknown namespace is not an error
+	err = store.WalkNamespace(ctx, randomValue(), func(info storage.BlobInfo) error {
+		return errors.New("unexpected blob")
+	})
+	require.NoError(t, err)
+}
+
+func TestTrash(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	store, err := filestore.NewAt(ctx.Dir("store"))
+	require.NoError(t, err)
+
+	create := func(ref storage.BlobRef) {
+		writer, err := store.Create(ctx, ref, -1)
+		require.NoError(t, err)
+		_, err = writer.Write(ref.Key)
+		require.NoError(t, err)
+		require.NoError(t, writer.Commit())
+	}
+
+	namespace, other := randomValue(), randomValue()
+	trashed := storage.BlobRef{Namespace: namespace, Key: randomValue()}
+	kept := storage.BlobRef{Namespace: namespace, Key: randomValue()}
+	otherTrashed := storage.BlobRef{Namespace: other, Key: randomValue()}
+	for _, ref := range []storage.BlobRef{trashed, kept, otherTrashed} {
+		create(ref)
+	}
+
+	require.NoError(t, store.Trash(ctx, trashed))
+	require.NoError(t, store.Trash(ctx, otherTrashed))
+	// trashing a missing blob is not an error
+	require.NoError(t, store.Trash(ctx, storage.BlobRef{Namespace: namespace, Key: randomValue()}))
+
+	_, err = store.Open(ctx, trashed)
+	require.Error(t, err, "opening trashed blob should fail")
+
+	// trashed blobs survive recovery
+	store, err = filestore.NewAt(ctx.Dir("store"))
+	require.NoError(t, err)
+
+	keys, err := store.RestoreTrash(ctx, namespace)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{trashed.Key}, keys)
+
+	for _, ref := range []storage.BlobRef{trashed, kept} {
+		reader, err := store.Open(ctx, ref)
+		require.NoError(t, err)
+		require.NoError(t, reader.Close())
+	}
+
+	_, err = store.Open(ctx, otherTrashed)
+	require.Error(t, err, "restoring should only affect a single namespace")
+
+	// nothing was trashed before an hour ago
+	freed, err := store.EmptyTrash(ctx, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	require.Zero(t, freed)
+
+	freed, err = store.EmptyTrash(ctx, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.Equal(t, int64(len(otherTrashed.Key)), freed)
+
+	keys, err = store.RestoreTrash(ctx, other)
+	require.NoError(t, err)
+	require.Empty(t, keys)
+}
+
+func TestEstimateSpaceUsed(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	store, err := filestore.NewAt(ctx.Dir("store"))
+	require.NoError(t, err)
+
+	const blobCount, blobSize = 8, 100
+	namespace := randomValue()
+	for i := 0; i < blobCount; i++ {
+		// every blob is stored in a different prefix directory
+		key := randomValue()
+		key[0] = byte(i << 3)
+
+		writer, err := store.Create(ctx, storage.BlobRef{Namespace: namespace, Key: key}, -1)
+		require.NoError(t, err)
+		_, err = writer.Write(make([]byte, blobSize))
+		require.NoError(t, err)
+		require.NoError(t, writer.Commit())
+	}
+
+	for _, samples := range []int{0, 1, blobCount, 2 * blobCount} {
+		size, err := store.EstimateSpaceUsed(ctx, samples)
+		require.NoError(t, err)
+		require.Equal(t, int64(blobCount*blobSize), size, samples)
+	}
+}
+
+func TestMultiStore(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	_, err := filestore.NewMultiStoreAt([]string{ctx.Dir("unknown")}, filestore.Placement("random"))
+	require.Error(t, err)
+
+	first, err := filestore.NewAt(ctx.Dir("first"))
+	require.NoError(t, err)
+	second, err := filestore.NewAt(ctx.Dir("second"))
+	require.NoError(t, err)
+
+	for _, placement := range []filestore.Placement{filestore.PlacementFillFirst, filestore.PlacementBalanced} {
+		multi, err := filestore.NewMultiStore([]*filestore.Store{first, second}, placement)
+		require.NoError(t, err)
+
+		namespace := randomValue()
+		write := func(store storage.Blobs, key []byte) storage.BlobRef {
+			ref := storage.BlobRef{Namespace: namespace, Key: key}
+			writer, err := store.Create(ctx, ref, int64(len(key)))
+			require.NoError(t, err)
+			_, err = writer.Write(key)
+			require.NoError(t, err)
+			require.NoError(t, writer.Commit())
+			return ref
+		}
+
+		// blobs are found in whichever directory contains them
+		refs := []storage.BlobRef{
+			write(multi, randomValue()),
+			write(first, randomValue()),
+			write(second, randomValue()),
+		}
+		for _, ref := range refs {
+			reader, err := multi.Open(ctx, ref)
+			require.NoError(t, err)
+			require.NoError(t, reader.Close())
+		}
+
+		_, err = multi.Open(ctx, storage.BlobRef{Namespace: namespace, Key: randomValue()})
+		require.True(t, os.IsNotExist(err))
+
+		listed, err := multi.ListNamespaces(ctx)
+		require.NoError(t, err)
+		require.Contains(t, listed, namespace)
+
+		var walked int
+		err = multi.WalkNamespace(ctx, namespace, func(info storage.BlobInfo) error {
+			walked++
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, len(refs), walked)
+
+		firstFree, err := first.FreeSpace()
+		require.NoError(t, err)
+		totalFree, err := multi.FreeSpace()
+		require.NoError(t, err)
+		// both directories are on the same disk, which is counted once
+		require.True(t, totalFree < 2*firstFree)
+
+		// trashed blobs are restored to their directory
+		require.NoError(t, multi.Trash(ctx, refs[2]))
+		_, err = second.Open(ctx, refs[2])
+		require.True(t, os.IsNotExist(err))
+
+		keys, err := multi.RestoreTrash(ctx, namespace)
+		require.NoError(t, err)
+		require.Equal(t, [][]byte{refs[2].Key}, keys)
+
+		reader, err := second.Open(ctx, refs[2])
+		require.NoError(t, err)
+		require.NoError(t, reader.Close())
+
+		for _, ref := range refs {
+			require.NoError(t, multi.Delete(ctx, ref))
+			_, err := multi.Open(ctx, ref)
+			require.True(t, os.IsNotExist(err))
+		}
+	}
+}
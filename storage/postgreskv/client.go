@@ -4,6 +4,7 @@
 package postgreskv
 
 import (
+	"bytes"
 	"database/sql"
 	"fmt"
 
@@ -110,6 +111,74 @@ func (client *Client) DeletePath(bucket, key storage.Key) error {
 	return nil
 }
 
+// CompareAndSwap replaces the value of key with newValue when the current value is oldValue.
+func (client *Client) CompareAndSwap(key storage.Key, oldValue, newValue storage.Value) error {
+	return client.CompareAndSwapPath(storage.Key(defaultBucket), key, oldValue, newValue)
+}
+
+// CompareAndSwapPath replaces the value of key (in the given bucket) with newValue when the current value is oldValue.
+func (client *Client) CompareAndSwapPath(bucket, key storage.Key, oldValue, newValue storage.Value) (err error) {
+	if key.IsZero() {
+		return storage.ErrEmptyKey.New("")
+	}
+
+	tx, err := client.pgConn.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			err = errs.Combine(err, tx.Rollback())
+			return
+		}
+		err = tx.Commit()
+	}()
+
+	q := "SELECT metadata FROM pathdata WHERE bucket = $1::BYTEA AND fullpath = $2::BYTEA FOR UPDATE"
+	var value []byte
+	err = tx.QueryRow(q, []byte(bucket), []byte(key)).Scan(&value)
+	switch {
+	case err == sql.ErrNoRows:
+		if oldValue != nil {
+			return storage.ErrKeyNotFound.New("%s", key)
+		}
+		if newValue == nil {
+			return nil
+		}
+		q := `
+			INSERT INTO pathdata (bucket, fullpath, metadata)
+				VALUES ($1::BYTEA, $2::BYTEA, $3::BYTEA)
+				ON CONFLICT DO NOTHING
+		`
+		result, err := tx.Exec(q, []byte(bucket), []byte(key), []byte(newValue))
+		if err != nil {
+			return err
+		}
+		numRows, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if numRows == 0 {
+			return storage.ErrValueChanged.New("%s", key)
+		}
+		return nil
+	case err != nil:
+		return err
+	case oldValue == nil || !bytes.Equal(value, oldValue):
+		return storage.ErrValueChanged.New("%s", key)
+	}
+
+	if newValue == nil {
+		q := "DELETE FROM pathdata WHERE bucket = $1::BYTEA AND fullpath = $2::BYTEA"
+		_, err = tx.Exec(q, []byte(bucket), []byte(key))
+		return err
+	}
+
+	q = "UPDATE pathdata SET metadata = $3::BYTEA WHERE bucket = $1::BYTEA AND fullpath = $2::BYTEA"
+	_, err = tx.Exec(q, []byte(bucket), []byte(key), []byte(newValue))
+	return err
+}
+
 // List returns either a list of known keys, in order, or an error.
 func (client *Client) List(first storage.Key, limit int) (storage.Keys, error) {
 	return storage.ListKeys(client, first, limit)
@@ -4,6 +4,7 @@
 package redis
 
 import (
+	"bytes"
 	"net/url"
 	"sort"
 	"strconv"
@@ -117,6 +118,49 @@ func (client *Client) Delete(key storage.Key) error {
 	return nil
 }
 
+// CompareAndSwap atomically replaces the value of key with newValue when the current value is oldValue.
+func (client *Client) CompareAndSwap(key storage.Key, oldValue, newValue storage.Value) error {
+	if key.IsZero() {
+		return storage.ErrEmptyKey.New("")
+	}
+
+	txf := func(tx *redis.Tx) error {
+		value, err := tx.Get(key.String()).Bytes()
+		switch {
+		case err == redis.Nil:
+			if oldValue != nil {
+				return storage.ErrKeyNotFound.New("%s", key)
+			}
+			if newValue == nil {
+				return nil
+			}
+		case err != nil:
+			return Error.New("get error: %v", err)
+		case oldValue == nil || !bytes.Equal(value, oldValue):
+			return storage.ErrValueChanged.New("%s", key)
+		}
+
+		_, err = tx.Pipelined(func(pipe redis.Pipeliner) error {
+			if newValue == nil {
+				pipe.Del(key.String())
+			} else {
+				pipe.Set(key.String(), []byte(newValue), client.TTL)
+			}
+			return nil
+		})
+		return err
+	}
+
+	err := client.db.Watch(txf, key.String())
+	if err == redis.TxFailedErr {
+		return storage.ErrValueChanged.New("%s", key)
+	}
+	if err != nil && !storage.ErrKeyNotFound.Has(err) && !storage.ErrValueChanged.Has(err) {
+		return Error.New("compare and swap error: %v", err)
+	}
+	return err
+}
+
 // Close closes a redis client
 func (client *Client) Close() error {
 	return client.db.Close()
@@ -16,6 +16,7 @@ func RunTests(t *testing.T, store storage.KeyValueStore) {
 
 	t.Run("CRUD", func(t *testing.T) { testCRUD(t, store) })
 	t.Run("Constraints", func(t *testing.T) { testConstraints(t, store) })
+	t.Run("CompareAndSwap", func(t *testing.T) { testCompareAndSwap(t, store) })
 	t.Run("Iterate", func(t *testing.T) { testIterate(t, store) })
 	t.Run("IterateAll", func(t *testing.T) { testIterateAll(t, store) })
 	t.Run("Prefix", func(t *testing.T) { testPrefix(t, store) })
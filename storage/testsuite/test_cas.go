@@ -0,0 +1,71 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package testsuite
+
+import (
+	"bytes"
+	"testing"
+
+	"storj.io/storj/storage"
+)
+
+func testCompareAndSwap(t *testing.T, store storage.KeyValueStore) {
+	key := storage.Key("cas/key")
+	defer cleanupItems(store, storage.Items{newItem("cas/key", "", false)})
+
+	t.Run("Create", func(t *testing.T) {
+		err := store.CompareAndSwap(key, storage.Value("x"), storage.Value("a"))
+		if !storage.ErrKeyNotFound.Has(err) {
+			t.Fatalf("expected key not found when swapping missing key, got %v", err)
+		}
+
+		if err := store.CompareAndSwap(key, nil, storage.Value("a")); err != nil {
+			t.Fatalf("failed to create %q: %v", key, err)
+		}
+
+		err = store.CompareAndSwap(key, nil, storage.Value("b"))
+		if !storage.ErrValueChanged.Has(err) {
+			t.Fatalf("expected value changed when creating existing key, got %v", err)
+		}
+	})
+
+	t.Run("Swap", func(t *testing.T) {
+		err := store.CompareAndSwap(key, storage.Value("b"), storage.Value("c"))
+		if !storage.ErrValueChanged.Has(err) {
+			t.Fatalf("expected value changed when swapping with a stale value, got %v", err)
+		}
+
+		if err := store.CompareAndSwap(key, storage.Value("a"), storage.Value("c")); err != nil {
+			t.Fatalf("failed to swap %q: %v", key, err)
+		}
+
+		value, err := store.Get(key)
+		if err != nil {
+			t.Fatalf("failed to get %q: %v", key, err)
+		}
+		if !bytes.Equal(value, storage.Value("c")) {
+			t.Fatalf("invalid value for %q: got %q", key, value)
+		}
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		err := store.CompareAndSwap(key, storage.Value("a"), nil)
+		if !storage.ErrValueChanged.Has(err) {
+			t.Fatalf("expected value changed when deleting with a stale value, got %v", err)
+		}
+
+		if err := store.CompareAndSwap(key, storage.Value("c"), nil); err != nil {
+			t.Fatalf("failed to delete %q: %v", key, err)
+		}
+
+		_, err = store.Get(key)
+		if !storage.ErrKeyNotFound.Has(err) {
+			t.Fatalf("expected %q to be deleted, got %v", key, err)
+		}
+
+		if err := store.CompareAndSwap(key, nil, nil); err != nil {
+			t.Fatalf("failed to compare missing %q: %v", key, err)
+		}
+	})
+}
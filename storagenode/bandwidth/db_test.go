@@ -85,6 +85,15 @@ func TestDB(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, expectedUsageBySatellite, usageBySatellite)
 
+		// summarizing a single satellite
+		usage, err = bandwidthdb.SatelliteSummary(ctx, satellite0, now.Add(-10*time.Hour), now.Add(10*time.Hour))
+		require.NoError(t, err)
+		require.Equal(t, expectedUsage, usage)
+
+		usage, err = bandwidthdb.SatelliteSummary(ctx, satellite0, now.Add(time.Hour), now.Add(10*time.Hour))
+		require.NoError(t, err)
+		require.Equal(t, &bandwidth.Usage{}, usage)
+
 		// only range capturing second satellite
 		usage, err = bandwidthdb.Summary(ctx, now.Add(time.Hour), now.Add(10*time.Hour))
 		require.NoError(t, err)
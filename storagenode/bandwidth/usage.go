@@ -15,6 +15,7 @@ import (
 type DB interface {
 	Add(ctx context.Context, satelliteID storj.NodeID, action pb.PieceAction, amount int64, created time.Time) error
 	Summary(ctx context.Context, from, to time.Time) (*Usage, error)
+	SatelliteSummary(ctx context.Context, satelliteID storj.NodeID, from, to time.Time) (*Usage, error)
 	SummaryBySatellite(ctx context.Context, from, to time.Time) (map[storj.NodeID]*Usage, error)
 }
 
@@ -82,6 +83,16 @@ func TotalMonthlySummary(ctx context.Context, db DB) (*Usage, error) {
 	return db.Summary(ctx, getBeginningOfMonth(), time.Now())
 }
 
+// SatelliteMonthlySummary returns bandwidth usage of a single satellite for current month
+func SatelliteMonthlySummary(ctx context.Context, db DB, satelliteID storj.NodeID) (*Usage, error) {
+	return db.SatelliteSummary(ctx, satelliteID, getBeginningOfMonth(), time.Now())
+}
+
+// MonthlySummaryBySatellite returns bandwidth usage grouped by satellite for current month
+func MonthlySummaryBySatellite(ctx context.Context, db DB) (map[storj.NodeID]*Usage, error) {
+	return db.SummaryBySatellite(ctx, getBeginningOfMonth(), time.Now())
+}
+
 func getBeginningOfMonth() time.Time {
 	t := time.Now()
 	y, m, _ := t.Date()
@@ -4,30 +4,193 @@
 package collector
 
 import (
+	"context"
+	"os"
+	"sync"
 	"time"
 
+	"github.com/zeebo/errs"
 	"go.uber.org/zap"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
 
+	"storj.io/storj/internal/sync2"
 	"storj.io/storj/storagenode/pieces"
 )
 
+var (
+	mon = monkit.Package()
+
+	// Error is the default error class for collector errors
+	Error = errs.Class("piece collector")
+)
+
 // Config defines parameters for storage node Collector.
 type Config struct {
-	Interval time.Duration
+	Interval       time.Duration `help:"how frequently expired pieces are collected" default:"1h0m0s"`
+	BatchSize      int           `help:"how many expired pieces are deleted in a single batch" default:"1000"`
+	TrashRetention time.Duration `help:"how long deleted pieces are kept in the trash before they are permanently deleted" default:"168h0m0s"`
+}
+
+// Stats contains the totals collected since the service started.
+type Stats struct {
+	LastRun       time.Time
+	DeletedPieces int64
+	FreedBytes    int64
 }
 
-// Service implements collecting expired pieces on the storage node.
+// Service implements collecting expired and trashed pieces on the storage node.
 type Service struct {
-	log        *zap.Logger
-	pieces     *pieces.Store
-	pieceinfos pieces.DB
+	log            *zap.Logger
+	pieces         *pieces.Store
+	pieceinfos     pieces.DB
+	batchSize      int
+	trashRetention time.Duration
+
+	Loop sync2.Cycle
+
+	mu    sync.Mutex
+	stats Stats
 }
 
 // NewService creates a new collector service.
-func NewService(log *zap.Logger, pieces *pieces.Store, pieceinfos pieces.DB) *Service {
+func NewService(log *zap.Logger, pieces *pieces.Store, pieceinfos pieces.DB, config Config) *Service {
+	batchSize := config.BatchSize
+	if batchSize <= 0 {
+		batchSize = 1000
+	}
 	return &Service{
-		log:        log,
-		pieces:     pieces,
-		pieceinfos: pieceinfos,
+		log:            log,
+		pieces:         pieces,
+		pieceinfos:     pieceinfos,
+		batchSize:      batchSize,
+		trashRetention: config.TrashRetention,
+		Loop:           *sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run runs collector service.
+func (service *Service) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return service.Loop.Run(ctx, func(ctx context.Context) error {
+		now := time.Now()
+
+		_, _, err := service.Collect(ctx, now)
+		if err != nil {
+			service.log.Error("error during collecting pieces: ", zap.Error(err))
+		}
+
+		_, _, err = service.EmptyTrash(ctx, now.Add(-service.trashRetention))
+		if err != nil {
+			service.log.Error("error during emptying trash: ", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Collect deletes pieces that expired before now and returns how many pieces were deleted and how many bytes were freed.
+func (service *Service) Collect(ctx context.Context, now time.Time) (deleted, freed int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	defer func() {
+		service.mu.Lock()
+		service.stats.LastRun = now
+		service.stats.DeletedPieces += deleted
+		service.stats.FreedBytes += freed
+		service.mu.Unlock()
+
+		mon.IntVal("expired_pieces_deleted").Observe(deleted)
+		mon.IntVal("expired_bytes_freed").Observe(freed)
+		if deleted > 0 {
+			service.log.Info("collected expired pieces", zap.Int64("count", deleted), zap.Int64("bytes", freed))
+		}
+	}()
+
+	expiredAt := now.UTC()
+
+	// pieces that couldn't be deleted stay in the database, skip past them to the next pieces
+	var failed int64
+	for {
+		infos, err := service.pieceinfos.GetExpired(ctx, expiredAt, int64(service.batchSize), failed)
+		if err != nil {
+			return deleted, freed, Error.Wrap(err)
+		}
+
+		for _, info := range infos {
+			// a missing blob only needs its piece info removed and doesn't free any space
+			removed := true
+			reader, err := service.pieces.Reader(ctx, info.SatelliteID, info.PieceID)
+			if err == nil {
+				err = reader.Close()
+			}
+			if os.IsNotExist(errs.Unwrap(err)) {
+				removed = false
+			}
+
+			err = service.pieces.Delete(ctx, info.SatelliteID, info.PieceID)
+			if err != nil && !os.IsNotExist(errs.Unwrap(err)) {
+				service.log.Warn("unable to delete expired piece",
+					zap.Stringer("satellite ID", info.SatelliteID),
+					zap.Stringer("piece ID", info.PieceID),
+					zap.Error(err))
+				failed++
+				continue
+			}
+
+			err = service.pieceinfos.DeleteExpired(ctx, expiredAt, info.SatelliteID, info.PieceID)
+			if err != nil {
+				service.log.Warn("unable to delete expired piece info",
+					zap.Stringer("satellite ID", info.SatelliteID),
+					zap.Stringer("piece ID", info.PieceID),
+					zap.Error(err))
+				failed++
+			} else {
+				deleted++
+			}
+
+			if removed {
+				freed += info.PieceSize
+			}
+		}
+
+		if len(infos) < service.batchSize {
+			return deleted, freed, nil
+		}
 	}
 }
+
+// EmptyTrash permanently deletes pieces trashed before trashedBefore and returns how many pieces were deleted and how many bytes were freed.
+func (service *Service) EmptyTrash(ctx context.Context, trashedBefore time.Time) (deleted, freed int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	defer func() {
+		mon.IntVal("trash_pieces_deleted").Observe(deleted)
+		mon.IntVal("trash_bytes_freed").Observe(freed)
+		if deleted > 0 || freed > 0 {
+			service.log.Info("emptied trash", zap.Int64("count", deleted), zap.Int64("bytes", freed))
+		}
+	}()
+
+	// piece information is deleted first, a failure afterwards only leaves orphaned blobs behind
+	deleted, err = service.pieceinfos.DeleteTrash(ctx, trashedBefore)
+	if err != nil {
+		return 0, 0, Error.Wrap(err)
+	}
+
+	freed, err = service.pieces.EmptyTrash(ctx, trashedBefore)
+	if err != nil {
+		return deleted, freed, Error.Wrap(err)
+	}
+	return deleted, freed, nil
+}
+
+// Stats returns the totals collected since the service started.
+func (service *Service) Stats() Stats {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	return service.stats
+}
+
+// Close stops the collector service.
+func (service *Service) Close() (err error) {
+	service.Loop.Close()
+	return nil
+}
@@ -0,0 +1,272 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package collector_test
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testidentity"
+	"storj.io/storj/pkg/auth/signing"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/collector"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/storagenodedb/storagenodedbtest"
+)
+
+func TestCollect(t *testing.T) {
+	storagenodedbtest.Run(t, func(t *testing.T, db storagenode.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		log := zaptest.NewLogger(t)
+		store := pieces.NewStore(log, db.Pieces())
+		pieceinfos := db.PieceInfo()
+
+		satellite := testidentity.MustPregeneratedSignedIdentity(0, storj.LatestIDVersion())
+		uplink := testidentity.MustPregeneratedSignedIdentity(1, storj.LatestIDVersion())
+
+		now := time.Now().UTC()
+		expired := now.Add(-time.Hour)
+		valid := now.Add(time.Hour)
+
+		addPiece := func(size int64, expiration time.Time, withBlob bool) storj.PieceID {
+			pieceID := storj.NewPieceID()
+
+			if withBlob {
+				writer, err := store.Writer(ctx, satellite.ID, pieceID)
+				require.NoError(t, err)
+				_, err = writer.Write(make([]byte, size))
+				require.NoError(t, err)
+				require.NoError(t, writer.Commit())
+			}
+
+			hash, err := signing.SignPieceHash(
+				signing.SignerFromFullIdentity(uplink),
+				&pb.PieceHash{
+					PieceId: pieceID,
+					Hash:    []byte{1, 2, 3},
+				})
+			require.NoError(t, err)
+
+			require.NoError(t, pieceinfos.Add(ctx, &pieces.Info{
+				SatelliteID:     satellite.ID,
+				PieceID:         pieceID,
+				PieceSize:       size,
+				PieceCreation:   now.Add(-2 * time.Hour),
+				PieceExpiration: &expiration,
+				UplinkPieceHash: hash,
+				Uplink:          uplink.PeerIdentity(),
+			}))
+			return pieceID
+		}
+
+		expiredPieces := []storj.PieceID{
+			addPiece(100, expired, true),
+			addPiece(200, expired, true),
+			// the blob of this piece is already gone
+			addPiece(300, expired, false),
+		}
+		validPiece := addPiece(400, valid, true)
+
+		service := collector.NewService(log, store, pieceinfos, collector.Config{
+			Interval:  time.Hour,
+			BatchSize: 2,
+		})
+
+		deleted, freed, err := service.Collect(ctx, now)
+		require.NoError(t, err)
+		assert.EqualValues(t, 3, deleted)
+		// the missing blob didn't free any space
+		assert.EqualValues(t, 300, freed)
+
+		for _, pieceID := range expiredPieces {
+			_, err := pieceinfos.Get(ctx, satellite.ID, pieceID)
+			require.Error(t, err)
+
+			_, err = store.Reader(ctx, satellite.ID, pieceID)
+			require.Error(t, err)
+		}
+
+		_, err = pieceinfos.Get(ctx, satellite.ID, validPiece)
+		require.NoError(t, err)
+
+		reader, err := store.Reader(ctx, satellite.ID, validPiece)
+		require.NoError(t, err)
+		require.NoError(t, reader.Close())
+
+		// nothing is left to collect
+		deleted, freed, err = service.Collect(ctx, now)
+		require.NoError(t, err)
+		assert.Zero(t, deleted)
+		assert.Zero(t, freed)
+
+		stats := service.Stats()
+		assert.Equal(t, now, stats.LastRun)
+		assert.EqualValues(t, 3, stats.DeletedPieces)
+		assert.EqualValues(t, 300, stats.FreedBytes)
+	})
+}
+
+// failingPieceInfos fails to delete the piece information of the failing pieces
+type failingPieceInfos struct {
+	pieces.DB
+	failing map[storj.PieceID]bool
+}
+
+func (db *failingPieceInfos) DeleteExpired(ctx context.Context, expiredAt time.Time, satelliteID storj.NodeID, pieceID storj.PieceID) error {
+	if db.failing[pieceID] {
+		return errs.New("failing piece")
+	}
+	return db.DB.DeleteExpired(ctx, expiredAt, satelliteID, pieceID)
+}
+
+func TestCollectSkipsFailingPieces(t *testing.T) {
+	storagenodedbtest.Run(t, func(t *testing.T, db storagenode.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		log := zaptest.NewLogger(t)
+		store := pieces.NewStore(log, db.Pieces())
+
+		satellite := testidentity.MustPregeneratedSignedIdentity(0, storj.LatestIDVersion())
+		uplink := testidentity.MustPregeneratedSignedIdentity(1, storj.LatestIDVersion())
+
+		now := time.Now().UTC()
+		expired := now.Add(-time.Hour)
+
+		var pieceIDs []storj.PieceID
+		for i := 0; i < 5; i++ {
+			pieceID := storj.NewPieceID()
+			hash, err := signing.SignPieceHash(
+				signing.SignerFromFullIdentity(uplink),
+				&pb.PieceHash{
+					PieceId: pieceID,
+					Hash:    []byte{1, 2, 3},
+				})
+			require.NoError(t, err)
+
+			require.NoError(t, db.PieceInfo().Add(ctx, &pieces.Info{
+				SatelliteID:     satellite.ID,
+				PieceID:         pieceID,
+				PieceSize:       100,
+				PieceCreation:   now.Add(-2 * time.Hour),
+				PieceExpiration: &expired,
+				UplinkPieceHash: hash,
+				Uplink:          uplink.PeerIdentity(),
+			}))
+			pieceIDs = append(pieceIDs, pieceID)
+		}
+		sort.Slice(pieceIDs, func(i, k int) bool {
+			return bytes.Compare(pieceIDs[i][:], pieceIDs[k][:]) < 0
+		})
+
+		// the whole first batch fails
+		pieceinfos := &failingPieceInfos{
+			DB: db.PieceInfo(),
+			failing: map[storj.PieceID]bool{
+				pieceIDs[0]: true,
+				pieceIDs[1]: true,
+			},
+		}
+
+		service := collector.NewService(log, store, pieceinfos, collector.Config{
+			Interval:  time.Hour,
+			BatchSize: 2,
+		})
+
+		deleted, freed, err := service.Collect(ctx, now)
+		require.NoError(t, err)
+		assert.EqualValues(t, 3, deleted)
+		assert.Zero(t, freed)
+
+		for i, pieceID := range pieceIDs {
+			_, err := db.PieceInfo().Get(ctx, satellite.ID, pieceID)
+			if pieceinfos.failing[pieceID] {
+				require.NoError(t, err, i)
+			} else {
+				require.Error(t, err, i)
+			}
+		}
+	})
+}
+
+func TestEmptyTrash(t *testing.T) {
+	storagenodedbtest.Run(t, func(t *testing.T, db storagenode.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		log := zaptest.NewLogger(t)
+		store := pieces.NewStore(log, db.Pieces())
+		pieceinfos := db.PieceInfo()
+
+		satellite := testidentity.MustPregeneratedSignedIdentity(0, storj.LatestIDVersion())
+		uplink := testidentity.MustPregeneratedSignedIdentity(1, storj.LatestIDVersion())
+
+		now := time.Now()
+		pieceID := storj.NewPieceID()
+
+		writer, err := store.Writer(ctx, satellite.ID, pieceID)
+		require.NoError(t, err)
+		_, err = writer.Write(make([]byte, 100))
+		require.NoError(t, err)
+		require.NoError(t, writer.Commit())
+
+		hash, err := signing.SignPieceHash(
+			signing.SignerFromFullIdentity(uplink),
+			&pb.PieceHash{
+				PieceId: pieceID,
+				Hash:    []byte{1, 2, 3},
+			})
+		require.NoError(t, err)
+
+		require.NoError(t, pieceinfos.Add(ctx, &pieces.Info{
+			SatelliteID:     satellite.ID,
+			PieceID:         pieceID,
+			PieceSize:       100,
+			PieceCreation:   now.Add(-time.Hour),
+			UplinkPieceHash: hash,
+			Uplink:          uplink.PeerIdentity(),
+		}))
+
+		require.NoError(t, pieceinfos.Trash(ctx, satellite.ID, pieceID, now))
+		require.NoError(t, store.Trash(ctx, satellite.ID, pieceID))
+
+		service := collector.NewService(log, store, pieceinfos, collector.Config{
+			Interval:       time.Hour,
+			BatchSize:      100,
+			TrashRetention: time.Hour,
+		})
+
+		// the piece is still within the retention period
+		deleted, freed, err := service.EmptyTrash(ctx, now.Add(-time.Hour))
+		require.NoError(t, err)
+		assert.Zero(t, deleted)
+		assert.Zero(t, freed)
+
+		deleted, freed, err = service.EmptyTrash(ctx, now.Add(time.Second))
+		require.NoError(t, err)
+		assert.EqualValues(t, 1, deleted)
+		assert.EqualValues(t, 100, freed)
+
+		restored, err := store.RestoreTrash(ctx, satellite.ID)
+		require.NoError(t, err)
+		assert.Zero(t, restored)
+
+		restored, err = pieceinfos.RestoreTrash(ctx, satellite.ID)
+		require.NoError(t, err)
+		assert.Zero(t, restored)
+	})
+}
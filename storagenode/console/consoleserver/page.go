@@ -0,0 +1,125 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleserver
+
+// indexPage is the dashboard served when no static directory is configured
+const indexPage = `<!DOCTYPE html>
+<html>
+<head>
+	<meta charset="utf-8">
+	<title>Storage Node Dashboard</title>
+	<style>
+		body { font-family: sans-serif; margin: 2em; color: #333; }
+		table { border-collapse: collapse; margin-bottom: 2em; }
+		th, td { padding: 0.3em 1em; border-bottom: 1px solid #ddd; text-align: left; }
+		.bad { color: #c00; }
+		.good { color: #080; }
+	</style>
+</head>
+<body>
+	<h1>Storage Node Dashboard</h1>
+	<table id="overview"></table>
+
+	<h2>Bandwidth this month</h2>
+	<table id="bandwidth"></table>
+
+	<h2>Satellites</h2>
+	<table id="satellites"></table>
+
+	<h2>Orders</h2>
+	<table id="orders"></table>
+
+	<script>
+		function size(bytes) {
+			var units = ["B", "KB", "MB", "GB", "TB", "PB"];
+			var i = 0;
+			while (Math.abs(bytes) >= 1000 && i < units.length - 1) {
+				bytes /= 1000;
+				i++;
+			}
+			return bytes.toFixed(i == 0 ? 0 : 2) + " " + units[i];
+		}
+
+		function row(cells, header) {
+			var tr = document.createElement("tr");
+			cells.forEach(function(cell) {
+				var td = document.createElement(header ? "th" : "td");
+				if (cell instanceof Node) {
+					td.appendChild(cell);
+				} else {
+					td.textContent = cell;
+				}
+				tr.appendChild(td);
+			});
+			return tr;
+		}
+
+		function status(text, good) {
+			var span = document.createElement("span");
+			span.className = good ? "good" : "bad";
+			span.textContent = text;
+			return span;
+		}
+
+		function fill(id, header, rows) {
+			var table = document.getElementById(id);
+			table.innerHTML = "";
+			if (header) {
+				table.appendChild(row(header, true));
+			}
+			rows.forEach(function(cells) { table.appendChild(row(cells)); });
+		}
+
+		function usage(u) {
+			return [size(u.put), size(u.get), size(u.getAudit), size(u.getRepair), size(u.putRepair), size(u.delete), size(u.total)];
+		}
+
+		var usageHeader = ["Put", "Get", "Audit", "Get Repair", "Put Repair", "Delete", "Total"];
+
+		function load(path, fn) {
+			fetch(path).then(function(response) {
+				if (!response.ok) {
+					throw new Error(response.statusText);
+				}
+				return response.json();
+			}).then(fn).catch(function(err) { console.error(path, err); });
+		}
+
+		function refresh() {
+			load("/api/dashboard", function(data) {
+				var version = data.version.major + "." + data.version.minor + "." + data.version.patch;
+				fill("overview", null, [
+					["Node ID", data.nodeID],
+					["Wallet", data.wallet],
+					["Version", status(version + (data.versionAllowed ? "" : " (outdated)"), data.versionAllowed)],
+					["Uptime", data.uptime],
+					["Last Pinged", new Date(data.lastPinged).toLocaleString()],
+					["Disk", size(data.diskSpace.used) + " of " + size(data.diskSpace.allocated)],
+					["Free on Disk", status(size(data.disk.free) + (data.disk.full ? " (full, uploads refused)" : ""), !data.disk.full)],
+					["Stored on Disk", status(size(data.disk.stored) + (data.disk.discrepancy ? " (disagrees with used space)" : ""), !data.disk.discrepancy)],
+					["Bandwidth", size(data.bandwidth.used) + " of " + size(data.bandwidth.allocated)],
+				]);
+				fill("bandwidth", usageHeader, [usage(data.usage)]);
+			});
+			load("/api/satellites", function(data) {
+				fill("satellites", ["Satellite", "Disk"].concat(usageHeader, ["Accepted Orders", "Rejected Orders"]),
+					data.map(function(s) {
+						return [s.satelliteID, size(s.usedSpace)].concat(usage(s.usage), [s.ordersAccepted, s.ordersRejected]);
+					}));
+			});
+			load("/api/orders?limit=50", function(data) {
+				fill("orders", ["Satellite", "Serial Number", "Action", "Amount", "Status", "Archived At"],
+					data.map(function(o) {
+						return [o.satelliteID, o.serialNumber, o.action, size(o.amount),
+							status(o.status, o.status == "accepted"), new Date(o.archivedAt).toLocaleString()];
+					}));
+			});
+		}
+
+		refresh();
+		setInterval(refresh, 30000);
+	</script>
+</body>
+</html>
+`
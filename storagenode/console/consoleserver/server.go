@@ -0,0 +1,155 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleserver
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"path/filepath"
+	"strconv"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	"storj.io/storj/storagenode/console"
+)
+
+const (
+	contentType = "Content-Type"
+
+	applicationJSON = "application/json"
+	textHTML        = "text/html; charset=utf-8"
+)
+
+// Error is storagenode console web error type
+var Error = errs.Class("storagenode console web error")
+
+// Config contains configuration for storagenode console web server
+type Config struct {
+	Address   string `help:"server address of the api gateway and frontend app" default:"127.0.0.1:14002"`
+	StaticDir string `help:"path to static resources, the embedded dashboard is used when empty" default:""`
+}
+
+// Server represents storagenode console web server
+type Server struct {
+	log *zap.Logger
+
+	config   Config
+	service  *console.Service
+	listener net.Listener
+
+	server http.Server
+}
+
+// NewServer creates new instance of storagenode console web server
+func NewServer(logger *zap.Logger, config Config, service *console.Service, listener net.Listener) *Server {
+	server := Server{
+		log:      logger,
+		service:  service,
+		config:   config,
+		listener: listener,
+	}
+
+	mux := http.NewServeMux()
+
+	mux.Handle("/api/dashboard", http.HandlerFunc(server.dashboardHandler))
+	mux.Handle("/api/satellites", http.HandlerFunc(server.satellitesHandler))
+	mux.Handle("/api/orders", http.HandlerFunc(server.ordersHandler))
+
+	if server.config.StaticDir != "" {
+		fs := http.FileServer(http.Dir(server.config.StaticDir))
+		mux.Handle("/static/", http.StripPrefix("/static", fs))
+	}
+	mux.Handle("/", http.HandlerFunc(server.appHandler))
+
+	server.server = http.Server{
+		Handler: mux,
+	}
+
+	return &server
+}
+
+// appHandler is web app http handler function
+func (s *Server) appHandler(w http.ResponseWriter, req *http.Request) {
+	if req.URL.Path != "/" {
+		http.NotFound(w, req)
+		return
+	}
+
+	if s.config.StaticDir != "" {
+		http.ServeFile(w, req, filepath.Join(s.config.StaticDir, "index.html"))
+		return
+	}
+
+	w.Header().Set(contentType, textHTML)
+	if _, err := w.Write([]byte(indexPage)); err != nil {
+		s.log.Error("failed to write dashboard page", zap.Error(err))
+	}
+}
+
+// dashboardHandler returns the overview of the node
+func (s *Server) dashboardHandler(w http.ResponseWriter, req *http.Request) {
+	dashboard, err := s.service.GetDashboard(req.Context())
+	s.serveJSON(w, dashboard, err)
+}
+
+// satellitesHandler returns the stats of every satellite
+func (s *Server) satellitesHandler(w http.ResponseWriter, req *http.Request) {
+	satellites, err := s.service.GetSatellites(req.Context())
+	s.serveJSON(w, satellites, err)
+}
+
+// ordersHandler returns the most recently settled orders, limited by the limit query parameter
+func (s *Server) ordersHandler(w http.ResponseWriter, req *http.Request) {
+	var limit int
+	if value := req.URL.Query().Get("limit"); value != "" {
+		var err error
+		limit, err = strconv.Atoi(value)
+		if err != nil {
+			http.Error(w, "invalid limit", http.StatusBadRequest)
+			return
+		}
+	}
+
+	list, err := s.service.GetOrders(req.Context(), limit)
+	s.serveJSON(w, list, err)
+}
+
+// serveJSON writes data as json or an internal error when err is not nil
+func (s *Server) serveJSON(w http.ResponseWriter, data interface{}, err error) {
+	if err != nil {
+		s.log.Error("console request failed", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(contentType, applicationJSON)
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		s.log.Error("failed to encode response", zap.Error(err))
+	}
+}
+
+// Run starts the server that host webapp and api endpoint
+func (s *Server) Run(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	var group errgroup.Group
+	group.Go(func() error {
+		<-ctx.Done()
+		return s.server.Shutdown(nil)
+	})
+	group.Go(func() error {
+		defer cancel()
+		return s.server.Serve(s.listener)
+	})
+
+	return group.Wait()
+}
+
+// Close closes server and underlying listener
+func (s *Server) Close() error {
+	return s.server.Close()
+}
@@ -0,0 +1,83 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleserver_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/storagenode/console"
+)
+
+func TestServer(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		node := planet.StorageNodes[0]
+		satellite := planet.Satellites[0]
+
+		err := node.DB.Bandwidth().Add(ctx, satellite.ID(), pb.PieceAction_GET, 1000, time.Now())
+		require.NoError(t, err)
+
+		address := "http://" + node.Console.Listener.Addr().String()
+
+		get := func(path string, data interface{}) {
+			response, err := http.Get(address + path)
+			require.NoError(t, err)
+			defer ctx.Check(response.Body.Close)
+
+			require.Equal(t, http.StatusOK, response.StatusCode)
+			if data != nil {
+				require.NoError(t, json.NewDecoder(response.Body).Decode(data))
+			}
+		}
+
+		// node IDs are decoded as strings, since storj.NodeID.UnmarshalJSON expects unquoted input
+		var dashboard struct {
+			console.Dashboard
+			NodeID string `json:"nodeID"`
+		}
+		get("/api/dashboard", &dashboard)
+		assert.Equal(t, node.ID().String(), dashboard.NodeID)
+		assert.True(t, dashboard.VersionAllowed)
+		assert.EqualValues(t, 1000, dashboard.Bandwidth.Used)
+		assert.EqualValues(t, 1000, dashboard.Usage.Get)
+		assert.NotZero(t, dashboard.DiskSpace.Allocated)
+
+		var satellites []struct {
+			console.SatelliteStats
+			SatelliteID string `json:"satelliteID"`
+		}
+		get("/api/satellites", &satellites)
+		require.Len(t, satellites, 1)
+		assert.Equal(t, satellite.ID().String(), satellites[0].SatelliteID)
+		assert.EqualValues(t, 1000, satellites[0].Usage.Total)
+
+		var orders []json.RawMessage
+		get("/api/orders?limit=10", &orders)
+		assert.Empty(t, orders)
+
+		response, err := http.Get(address + "/api/orders?limit=x")
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+		require.NoError(t, response.Body.Close())
+
+		response, err = http.Get(address + "/")
+		require.NoError(t, err)
+		page, err := ioutil.ReadAll(response.Body)
+		require.NoError(t, err)
+		require.NoError(t, response.Body.Close())
+		assert.Equal(t, http.StatusOK, response.StatusCode)
+		assert.Contains(t, string(page), "Storage Node Dashboard")
+	})
+}
@@ -0,0 +1,288 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/version"
+	"storj.io/storj/pkg/kademlia"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storagenode/bandwidth"
+	"storj.io/storj/storagenode/monitor"
+	"storj.io/storj/storagenode/orders"
+	"storj.io/storj/storagenode/pieces"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is the default error class for storage node console errors
+	Error = errs.Class("storagenode console")
+)
+
+// maxOrders is the maximum number of archived orders that are inspected
+const maxOrders = 1000
+
+// Service is handling storage node dashboard related logic
+type Service struct {
+	log *zap.Logger
+
+	bandwidth bandwidth.DB
+	pieceInfo pieces.DB
+	orders    orders.DB
+	kademlia  *kademlia.Kademlia
+	monitor   *monitor.Service
+
+	version     *version.Service
+	versionInfo version.Info
+
+	allocatedBandwidth memory.Size
+	allocatedDiskSpace memory.Size
+
+	startTime time.Time
+}
+
+// NewService returns new instance of Service
+func NewService(log *zap.Logger, bandwidth bandwidth.DB, pieceInfo pieces.DB, orders orders.DB, kademlia *kademlia.Kademlia, monitor *monitor.Service, version *version.Service, versionInfo version.Info, allocatedBandwidth, allocatedDiskSpace memory.Size) *Service {
+	return &Service{
+		log:                log,
+		bandwidth:          bandwidth,
+		pieceInfo:          pieceInfo,
+		orders:             orders,
+		kademlia:           kademlia,
+		monitor:            monitor,
+		version:            version,
+		versionInfo:        versionInfo,
+		allocatedBandwidth: allocatedBandwidth,
+		allocatedDiskSpace: allocatedDiskSpace,
+		startTime:          time.Now(),
+	}
+}
+
+// Dashboard contains the overview of the storage node
+type Dashboard struct {
+	NodeID     storj.NodeID `json:"nodeID"`
+	Wallet     string       `json:"wallet"`
+	StartedAt  time.Time    `json:"startedAt"`
+	Uptime     string       `json:"uptime"`
+	LastPinged time.Time    `json:"lastPinged"`
+
+	Version        version.SemVer `json:"version"`
+	VersionAllowed bool           `json:"versionAllowed"`
+
+	DiskSpace Space          `json:"diskSpace"`
+	Disk      DiskStatus     `json:"disk"`
+	Bandwidth Space          `json:"bandwidth"`
+	Usage     BandwidthUsage `json:"usage"`
+}
+
+// DiskStatus contains the result of the last check of the disk
+type DiskStatus struct {
+	CheckedAt   time.Time `json:"checkedAt"`
+	Free        int64     `json:"free"`
+	Stored      int64     `json:"stored"`
+	Full        bool      `json:"full"`
+	Discrepancy bool      `json:"discrepancy"`
+}
+
+// Space contains used and allocated amount of a resource in bytes
+type Space struct {
+	Used      int64 `json:"used"`
+	Allocated int64 `json:"allocated"`
+}
+
+// BandwidthUsage contains bandwidth used by action in bytes
+type BandwidthUsage struct {
+	Put       int64 `json:"put"`
+	Get       int64 `json:"get"`
+	GetAudit  int64 `json:"getAudit"`
+	GetRepair int64 `json:"getRepair"`
+	PutRepair int64 `json:"putRepair"`
+	Delete    int64 `json:"delete"`
+	Total     int64 `json:"total"`
+}
+
+// SatelliteStats contains the used space and monthly bandwidth of a single satellite and
+// the settlement status of its most recently archived orders
+type SatelliteStats struct {
+	SatelliteID    storj.NodeID   `json:"satelliteID"`
+	UsedSpace      int64          `json:"usedSpace"`
+	Usage          BandwidthUsage `json:"usage"`
+	OrdersAccepted int64          `json:"ordersAccepted"`
+	OrdersRejected int64          `json:"ordersRejected"`
+}
+
+// Order contains the settlement status of an archived order
+type Order struct {
+	SatelliteID  storj.NodeID       `json:"satelliteID"`
+	SerialNumber storj.SerialNumber `json:"serialNumber"`
+	Action       string             `json:"action"`
+	Amount       int64              `json:"amount"`
+	Status       string             `json:"status"`
+	ArchivedAt   time.Time          `json:"archivedAt"`
+}
+
+// GetDashboard returns the overview of the storage node
+func (s *Service) GetDashboard(ctx context.Context) (_ *Dashboard, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	usedSpace, err := s.pieceInfo.SpaceUsed(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	usage, err := bandwidth.TotalMonthlySummary(ctx, s.bandwidth)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	disk := s.monitor.DiskStatus()
+
+	local := s.kademlia.Local()
+	return &Dashboard{
+		NodeID:     local.Id,
+		Wallet:     local.GetMetadata().GetWallet(),
+		StartedAt:  s.startTime,
+		Uptime:     time.Since(s.startTime).Truncate(time.Second).String(),
+		LastPinged: s.kademlia.LastPinged(),
+
+		Version:        s.versionInfo.Version,
+		VersionAllowed: s.version.IsAllowed(),
+
+		DiskSpace: Space{
+			Used:      usedSpace,
+			Allocated: s.allocatedDiskSpace.Int64(),
+		},
+		Disk: DiskStatus{
+			CheckedAt:   disk.CheckedAt,
+			Free:        disk.Free,
+			Stored:      disk.UsedOnDisk,
+			Full:        disk.Full,
+			Discrepancy: disk.Discrepancy,
+		},
+		Bandwidth: Space{
+			Used:      usage.Total(),
+			Allocated: s.allocatedBandwidth.Int64(),
+		},
+		Usage: fromUsage(usage),
+	}, nil
+}
+
+// GetSatellites returns the stats of every satellite the node worked with this month, ordered by satellite ID
+func (s *Service) GetSatellites(ctx context.Context) (_ []SatelliteStats, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	usages, err := bandwidth.MonthlySummaryBySatellite(ctx, s.bandwidth)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	spaceUsed, err := s.pieceInfo.SpaceUsedBySatellite(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	stats := make(map[storj.NodeID]*SatelliteStats)
+	get := func(satelliteID storj.NodeID) *SatelliteStats {
+		satellite, ok := stats[satelliteID]
+		if !ok {
+			satellite = &SatelliteStats{SatelliteID: satelliteID}
+			stats[satelliteID] = satellite
+		}
+		return satellite
+	}
+
+	for satelliteID, usage := range usages {
+		get(satelliteID).Usage = fromUsage(usage)
+	}
+	for satelliteID, used := range spaceUsed {
+		get(satelliteID).UsedSpace = used
+	}
+
+	archived, err := s.orders.ListArchived(ctx, maxOrders)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	for _, order := range archived {
+		satellite := get(order.Limit.SatelliteId)
+		switch order.Status {
+		case orders.StatusAccepted:
+			satellite.OrdersAccepted++
+		case orders.StatusRejected:
+			satellite.OrdersRejected++
+		}
+	}
+
+	satellites := make([]SatelliteStats, 0, len(stats))
+	for _, satellite := range stats {
+		satellites = append(satellites, *satellite)
+	}
+	sort.Slice(satellites, func(i, k int) bool {
+		return satellites[i].SatelliteID.Less(satellites[k].SatelliteID)
+	})
+	return satellites, nil
+}
+
+// GetOrders returns at most limit orders that were sent to satellites
+func (s *Service) GetOrders(ctx context.Context, limit int) (_ []Order, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if limit <= 0 || limit > maxOrders {
+		limit = maxOrders
+	}
+
+	archived, err := s.orders.ListArchived(ctx, limit)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	list := make([]Order, 0, len(archived))
+	for _, order := range archived {
+		list = append(list, Order{
+			SatelliteID:  order.Limit.SatelliteId,
+			SerialNumber: order.Limit.SerialNumber,
+			Action:       order.Limit.Action.String(),
+			Amount:       order.Order.Amount,
+			Status:       statusString(order.Status),
+			ArchivedAt:   order.ArchivedAt,
+		})
+	}
+	return list, nil
+}
+
+// fromUsage converts bandwidth usage to its dashboard representation
+func fromUsage(usage *bandwidth.Usage) BandwidthUsage {
+	return BandwidthUsage{
+		Put:       usage.Put,
+		Get:       usage.Get,
+		GetAudit:  usage.GetAudit,
+		GetRepair: usage.GetRepair,
+		PutRepair: usage.PutRepair,
+		Delete:    usage.Delete,
+		Total:     usage.Total(),
+	}
+}
+
+// statusString returns the name of the order status
+func statusString(status orders.Status) string {
+	switch status {
+	case orders.StatusUnsent:
+		return "unsent"
+	case orders.StatusAccepted:
+		return "accepted"
+	case orders.StatusRejected:
+		return "rejected"
+	case orders.StatusFailed:
+		return "failed"
+	default:
+		return "unknown"
+	}
+}
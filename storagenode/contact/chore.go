@@ -0,0 +1,184 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package contact
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/internal/sync2"
+	"storj.io/storj/pkg/kademlia"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/transport"
+	"storj.io/storj/storagenode/monitor"
+	"storj.io/storj/storagenode/trust"
+)
+
+var (
+	// Error is the default error class for contact package
+	Error = errs.Class("contact")
+
+	mon = monkit.Package()
+)
+
+// Config contains configurable values for contacting satellites
+type Config struct {
+	Interval time.Duration `help:"how frequently the node checks in with its trusted satellites" default:"30m0s"`
+	Timeout  time.Duration `help:"timeout for a single check-in with a satellite" default:"1m0s"`
+}
+
+// Chore periodically checks in with all trusted satellites
+type Chore struct {
+	log    *zap.Logger
+	config Config
+
+	transport transport.Client
+	kademlia  *kademlia.Kademlia
+	trust     *trust.Pool
+	monitor   *monitor.Service
+	operator  pb.NodeOperator
+
+	Loop sync2.Cycle
+}
+
+// NewChore creates a new contact chore
+func NewChore(log *zap.Logger, transport transport.Client, kademlia *kademlia.Kademlia, trust *trust.Pool, monitor *monitor.Service, operator pb.NodeOperator, config Config) *Chore {
+	return &Chore{
+		log:    log,
+		config: config,
+
+		transport: transport,
+		kademlia:  kademlia,
+		trust:     trust,
+		monitor:   monitor,
+		operator:  operator,
+
+		Loop: *sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run checks in with all trusted satellites on every interval
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// satellites can't be found on the network before bootstrapping
+	chore.kademlia.WaitForBootstrap()
+
+	return chore.Loop.Run(ctx, func(ctx context.Context) error {
+		request, err := chore.checkInRequest(ctx)
+		if err != nil {
+			chore.log.Error("unable to collect node information", zap.Error(err))
+			return nil
+		}
+
+		var group errgroup.Group
+		for _, satelliteID := range chore.trust.GetSatellites(ctx) {
+			satelliteID := satelliteID
+			group.Go(func() error {
+				ctx, cancel := context.WithTimeout(ctx, chore.config.Timeout)
+				defer cancel()
+
+				request, err := chore.satelliteCheckInRequest(ctx, satelliteID, request)
+				if err != nil {
+					chore.log.Error("unable to collect satellite capacity", zap.Stringer("Satellite ID", satelliteID), zap.Error(err))
+					return nil
+				}
+
+				err = chore.CheckIn(ctx, satelliteID, request)
+				if err != nil {
+					chore.log.Warn("check-in failed", zap.Stringer("Satellite ID", satelliteID), zap.Error(err))
+				}
+				return nil
+			})
+		}
+		_ = group.Wait() // doesn't return errors
+
+		return nil
+	})
+}
+
+// checkInRequest collects the current node information.
+func (chore *Chore) checkInRequest(ctx context.Context) (*pb.CheckInRequest, error) {
+	self := chore.kademlia.Local()
+
+	freeDisk, err := chore.monitor.AvailableSpace(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	freeBandwidth, err := chore.monitor.AvailableBandwidth(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	operator := chore.operator
+	return &pb.CheckInRequest{
+		Address: self.GetAddress().GetAddress(),
+		Capacity: &pb.NodeCapacity{
+			FreeBandwidth: freeBandwidth,
+			FreeDisk:      freeDisk,
+		},
+		Operator: &operator,
+		Version:  self.GetVersion(),
+	}, nil
+}
+
+// satelliteCheckInRequest limits the capacity of the request to the allocation of the satellite.
+func (chore *Chore) satelliteCheckInRequest(ctx context.Context, satelliteID storj.NodeID, request *pb.CheckInRequest) (*pb.CheckInRequest, error) {
+	if _, ok := chore.monitor.SatelliteLimit(satelliteID); !ok {
+		return request, nil
+	}
+
+	freeDisk, err := chore.monitor.AvailableSpaceForSatellite(ctx, satelliteID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	freeBandwidth, err := chore.monitor.AvailableBandwidthForSatellite(ctx, satelliteID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	limited := *request
+	limited.Capacity = &pb.NodeCapacity{
+		FreeBandwidth: freeBandwidth,
+		FreeDisk:      freeDisk,
+	}
+	return &limited, nil
+}
+
+// CheckIn sends the node information to the specified satellite.
+func (chore *Chore) CheckIn(ctx context.Context, satelliteID storj.NodeID, request *pb.CheckInRequest) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	satellite, err := chore.kademlia.FindNode(ctx, satelliteID)
+	if err != nil {
+		return Error.New("unable to find satellite on the network: %v", err)
+	}
+
+	conn, err := chore.transport.DialNode(ctx, &satellite)
+	if err != nil {
+		return Error.New("unable to connect to the satellite: %v", err)
+	}
+	defer func() { err = errs.Combine(err, conn.Close()) }()
+
+	response, err := pb.NewNodeClient(conn).CheckIn(ctx, request)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if !response.PingNodeSuccess {
+		return Error.New("satellite was unable to reach the node: %s", response.PingErrorMessage)
+	}
+	return nil
+}
+
+// Close stops the contact chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
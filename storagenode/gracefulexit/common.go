@@ -0,0 +1,45 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gracefulexit
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/pkg/kademlia"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/transport"
+)
+
+var (
+	// Error is the default error class for graceful exit package
+	Error = errs.Class("graceful exit")
+
+	mon = monkit.Package()
+)
+
+// Config contains configurable values for graceful exits
+type Config struct {
+	Interval          time.Duration `help:"how frequently the node asks exiting satellites for pieces to transfer" default:"15m0s" devDefault:"10s"`
+	TransferBatchSize int           `help:"the number of transfers requested from a satellite at once" default:"50"`
+	TransferTimeout   time.Duration `help:"timeout for transferring a single piece" default:"10m0s"`
+}
+
+// dialSatellite looks up the satellite on the network and connects to its graceful exit service
+func dialSatellite(ctx context.Context, transport transport.Client, kademlia *kademlia.Kademlia, satelliteID storj.NodeID) (_ pb.SatelliteGracefulExitClient, close func() error, err error) {
+	satellite, err := kademlia.FindNode(ctx, satelliteID)
+	if err != nil {
+		return nil, nil, Error.New("unable to find satellite on the network: %v", err)
+	}
+
+	conn, err := transport.DialNode(ctx, &satellite)
+	if err != nil {
+		return nil, nil, Error.New("unable to connect to the satellite: %v", err)
+	}
+	return pb.NewSatelliteGracefulExitClient(conn), conn.Close, nil
+}
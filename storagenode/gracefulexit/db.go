@@ -0,0 +1,33 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gracefulexit
+
+import (
+	"context"
+	"time"
+
+	"storj.io/storj/pkg/storj"
+)
+
+// ExitProgress is the local state of a graceful exit from a satellite
+type ExitProgress struct {
+	SatelliteID       storj.NodeID
+	InitiatedAt       time.Time
+	FinishedAt        *time.Time
+	Successful        bool
+	PiecesTransferred int64
+	TransfersFailed   int64
+}
+
+// DB tracks the graceful exits of the storage node
+type DB interface {
+	// InitiateExit records the start of an exit, an existing exit is left unchanged
+	InitiateExit(ctx context.Context, satelliteID storj.NodeID, initiatedAt time.Time) error
+	// IncrementProgress adds to the transferred pieces and failed transfers of an exit
+	IncrementProgress(ctx context.Context, satelliteID storj.NodeID, piecesTransferred, transfersFailed int64) error
+	// CompleteExit records the outcome of an exit
+	CompleteExit(ctx context.Context, satelliteID storj.NodeID, finishedAt time.Time, successful bool) error
+	// ListExits returns all exits, finished or not
+	ListExits(ctx context.Context) ([]ExitProgress, error)
+}
@@ -0,0 +1,137 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gracefulexit
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/pkg/kademlia"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/transport"
+	"storj.io/storj/storagenode/trust"
+)
+
+var _ pb.NodeGracefulExitServer = (*Endpoint)(nil)
+
+// Endpoint lets the node operator initiate graceful exits and follow their progress
+type Endpoint struct {
+	log *zap.Logger
+
+	db        DB
+	transport transport.Client
+	kademlia  *kademlia.Kademlia
+	trust     *trust.Pool
+}
+
+// NewEndpoint creates a new graceful exit endpoint
+func NewEndpoint(log *zap.Logger, db DB, transport transport.Client, kademlia *kademlia.Kademlia, trust *trust.Pool) *Endpoint {
+	return &Endpoint{
+		log:       log,
+		db:        db,
+		transport: transport,
+		kademlia:  kademlia,
+		trust:     trust,
+	}
+}
+
+// InitiateGracefulExit asks the satellite to start the exit and records it locally
+func (endpoint *Endpoint) InitiateGracefulExit(ctx context.Context, req *pb.InitiateGracefulExitRequest) (_ *pb.ExitProgress, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := endpoint.trust.VerifySatelliteID(ctx, req.SatelliteId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, Error.Wrap(err).Error())
+	}
+
+	initiatedAt, err := endpoint.initiateExit(ctx, req.SatelliteId)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	err = endpoint.db.InitiateExit(ctx, req.SatelliteId, initiatedAt)
+	if err != nil {
+		return nil, status.Error(codes.Internal, Error.Wrap(err).Error())
+	}
+	endpoint.log.Info("graceful exit initiated", zap.Stringer("Satellite ID", req.SatelliteId))
+
+	exits, err := endpoint.db.ListExits(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, Error.Wrap(err).Error())
+	}
+	for _, exit := range exits {
+		if exit.SatelliteID == req.SatelliteId {
+			return progressToProto(exit)
+		}
+	}
+	return nil, status.Error(codes.Internal, "graceful exit not recorded")
+}
+
+// initiateExit notifies the satellite, which stops sending new pieces to the node
+func (endpoint *Endpoint) initiateExit(ctx context.Context, satelliteID storj.NodeID) (_ time.Time, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	client, closeConn, err := dialSatellite(ctx, endpoint.transport, endpoint.kademlia, satelliteID)
+	if err != nil {
+		return time.Time{}, err
+	}
+	defer func() { err = errs.Combine(err, closeConn()) }()
+
+	response, err := client.InitiateExit(ctx, &pb.InitiateExitRequest{})
+	if err != nil {
+		return time.Time{}, Error.Wrap(err)
+	}
+
+	initiatedAt, err := ptypes.Timestamp(response.ExitInitiatedAt)
+	return initiatedAt, Error.Wrap(err)
+}
+
+// GetExitProgress returns the progress of all graceful exits
+func (endpoint *Endpoint) GetExitProgress(ctx context.Context, req *pb.GetExitProgressRequest) (_ *pb.GetExitProgressResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	exits, err := endpoint.db.ListExits(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, Error.Wrap(err).Error())
+	}
+
+	response := &pb.GetExitProgressResponse{}
+	for _, exit := range exits {
+		progress, err := progressToProto(exit)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		response.Progress = append(response.Progress, progress)
+	}
+	return response, nil
+}
+
+// progressToProto converts the exit progress to its protobuf representation
+func progressToProto(exit ExitProgress) (*pb.ExitProgress, error) {
+	initiatedAt, err := ptypes.TimestampProto(exit.InitiatedAt)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	progress := &pb.ExitProgress{
+		SatelliteId:       exit.SatelliteID,
+		InitiatedAt:       initiatedAt,
+		Successful:        exit.Successful,
+		PiecesTransferred: exit.PiecesTransferred,
+		TransfersFailed:   exit.TransfersFailed,
+	}
+	if exit.FinishedAt != nil {
+		progress.FinishedAt, err = ptypes.TimestampProto(*exit.FinishedAt)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+	}
+	return progress, nil
+}
@@ -0,0 +1,230 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gracefulexit
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/sync2"
+	"storj.io/storj/pkg/auth/signing"
+	"storj.io/storj/pkg/kademlia"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/transport"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/uplink/piecestore"
+)
+
+// Worker transfers the pieces of exiting satellites to the replacement nodes chosen by the satellites
+type Worker struct {
+	log    *zap.Logger
+	config Config
+
+	db        DB
+	transport transport.Client
+	kademlia  *kademlia.Kademlia
+	store     *pieces.Store
+	pieceinfo pieces.DB
+
+	Loop sync2.Cycle
+}
+
+// NewWorker creates a new graceful exit worker
+func NewWorker(log *zap.Logger, db DB, transport transport.Client, kademlia *kademlia.Kademlia, store *pieces.Store, pieceinfo pieces.DB, config Config) *Worker {
+	return &Worker{
+		log:    log,
+		config: config,
+
+		db:        db,
+		transport: transport,
+		kademlia:  kademlia,
+		store:     store,
+		pieceinfo: pieceinfo,
+
+		Loop: *sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run processes the unfinished exits on every interval
+func (worker *Worker) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// satellites can't be found on the network before bootstrapping
+	worker.kademlia.WaitForBootstrap()
+
+	return worker.Loop.Run(ctx, func(ctx context.Context) error {
+		err := worker.ProcessExits(ctx)
+		if err != nil {
+			worker.log.Error("error processing graceful exits", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// ProcessExits transfers the pieces of all unfinished exits
+func (worker *Worker) ProcessExits(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	exits, err := worker.db.ListExits(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	var errlist errs.Group
+	for _, exit := range exits {
+		if exit.FinishedAt != nil {
+			continue
+		}
+		errlist.Add(worker.processExit(ctx, exit.SatelliteID))
+	}
+	return errlist.Err()
+}
+
+// processExit transfers pieces until the satellite has nothing left to hand out
+func (worker *Worker) processExit(ctx context.Context, satelliteID storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	client, closeConn, err := dialSatellite(ctx, worker.transport, worker.kademlia, satelliteID)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, closeConn()) }()
+
+	for {
+		response, err := client.GetTransfers(ctx, &pb.GetTransfersRequest{
+			Limit: int32(worker.config.TransferBatchSize),
+		})
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		if response.Finished {
+			return worker.completeExit(ctx, satelliteID, response.Successful)
+		}
+		if len(response.Transfers) == 0 {
+			// the satellite hasn't queued our pieces yet
+			return nil
+		}
+
+		for _, transfer := range response.Transfers {
+			if err := worker.handleTransfer(ctx, client, satelliteID, transfer); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// handleTransfer transfers a single piece and reports the result to the satellite
+func (worker *Worker) handleTransfer(ctx context.Context, client pb.SatelliteGracefulExitClient, satelliteID storj.NodeID, transfer *pb.TransferPiece) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	report := &pb.ReportTransferRequest{
+		Path:     transfer.Path,
+		PieceNum: transfer.PieceNum,
+	}
+
+	hash, transferErr := worker.transferPiece(ctx, satelliteID, transfer)
+	if transferErr != nil {
+		worker.log.Debug("piece transfer failed", zap.Stringer("Satellite ID", satelliteID), zap.Stringer("Piece ID", transfer.OriginalPieceId), zap.Error(transferErr))
+		report.Error = transferErr.Error()
+	} else {
+		report.Success = true
+		report.OrderLimit = transfer.GetAddressedOrderLimit().GetLimit()
+		report.ReplacementPieceHash = hash
+	}
+
+	_, err = client.ReportTransfer(ctx, report)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	if transferErr != nil {
+		return Error.Wrap(worker.db.IncrementProgress(ctx, satelliteID, 0, 1))
+	}
+
+	// the satellite doesn't reference our copy anymore
+	if err := worker.deletePiece(ctx, satelliteID, transfer.OriginalPieceId); err != nil {
+		worker.log.Warn("unable to delete transferred piece", zap.Stringer("Piece ID", transfer.OriginalPieceId), zap.Error(err))
+	}
+	return Error.Wrap(worker.db.IncrementProgress(ctx, satelliteID, 1, 0))
+}
+
+// transferPiece uploads the local piece to the replacement node
+func (worker *Worker) transferPiece(ctx context.Context, satelliteID storj.NodeID, transfer *pb.TransferPiece) (_ *pb.PieceHash, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	ctx, cancel := context.WithTimeout(ctx, worker.config.TransferTimeout)
+	defer cancel()
+
+	addressedLimit := transfer.GetAddressedOrderLimit()
+	limit := addressedLimit.GetLimit()
+	if limit == nil {
+		return nil, Error.New("missing order limit")
+	}
+
+	reader, err := worker.store.Reader(ctx, satelliteID, transfer.OriginalPieceId)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, reader.Close()) }()
+
+	conn, err := worker.transport.DialNode(ctx, &pb.Node{
+		Id:      limit.StorageNodeId,
+		Address: addressedLimit.GetStorageNodeAddress(),
+		Type:    pb.NodeType_STORAGE,
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	client := piecestore.NewClient(
+		worker.log.Named("piecestore"),
+		signing.SignerFromFullIdentity(worker.transport.Identity()),
+		conn,
+		piecestore.DefaultConfig,
+	)
+	defer func() { err = errs.Combine(err, client.Close()) }()
+
+	upload, err := client.Upload(ctx, limit)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	_, err = io.CopyN(upload, reader, reader.Size())
+	if err != nil {
+		return nil, Error.Wrap(errs.Combine(err, upload.Cancel()))
+	}
+
+	hash, err := upload.Commit()
+	return hash, Error.Wrap(err)
+}
+
+// deletePiece removes the piece and its metadata from the node
+func (worker *Worker) deletePiece(ctx context.Context, satelliteID storj.NodeID, pieceID storj.PieceID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return errs.Combine(
+		worker.store.Delete(ctx, satelliteID, pieceID),
+		worker.pieceinfo.Delete(ctx, satelliteID, pieceID),
+	)
+}
+
+// completeExit records the outcome reported by the satellite
+func (worker *Worker) completeExit(ctx context.Context, satelliteID storj.NodeID, successful bool) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	worker.log.Info("graceful exit finished", zap.Stringer("Satellite ID", satelliteID), zap.Bool("successful", successful))
+	return Error.Wrap(worker.db.CompleteExit(ctx, satelliteID, time.Now().UTC(), successful))
+}
+
+// Close stops the graceful exit worker.
+func (worker *Worker) Close() error {
+	worker.Loop.Close()
+	return nil
+}
@@ -5,6 +5,7 @@ package inspector
 
 import (
 	"context"
+	"sort"
 	"strings"
 	"time"
 
@@ -19,6 +20,9 @@ import (
 	"storj.io/storj/pkg/piecestore/psserver/psdb"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/storagenode/bandwidth"
+	"storj.io/storj/storagenode/collector"
+	"storj.io/storj/storagenode/monitor"
+	"storj.io/storj/storagenode/orders"
 	"storj.io/storj/storagenode/pieces"
 )
 
@@ -36,19 +40,25 @@ type Endpoint struct {
 	kademlia  *kademlia.Kademlia
 	usageDB   bandwidth.DB
 	psdbDB    *psdb.DB // TODO remove after complete migration
+	collector *collector.Service
+	monitor   *monitor.Service
+	sender    *orders.Sender
 
 	startTime time.Time
 	config    psserver.Config
 }
 
 // NewEndpoint creates piecestore inspector instance
-func NewEndpoint(log *zap.Logger, pieceInfo pieces.DB, kademlia *kademlia.Kademlia, usageDB bandwidth.DB, psdbDB *psdb.DB, config psserver.Config) *Endpoint {
+func NewEndpoint(log *zap.Logger, pieceInfo pieces.DB, kademlia *kademlia.Kademlia, usageDB bandwidth.DB, psdbDB *psdb.DB, collector *collector.Service, monitor *monitor.Service, sender *orders.Sender, config psserver.Config) *Endpoint {
 	return &Endpoint{
 		log:       log,
 		pieceInfo: pieceInfo,
 		kademlia:  kademlia,
 		usageDB:   usageDB,
 		psdbDB:    psdbDB,
+		collector: collector,
+		monitor:   monitor,
+		sender:    sender,
 		config:    config,
 		startTime: time.Now(),
 	}
@@ -70,13 +80,22 @@ func (inspector *Endpoint) retrieveStats(ctx context.Context) (*pb.StatSummaryRe
 
 	totalUsedBandwidth := usage.Total()
 
+	collected := inspector.collector.Stats()
+	disk := inspector.monitor.DiskStatus()
+
 	return &pb.StatSummaryResponse{
-		UsedSpace:          totalUsedSpace,
-		AvailableSpace:     inspector.config.AllocatedDiskSpace.Int64() - totalUsedSpace,
-		UsedIngress:        ingress,
-		UsedEgress:         egress,
-		UsedBandwidth:      totalUsedBandwidth,
-		AvailableBandwidth: inspector.config.AllocatedBandwidth.Int64() - totalUsedBandwidth,
+		UsedSpace:            totalUsedSpace,
+		AvailableSpace:       inspector.config.AllocatedDiskSpace.Int64() - totalUsedSpace,
+		UsedIngress:          ingress,
+		UsedEgress:           egress,
+		UsedBandwidth:        totalUsedBandwidth,
+		AvailableBandwidth:   inspector.config.AllocatedBandwidth.Int64() - totalUsedBandwidth,
+		ExpiredPiecesDeleted: collected.DeletedPieces,
+		ExpiredBytesFreed:    collected.FreedBytes,
+		DiskFree:             disk.Free,
+		DiskUsedEstimate:     disk.UsedOnDisk,
+		DiskFull:             disk.Full,
+		DiskDiscrepancy:      disk.Discrepancy,
 	}, nil
 }
 
@@ -96,6 +115,101 @@ func (inspector *Endpoint) Stats(ctx context.Context, in *pb.StatsRequest) (out
 	return statsSummary, nil
 }
 
+// SatelliteStats returns space and bandwidth statistics for every satellite
+func (inspector *Endpoint) SatelliteStats(ctx context.Context, in *pb.SatelliteStatsRequest) (out *pb.SatelliteStatsResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	spaceUsed, err := inspector.pieceInfo.SpaceUsedBySatellite(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	usages, err := bandwidth.MonthlySummaryBySatellite(ctx, inspector.usageDB)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	satellites := make(map[storj.NodeID]*pb.SatelliteStatsResponse_Satellite)
+	get := func(satelliteID storj.NodeID) *pb.SatelliteStatsResponse_Satellite {
+		satellite, ok := satellites[satelliteID]
+		if !ok {
+			satellite = &pb.SatelliteStatsResponse_Satellite{SatelliteId: satelliteID}
+			satellites[satelliteID] = satellite
+		}
+		return satellite
+	}
+
+	for satelliteID, limit := range inspector.monitor.SatelliteLimits() {
+		satellite := get(satelliteID)
+		satellite.AllocatedSpace = limit.DiskSpace.Int64()
+		satellite.AllocatedBandwidth = limit.Bandwidth.Int64()
+	}
+	for satelliteID, used := range spaceUsed {
+		get(satelliteID).UsedSpace = used
+	}
+	for satelliteID, usage := range usages {
+		satellite := get(satelliteID)
+		satellite.UsedIngress = usage.Put + usage.PutRepair
+		satellite.UsedEgress = usage.Get + usage.GetAudit + usage.GetRepair
+		satellite.UsedBandwidth = usage.Total()
+	}
+
+	out = &pb.SatelliteStatsResponse{}
+	for _, satellite := range satellites {
+		out.Satellites = append(out.Satellites, satellite)
+	}
+	sort.Slice(out.Satellites, func(i, k int) bool {
+		return out.Satellites[i].SatelliteId.Less(out.Satellites[k].SatelliteId)
+	})
+	return out, nil
+}
+
+// EmptyTrash permanently deletes pieces that were trashed before the requested time
+func (inspector *Endpoint) EmptyTrash(ctx context.Context, in *pb.EmptyTrashRequest) (out *pb.EmptyTrashResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	trashedBefore, err := ptypes.Timestamp(in.TrashedBefore)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	deleted, freed, err := inspector.collector.EmptyTrash(ctx, trashedBefore)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return &pb.EmptyTrashResponse{
+		PiecesDeleted: deleted,
+		BytesFreed:    freed,
+	}, nil
+}
+
+// OrderQueues returns the unsent orders and the settlement state of every satellite
+func (inspector *Endpoint) OrderQueues(ctx context.Context, in *pb.OrderQueuesRequest) (out *pb.OrderQueuesResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	queues, err := inspector.sender.Queues(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	out = &pb.OrderQueuesResponse{}
+	for _, queue := range queues {
+		response := &pb.OrderQueuesResponse_Queue{
+			SatelliteId: queue.SatelliteID,
+			Unsent:      queue.Unsent,
+			Failures:    int32(queue.Failures),
+		}
+		if !queue.NextAttempt.IsZero() {
+			response.NextAttempt, err = ptypes.TimestampProto(queue.NextAttempt)
+			if err != nil {
+				return nil, Error.Wrap(err)
+			}
+		}
+		out.Queues = append(out.Queues, response)
+	}
+	return out, nil
+}
+
 func (inspector *Endpoint) getDashboardData(ctx context.Context) (*pb.DashboardResponse, error) {
 	statsSummary, err := inspector.retrieveStats(ctx)
 	if err != nil {
@@ -81,6 +81,18 @@ func TestInspectorStats(t *testing.T) {
 				downloaded++
 				assert.Equal(t, response.UsedBandwidth-response.UsedIngress, response.UsedEgress)
 			}
+
+			// all usage belongs to the only satellite
+			satellites, err := storageNode.Storage2.Inspector.SatelliteStats(ctx, &pb.SatelliteStatsRequest{})
+			require.NoError(t, err)
+			require.Len(t, satellites.Satellites, 1)
+			satellite := satellites.Satellites[0]
+			assert.Equal(t, planet.Satellites[0].ID(), satellite.SatelliteId)
+			assert.Equal(t, response.UsedSpace, satellite.UsedSpace)
+			assert.Equal(t, response.UsedIngress, satellite.UsedIngress)
+			assert.Equal(t, response.UsedEgress, satellite.UsedEgress)
+			assert.Equal(t, response.UsedBandwidth, satellite.UsedBandwidth)
+			assert.Zero(t, satellite.AllocatedSpace)
 		} else {
 			assert.Zero(t, response.UsedSpace)
 			// TODO track why this is failing
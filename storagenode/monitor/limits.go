@@ -0,0 +1,66 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package monitor
+
+import (
+	"strings"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/pkg/storj"
+)
+
+// SatelliteLimit is the maximum disk space and monthly bandwidth a single satellite may use, zero means unlimited
+type SatelliteLimit struct {
+	DiskSpace memory.Size
+	Bandwidth memory.Size
+}
+
+// ParseSatelliteLimits parses a comma separated list of <satellite id>:<disk space>:<bandwidth> entries,
+// an empty disk space or bandwidth means unlimited
+func ParseSatelliteLimits(s string) (map[storj.NodeID]SatelliteLimit, error) {
+	limits := make(map[storj.NodeID]SatelliteLimit)
+	if strings.TrimSpace(s) == "" {
+		return limits, nil
+	}
+
+	for _, entry := range strings.Split(s, ",") {
+		parts := strings.Split(strings.TrimSpace(entry), ":")
+		if len(parts) != 3 {
+			return nil, Error.New("invalid satellite limit %q, expected <satellite id>:<disk space>:<bandwidth>", entry)
+		}
+
+		satelliteID, err := storj.NodeIDFromString(parts[0])
+		if err != nil {
+			return nil, Error.New("invalid satellite id in limit %q: %v", entry, err)
+		}
+		if _, ok := limits[satelliteID]; ok {
+			return nil, Error.New("duplicate limit for satellite %s", satelliteID)
+		}
+
+		var limit SatelliteLimit
+		if limit.DiskSpace, err = parseSize(parts[1]); err != nil {
+			return nil, Error.New("invalid disk space in limit %q: %v", entry, err)
+		}
+		if limit.Bandwidth, err = parseSize(parts[2]); err != nil {
+			return nil, Error.New("invalid bandwidth in limit %q: %v", entry, err)
+		}
+		limits[satelliteID] = limit
+	}
+	return limits, nil
+}
+
+// parseSize parses a size, an empty string is parsed as zero
+func parseSize(s string) (memory.Size, error) {
+	if s == "" {
+		return 0, nil
+	}
+	size, err := memory.ParseString(s)
+	if err != nil {
+		return 0, err
+	}
+	if size < 0 {
+		return 0, Error.New("negative size %q", s)
+	}
+	return memory.Size(size), nil
+}
@@ -0,0 +1,115 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package monitor_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testidentity"
+	"storj.io/storj/pkg/auth/signing"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/monitor"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/storagenodedb/storagenodedbtest"
+)
+
+func TestParseSatelliteLimits(t *testing.T) {
+	satellite0 := testidentity.MustPregeneratedSignedIdentity(0, storj.LatestIDVersion()).ID
+	satellite1 := testidentity.MustPregeneratedSignedIdentity(1, storj.LatestIDVersion()).ID
+
+	limits, err := monitor.ParseSatelliteLimits("")
+	require.NoError(t, err)
+	assert.Empty(t, limits)
+
+	limits, err = monitor.ParseSatelliteLimits(satellite0.String() + ":1GB:2TB, " + satellite1.String() + "::500GB")
+	require.NoError(t, err)
+	assert.Equal(t, map[storj.NodeID]monitor.SatelliteLimit{
+		satellite0: {DiskSpace: memory.GB, Bandwidth: 2 * memory.TB},
+		satellite1: {Bandwidth: 500 * memory.GB},
+	}, limits)
+
+	for _, invalid := range []string{
+		satellite0.String(),
+		satellite0.String() + ":1GB",
+		"invalid:1GB:1GB",
+		satellite0.String() + ":1XB:1GB",
+		satellite0.String() + ":1GB:-1GB",
+		satellite0.String() + ":1GB:1GB," + satellite0.String() + ":2GB:2GB",
+	} {
+		_, err := monitor.ParseSatelliteLimits(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestSatelliteLimits(t *testing.T) {
+	storagenodedbtest.Run(t, func(t *testing.T, db storagenode.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		limited := testidentity.MustPregeneratedSignedIdentity(0, storj.LatestIDVersion())
+		unlimited := testidentity.MustPregeneratedSignedIdentity(1, storj.LatestIDVersion())
+		uplink := testidentity.MustPregeneratedSignedIdentity(2, storj.LatestIDVersion())
+
+		now := time.Now()
+		for _, satellite := range []storj.NodeID{limited.ID, unlimited.ID} {
+			pieceID := storj.NewPieceID()
+			hash, err := signing.SignPieceHash(signing.SignerFromFullIdentity(uplink), &pb.PieceHash{
+				PieceId: pieceID,
+				Hash:    []byte{1, 2, 3},
+			})
+			require.NoError(t, err)
+
+			require.NoError(t, db.PieceInfo().Add(ctx, &pieces.Info{
+				SatelliteID:     satellite,
+				PieceID:         pieceID,
+				PieceSize:       memory.MB.Int64(),
+				PieceCreation:   now,
+				UplinkPieceHash: hash,
+				Uplink:          uplink.PeerIdentity(),
+			}))
+			require.NoError(t, db.Bandwidth().Add(ctx, satellite, pb.PieceAction_GET, memory.MB.Int64(), now))
+		}
+
+		log := zaptest.NewLogger(t)
+		store := pieces.NewStore(log, db.Pieces())
+
+		service := monitor.NewService(log, nil, store, db.PieceInfo(), db.Bandwidth(),
+			memory.GB.Int64(), memory.GB.Int64(), time.Hour, monitor.Config{},
+			map[storj.NodeID]monitor.SatelliteLimit{
+				limited.ID: {DiskSpace: 3 * memory.MB, Bandwidth: 5 * memory.MB},
+			})
+
+		space, err := service.AvailableSpace(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, memory.GB.Int64()-2*memory.MB.Int64(), space)
+
+		space, err = service.AvailableSpaceForSatellite(ctx, limited.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 2*memory.MB.Int64(), space)
+
+		space, err = service.AvailableSpaceForSatellite(ctx, unlimited.ID)
+		require.NoError(t, err)
+		assert.Equal(t, memory.GB.Int64()-2*memory.MB.Int64(), space)
+
+		bandwidth, err := service.AvailableBandwidthForSatellite(ctx, limited.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 4*memory.MB.Int64(), bandwidth)
+
+		bandwidth, err = service.AvailableBandwidthForSatellite(ctx, unlimited.ID)
+		require.NoError(t, err)
+		assert.Equal(t, memory.GB.Int64()-2*memory.MB.Int64(), bandwidth)
+
+		_, ok := service.SatelliteLimit(unlimited.ID)
+		assert.False(t, ok)
+	})
+}
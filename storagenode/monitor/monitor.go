@@ -5,15 +5,18 @@ package monitor
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 	monkit "gopkg.in/spacemonkeygo/monkit.v2"
 
+	"storj.io/storj/internal/memory"
 	"storj.io/storj/internal/sync2"
 	"storj.io/storj/pkg/kademlia"
 	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
 	"storj.io/storj/storagenode/bandwidth"
 	"storj.io/storj/storagenode/pieces"
 )
@@ -27,7 +30,34 @@ var (
 
 // Config defines parameters for storage node disk and bandwidth usage monitoring.
 type Config struct {
-	Interval time.Duration `help:"how frequently Kademlia bucket should be refreshed with node stats" default:"1h0m0s"`
+	Interval         time.Duration `help:"how frequently Kademlia bucket should be refreshed with node stats" default:"1h0m0s"`
+	SatelliteLimits  string        `help:"per-satellite allocation limits as comma separated <satellite id>:<disk space>:<bandwidth>, empty disk space or bandwidth means unlimited" default:""`
+	MinimumDiskSpace memory.Size   `help:"how much disk space must stay free, uploads are refused when the disk has less" default:"500MB"`
+	DiskSamples      int           `help:"how many directories of every satellite are measured to estimate the size of the stored pieces, zero measures all of them" default:"32"`
+}
+
+const (
+	// discrepancyRatio is how much the used space of the pieces and the size of the stored blobs may differ
+	discrepancyRatio = 0.1
+	// discrepancyMinimum is the smallest difference that is reported, estimates of small nodes are imprecise
+	discrepancyMinimum = 64 * memory.MB
+)
+
+// DiskStatus contains the result of a disk space check
+type DiskStatus struct {
+	CheckedAt time.Time
+	// Allocated is the disk space allocated for pieces
+	Allocated int64
+	// UsedByDB is the space used by the pieces according to the piece information
+	UsedByDB int64
+	// UsedOnDisk is the estimated size of the stored blobs
+	UsedOnDisk int64
+	// Free is the free space on the disk
+	Free int64
+	// Full is set when uploads are refused because the disk is running out of space
+	Full bool
+	// Discrepancy is set when the piece information and the stored blobs disagree about the used space
+	Discrepancy bool
 }
 
 // Service which monitors disk usage and updates kademlia network as necessary.
@@ -39,13 +69,19 @@ type Service struct {
 	usageDB            bandwidth.DB
 	allocatedDiskSpace int64
 	allocatedBandwidth int64
+	minimumDiskSpace   int64
+	diskSamples        int
+	satelliteLimits    map[storj.NodeID]SatelliteLimit
 	Loop               sync2.Cycle
+
+	mu         sync.Mutex
+	diskStatus DiskStatus
 }
 
 // TODO: should it be responsible for monitoring actual bandwidth as well?
 
 // NewService creates a new storage node monitoring service.
-func NewService(log *zap.Logger, routingTable *kademlia.RoutingTable, store *pieces.Store, pieceInfo pieces.DB, usageDB bandwidth.DB, allocatedDiskSpace, allocatedBandwidth int64, interval time.Duration) *Service {
+func NewService(log *zap.Logger, routingTable *kademlia.RoutingTable, store *pieces.Store, pieceInfo pieces.DB, usageDB bandwidth.DB, allocatedDiskSpace, allocatedBandwidth int64, interval time.Duration, config Config, satelliteLimits map[storj.NodeID]SatelliteLimit) *Service {
 	return &Service{
 		log:                log,
 		routingTable:       routingTable,
@@ -54,6 +90,9 @@ func NewService(log *zap.Logger, routingTable *kademlia.RoutingTable, store *pie
 		usageDB:            usageDB,
 		allocatedDiskSpace: allocatedDiskSpace,
 		allocatedBandwidth: allocatedBandwidth,
+		minimumDiskSpace:   config.MinimumDiskSpace.Int64(),
+		diskSamples:        config.DiskSamples,
+		satelliteLimits:    satelliteLimits,
 		Loop:               *sync2.NewCycle(interval),
 	}
 }
@@ -107,7 +146,16 @@ func (service *Service) Run(ctx context.Context) (err error) {
 		service.log.Warn("Disk space is less than requested. Allocating space", zap.Int64("bytes", service.allocatedDiskSpace))
 	}
 
+	// the loop checks the disk again, a failing check must not stop the node
+	if _, err := service.CheckDisk(ctx); err != nil {
+		service.log.Error("error during checking disk: ", zap.Error(err))
+	}
+
 	return service.Loop.Run(ctx, func(ctx context.Context) error {
+		if _, err := service.CheckDisk(ctx); err != nil {
+			service.log.Error("error during checking disk: ", zap.Error(err))
+		}
+
 		err := service.updateNodeInformation(ctx)
 		if err != nil {
 			service.log.Error("error during updating node information: ", zap.Error(err))
@@ -116,8 +164,78 @@ func (service *Service) Run(ctx context.Context) (err error) {
 	})
 }
 
+// CheckDisk compares the allocated disk space and the used space of the pieces with the state of the disk
+func (service *Service) CheckDisk(ctx context.Context) (status DiskStatus, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	storageStatus, err := service.store.StorageStatus()
+	if err != nil {
+		return status, Error.Wrap(err)
+	}
+
+	usedByDB, err := service.pieceInfo.SpaceUsed(ctx)
+	if err != nil {
+		return status, Error.Wrap(err)
+	}
+
+	usedOnDisk, err := service.store.EstimateSpaceUsed(ctx, service.diskSamples)
+	if err != nil {
+		return status, Error.Wrap(err)
+	}
+
+	status = DiskStatus{
+		CheckedAt:   time.Now(),
+		Allocated:   service.allocatedDiskSpace,
+		UsedByDB:    usedByDB,
+		UsedOnDisk:  usedOnDisk,
+		Free:        storageStatus.DiskFree,
+		Full:        storageStatus.DiskFree <= service.minimumDiskSpace,
+		Discrepancy: isDiscrepancy(usedByDB, usedOnDisk),
+	}
+
+	mon.IntVal("disk_used_by_db").Observe(status.UsedByDB)
+	mon.IntVal("disk_used_on_disk").Observe(status.UsedOnDisk)
+	mon.IntVal("disk_free").Observe(status.Free)
+
+	if status.Full {
+		service.log.Warn("Disk is almost full, refusing uploads", zap.Int64("free bytes", status.Free))
+	} else if status.Allocated-status.UsedOnDisk > status.Free {
+		service.log.Warn("Allocated disk space is more than what is left on the disk",
+			zap.Int64("allocated bytes", status.Allocated),
+			zap.Int64("used bytes", status.UsedOnDisk),
+			zap.Int64("free bytes", status.Free))
+	}
+	if status.Discrepancy {
+		service.log.Warn("Used space of pieces disagrees with the size of the stored pieces",
+			zap.Int64("used bytes", status.UsedByDB),
+			zap.Int64("stored bytes", status.UsedOnDisk))
+	}
+
+	service.mu.Lock()
+	service.diskStatus = status
+	service.mu.Unlock()
+
+	return status, nil
+}
+
+// DiskStatus returns the result of the last disk check
+func (service *Service) DiskStatus() DiskStatus {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	return service.diskStatus
+}
+
+// isDiscrepancy returns whether the used space and the size of the stored pieces differ too much
+func isDiscrepancy(used, stored int64) bool {
+	difference, larger := used-stored, used
+	if difference < 0 {
+		difference, larger = -difference, stored
+	}
+	return difference > discrepancyMinimum.Int64() && float64(difference) > discrepancyRatio*float64(larger)
+}
+
 func (service *Service) updateNodeInformation(ctx context.Context) error {
-	usedSpace, err := service.usedSpace(ctx)
+	freeDisk, err := service.AvailableSpace(ctx)
 	if err != nil {
 		return Error.Wrap(err)
 	}
@@ -131,7 +249,7 @@ func (service *Service) updateNodeInformation(ctx context.Context) error {
 
 	self.Restrictions = &pb.NodeRestrictions{
 		FreeBandwidth: service.allocatedBandwidth - usedBandwidth,
-		FreeDisk:      service.allocatedDiskSpace - usedSpace,
+		FreeDisk:      freeDisk,
 	}
 
 	// Update the routing table with latest restrictions
@@ -142,12 +260,17 @@ func (service *Service) updateNodeInformation(ctx context.Context) error {
 	return nil
 }
 
+// usedSpace returns the disk space used by stored and trashed pieces
 func (service *Service) usedSpace(ctx context.Context) (int64, error) {
 	usedSpace, err := service.pieceInfo.SpaceUsed(ctx)
 	if err != nil {
 		return 0, err
 	}
-	return usedSpace, nil
+	trashSpace, err := service.pieceInfo.SpaceUsedInTrash(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return usedSpace + trashSpace, nil
 }
 
 func (service *Service) usedBandwidth(ctx context.Context) (int64, error) {
@@ -160,12 +283,33 @@ func (service *Service) usedBandwidth(ctx context.Context) (int64, error) {
 
 // AvailableSpace returns available disk space for upload
 func (service *Service) AvailableSpace(ctx context.Context) (int64, error) {
-	usedSpace, err := service.pieceInfo.SpaceUsed(ctx)
+	usedByDB, err := service.pieceInfo.SpaceUsed(ctx)
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+	trashSpace, err := service.pieceInfo.SpaceUsedInTrash(ctx)
 	if err != nil {
 		return 0, Error.Wrap(err)
 	}
 	allocatedSpace := service.allocatedDiskSpace
-	return allocatedSpace - usedSpace, nil
+
+	// the disk can be filled by something else than the pieces,
+	// the free space of the last disk check is reduced by the pieces stored since then
+	status := service.DiskStatus()
+	if status.CheckedAt.IsZero() {
+		// the disk hasn't been checked yet
+		storageStatus, err := service.store.StorageStatus()
+		if err != nil {
+			return 0, Error.Wrap(err)
+		}
+		status.Free, status.UsedByDB = storageStatus.DiskFree, usedByDB
+	}
+	freeSpace := status.Free - service.minimumDiskSpace
+	if stored := usedByDB - status.UsedByDB; stored > 0 {
+		freeSpace -= stored
+	}
+
+	return min(allocatedSpace-usedByDB-trashSpace, freeSpace), nil
 }
 
 // AvailableBandwidth returns available bandwidth for upload/download
@@ -177,3 +321,67 @@ func (service *Service) AvailableBandwidth(ctx context.Context) (int64, error) {
 	allocatedBandwidth := service.allocatedBandwidth
 	return allocatedBandwidth - usage.Total(), nil
 }
+
+// SatelliteLimit returns the allocation limit of the satellite and whether it has one
+func (service *Service) SatelliteLimit(satelliteID storj.NodeID) (SatelliteLimit, bool) {
+	limit, ok := service.satelliteLimits[satelliteID]
+	return limit, ok
+}
+
+// SatelliteLimits returns the allocation limits of all satellites that have one
+func (service *Service) SatelliteLimits() map[storj.NodeID]SatelliteLimit {
+	limits := make(map[storj.NodeID]SatelliteLimit, len(service.satelliteLimits))
+	for satelliteID, limit := range service.satelliteLimits {
+		limits[satelliteID] = limit
+	}
+	return limits
+}
+
+// AvailableSpaceForSatellite returns available disk space for upload from the satellite
+func (service *Service) AvailableSpaceForSatellite(ctx context.Context, satelliteID storj.NodeID) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	available, err := service.AvailableSpace(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	limit, ok := service.satelliteLimits[satelliteID]
+	if !ok || limit.DiskSpace == 0 {
+		return available, nil
+	}
+
+	usedSpace, err := service.pieceInfo.SpaceUsedForSatellite(ctx, satelliteID)
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+	return min(available, limit.DiskSpace.Int64()-usedSpace), nil
+}
+
+// AvailableBandwidthForSatellite returns available bandwidth for upload/download of the satellite
+func (service *Service) AvailableBandwidthForSatellite(ctx context.Context, satelliteID storj.NodeID) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	available, err := service.AvailableBandwidth(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	limit, ok := service.satelliteLimits[satelliteID]
+	if !ok || limit.Bandwidth == 0 {
+		return available, nil
+	}
+
+	usage, err := bandwidth.SatelliteMonthlySummary(ctx, service.usageDB, satelliteID)
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+	return min(available, limit.Bandwidth.Int64()-usage.Total()), nil
+}
+
+func min(a, b int64) int64 {
+	if a < b {
+		return a
+	}
+	return b
+}
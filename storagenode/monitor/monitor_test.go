@@ -6,14 +6,23 @@ package monitor_test
 import (
 	"crypto/rand"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
 
 	"storj.io/storj/internal/memory"
 	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testidentity"
 	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/pkg/auth/signing"
 	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/monitor"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/storagenodedb/storagenodedbtest"
 )
 
 func TestMonitor(t *testing.T) {
@@ -60,3 +69,67 @@ func TestMonitor(t *testing.T) {
 	}
 	assert.NotZero(t, nodeAssertions, "No storage node were verifed")
 }
+
+func TestCheckDisk(t *testing.T) {
+	storagenodedbtest.Run(t, func(t *testing.T, db storagenode.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		log := zaptest.NewLogger(t)
+		store := pieces.NewStore(log, db.Pieces())
+
+		satellite := testidentity.MustPregeneratedSignedIdentity(0, storj.LatestIDVersion())
+		uplink := testidentity.MustPregeneratedSignedIdentity(1, storj.LatestIDVersion())
+
+		newService := func(minimumDiskSpace memory.Size) *monitor.Service {
+			return monitor.NewService(log, nil, store, db.PieceInfo(), db.Bandwidth(),
+				memory.GB.Int64(), memory.GB.Int64(), time.Hour,
+				monitor.Config{MinimumDiskSpace: minimumDiskSpace},
+				map[storj.NodeID]monitor.SatelliteLimit{})
+		}
+
+		service := newService(0)
+
+		status, err := service.CheckDisk(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, status, service.DiskStatus())
+		assert.EqualValues(t, memory.GB.Int64(), status.Allocated)
+		assert.Zero(t, status.UsedByDB)
+		assert.Zero(t, status.UsedOnDisk)
+		assert.NotZero(t, status.Free)
+		assert.False(t, status.Full)
+		assert.False(t, status.Discrepancy)
+
+		// piece information without stored pieces
+		pieceID := storj.NewPieceID()
+		hash, err := signing.SignPieceHash(signing.SignerFromFullIdentity(uplink), &pb.PieceHash{
+			PieceId: pieceID,
+			Hash:    []byte{1, 2, 3},
+		})
+		require.NoError(t, err)
+		require.NoError(t, db.PieceInfo().Add(ctx, &pieces.Info{
+			SatelliteID:     satellite.ID,
+			PieceID:         pieceID,
+			PieceSize:       100 * memory.MB.Int64(),
+			PieceCreation:   time.Now(),
+			UplinkPieceHash: hash,
+			Uplink:          uplink.PeerIdentity(),
+		}))
+
+		status, err = service.CheckDisk(ctx)
+		require.NoError(t, err)
+		assert.EqualValues(t, 100*memory.MB.Int64(), status.UsedByDB)
+		assert.True(t, status.Discrepancy)
+
+		// the disk is never large enough
+		service = newService(memory.EB)
+
+		status, err = service.CheckDisk(ctx)
+		require.NoError(t, err)
+		assert.True(t, status.Full)
+
+		available, err := service.AvailableSpace(ctx)
+		require.NoError(t, err)
+		assert.True(t, available <= 0)
+	})
+}
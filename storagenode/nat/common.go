@@ -0,0 +1,42 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nat
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"github.com/zeebo/errs"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+)
+
+var (
+	// Error is the default error class for nat package
+	Error = errs.Class("nat")
+
+	mon = monkit.Package()
+)
+
+// Config contains configurable values for mapping the public port on the router
+type Config struct {
+	Enabled          bool          `help:"map the public port on the router with UPnP or NAT-PMP and advertise the discovered external address" default:"false"`
+	Method           string        `help:"port mapping method to use: auto, upnp or natpmp" default:"auto"`
+	Gateway          string        `help:"address of the NAT-PMP gateway, usually the router, e.g. 192.168.1.1" default:""`
+	DiscoveryAddress string        `help:"address for discovering UPnP gateways with SSDP" default:"239.255.255.250:1900"`
+	Lifetime         time.Duration `help:"requested lifetime of the port mapping, the mapping is refreshed at half of the granted lifetime" default:"1h0m0s"`
+	RetryInterval    time.Duration `help:"how long to wait before retrying after the port couldn't be mapped" default:"5m0s"`
+	Timeout          time.Duration `help:"timeout for discovering the gateway and mapping the port" default:"10s"`
+}
+
+// Mapper maps ports on a gateway
+type Mapper interface {
+	// ExternalIP returns the public IP of the gateway
+	ExternalIP(ctx context.Context) (net.IP, error)
+	// AddPortMapping maps the TCP port externalPort of the gateway to internalPort of this host,
+	// it returns the port that was mapped and the granted lifetime, which is zero for permanent mappings.
+	AddPortMapping(ctx context.Context, internalPort, externalPort int, lifetime time.Duration) (mappedPort int, granted time.Duration, err error)
+	// DeletePortMapping removes the mapping of externalPort
+	DeletePortMapping(ctx context.Context, internalPort, externalPort int) error
+}
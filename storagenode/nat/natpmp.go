@@ -0,0 +1,129 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nat
+
+import (
+	"context"
+	"encoding/binary"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/zeebo/errs"
+)
+
+const (
+	natpmpPort = 5351
+
+	natpmpOpExternalAddress = 0
+	natpmpOpMapTCP          = 2
+
+	// natpmpInitialTimeout is doubled on every retry as described in RFC 6886
+	natpmpInitialTimeout = 250 * time.Millisecond
+	natpmpMaxAttempts    = 9
+)
+
+// NATPMP maps ports with the NAT Port Mapping Protocol (RFC 6886)
+type NATPMP struct {
+	gateway string
+}
+
+// NewNATPMP creates a NAT-PMP client for the gateway, the default port is used when gateway has none
+func NewNATPMP(gateway string) *NATPMP {
+	if _, _, err := net.SplitHostPort(gateway); err != nil {
+		gateway = net.JoinHostPort(gateway, strconv.Itoa(natpmpPort))
+	}
+	return &NATPMP{gateway: gateway}
+}
+
+// ExternalIP returns the public IP of the gateway
+func (natpmp *NATPMP) ExternalIP(ctx context.Context) (_ net.IP, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	response, err := natpmp.request(ctx, []byte{0, natpmpOpExternalAddress}, 12)
+	if err != nil {
+		return nil, err
+	}
+	return net.IP(response[8:12]), nil
+}
+
+// AddPortMapping maps the TCP port externalPort of the gateway to internalPort of this host
+func (natpmp *NATPMP) AddPortMapping(ctx context.Context, internalPort, externalPort int, lifetime time.Duration) (_ int, _ time.Duration, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	request := make([]byte, 12)
+	request[1] = natpmpOpMapTCP
+	binary.BigEndian.PutUint16(request[4:6], uint16(internalPort))
+	binary.BigEndian.PutUint16(request[6:8], uint16(externalPort))
+	binary.BigEndian.PutUint32(request[8:12], uint32(lifetime/time.Second))
+
+	response, err := natpmp.request(ctx, request, 16)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	mappedPort := int(binary.BigEndian.Uint16(response[10:12]))
+	granted := time.Duration(binary.BigEndian.Uint32(response[12:16])) * time.Second
+	return mappedPort, granted, nil
+}
+
+// DeletePortMapping removes the mapping of internalPort, NAT-PMP identifies mappings by the internal port
+func (natpmp *NATPMP) DeletePortMapping(ctx context.Context, internalPort, externalPort int) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	request := make([]byte, 12)
+	request[1] = natpmpOpMapTCP
+	binary.BigEndian.PutUint16(request[4:6], uint16(internalPort))
+
+	_, err = natpmp.request(ctx, request, 16)
+	return err
+}
+
+// request sends the request until the gateway responds, retrying with exponential backoff
+func (natpmp *NATPMP) request(ctx context.Context, request []byte, responseSize int) (_ []byte, err error) {
+	conn, err := net.Dial("udp4", natpmp.gateway)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(conn.Close())) }()
+
+	response := make([]byte, 16)
+	timeout := natpmpInitialTimeout
+	for attempt := 0; attempt < natpmpMaxAttempts; attempt++ {
+		if err := ctx.Err(); err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		if _, err := conn.Write(request); err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		deadline := time.Now().Add(timeout)
+		if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+			deadline = ctxDeadline
+		}
+		if err := conn.SetReadDeadline(deadline); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		timeout *= 2
+
+		n, err := conn.Read(response)
+		if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
+			continue
+		}
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		if n < responseSize || response[0] != 0 || response[1] != request[1]|0x80 {
+			// not a response to our request
+			continue
+		}
+		if result := binary.BigEndian.Uint16(response[2:4]); result != 0 {
+			return nil, Error.New("gateway rejected the request with result code %d", result)
+		}
+		return response[:responseSize], nil
+	}
+	return nil, Error.New("no response from NAT-PMP gateway %s", natpmp.gateway)
+}
@@ -0,0 +1,175 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nat
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/sync2"
+)
+
+// Service maps the public port of the node on the router and keeps the mapping alive
+//
+// Whenever the external address changes, it is passed to the update callback.
+type Service struct {
+	log          *zap.Logger
+	config       Config
+	internalPort int
+	update       func(address string) error
+
+	mu         sync.Mutex
+	mapper     Mapper
+	mappedPort int
+	address    string
+}
+
+// NewService creates a new port mapping service for internalPort
+func NewService(log *zap.Logger, config Config, internalPort int, update func(address string) error) *Service {
+	return &Service{
+		log:          log,
+		config:       config,
+		internalPort: internalPort,
+		update:       update,
+	}
+}
+
+// Run maps the port and refreshes the mapping before it expires
+func (service *Service) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !service.config.Enabled {
+		return nil
+	}
+
+	for {
+		wait := service.Refresh(ctx)
+		if !sync2.Sleep(ctx, wait) {
+			return ctx.Err()
+		}
+	}
+}
+
+// Refresh creates or renews the port mapping and returns how long to wait before the next refresh
+func (service *Service) Refresh(ctx context.Context) time.Duration {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(ctx, service.config.Timeout)
+	defer cancel()
+
+	granted, err := service.refresh(ctx)
+	if err != nil {
+		service.log.Warn("unable to map the public port, forward the port manually or set the external address", zap.Error(err))
+		// discover the gateway again, the network may have changed
+		service.mapper = nil
+		return service.config.RetryInterval
+	}
+
+	if granted <= 0 {
+		// permanent mappings are renewed in case the router was restarted
+		granted = service.config.Lifetime
+	}
+	return granted / 2
+}
+
+// refresh must hold the lock
+func (service *Service) refresh(ctx context.Context) (granted time.Duration, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if service.mapper == nil {
+		service.mapper, err = service.discover(ctx)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	externalPort := service.mappedPort
+	if externalPort == 0 {
+		externalPort = service.internalPort
+	}
+
+	mappedPort, granted, err := service.mapper.AddPortMapping(ctx, service.internalPort, externalPort, service.config.Lifetime)
+	if err != nil {
+		return 0, err
+	}
+	service.mappedPort = mappedPort
+
+	ip, err := service.mapper.ExternalIP(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	address := net.JoinHostPort(ip.String(), strconv.Itoa(mappedPort))
+	if address != service.address {
+		service.log.Info("mapped public port", zap.String("external address", address), zap.Duration("lifetime", granted))
+		if service.update != nil {
+			if err := service.update(address); err != nil {
+				return 0, Error.Wrap(err)
+			}
+		}
+		service.address = address
+	}
+	return granted, nil
+}
+
+// discover finds a gateway with the configured method
+func (service *Service) discover(ctx context.Context) (_ Mapper, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	switch service.config.Method {
+	case "upnp":
+		return DiscoverUPnP(ctx, service.config.DiscoveryAddress)
+	case "natpmp":
+		if service.config.Gateway == "" {
+			return nil, Error.New("NAT-PMP requires the gateway address")
+		}
+		return NewNATPMP(service.config.Gateway), nil
+	case "auto", "":
+		upnp, upnpErr := DiscoverUPnP(ctx, service.config.DiscoveryAddress)
+		if upnpErr == nil {
+			return upnp, nil
+		}
+		if service.config.Gateway == "" {
+			return nil, upnpErr
+		}
+
+		natpmp := NewNATPMP(service.config.Gateway)
+		if _, err := natpmp.ExternalIP(ctx); err != nil {
+			return nil, Error.New("no UPnP or NAT-PMP gateway found: %v; %v", upnpErr, err)
+		}
+		return natpmp, nil
+	default:
+		return nil, Error.New("unknown port mapping method %q", service.config.Method)
+	}
+}
+
+// Address returns the external address of the mapping, empty when the port isn't mapped
+func (service *Service) Address() string {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	return service.address
+}
+
+// Close removes the port mapping
+func (service *Service) Close() error {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	if service.mapper == nil || service.mappedPort == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), service.config.Timeout)
+	defer cancel()
+
+	err := service.mapper.DeletePortMapping(ctx, service.internalPort, service.mappedPort)
+	service.mapper, service.mappedPort, service.address = nil, 0, ""
+	return err
+}
@@ -0,0 +1,147 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nat_test
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testnat"
+	"storj.io/storj/storagenode/nat"
+)
+
+func TestUPnP(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	igd, err := testnat.NewIGD(net.ParseIP("203.0.113.7"))
+	require.NoError(t, err)
+	defer ctx.Check(igd.Close)
+
+	upnp, err := nat.DiscoverUPnP(ctx, igd.SSDPAddress())
+	require.NoError(t, err)
+
+	ip, err := upnp.ExternalIP(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "203.0.113.7", ip.String())
+
+	mappedPort, granted, err := upnp.AddPortMapping(ctx, 7777, 28967, time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, 28967, mappedPort)
+	assert.Equal(t, time.Hour, granted)
+
+	mappings := igd.Mappings()
+	require.Len(t, mappings, 1)
+	assert.Equal(t, testnat.Mapping{
+		ExternalPort:   28967,
+		InternalPort:   7777,
+		InternalClient: "127.0.0.1",
+		Lifetime:       time.Hour,
+	}, mappings[0])
+
+	// gateways that only support permanent mappings
+	igd.SetPermanentOnly(true)
+	_, granted, err = upnp.AddPortMapping(ctx, 7777, 28967, time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, time.Duration(0), granted)
+
+	require.NoError(t, upnp.DeletePortMapping(ctx, 7777, 28967))
+	assert.Empty(t, igd.Mappings())
+}
+
+func TestNATPMP(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	igd, err := testnat.NewIGD(net.ParseIP("203.0.113.7"))
+	require.NoError(t, err)
+	defer ctx.Check(igd.Close)
+
+	natpmp := nat.NewNATPMP(igd.NATPMPAddress())
+
+	ip, err := natpmp.ExternalIP(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "203.0.113.7", ip.String())
+
+	mappedPort, granted, err := natpmp.AddPortMapping(ctx, 7777, 28967, time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, 28967, mappedPort)
+	assert.Equal(t, time.Hour, granted)
+	require.Len(t, igd.Mappings(), 1)
+
+	require.NoError(t, natpmp.DeletePortMapping(ctx, 7777, 28967))
+	assert.Empty(t, igd.Mappings())
+}
+
+func TestService(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	igd, err := testnat.NewIGD(net.ParseIP("203.0.113.7"))
+	require.NoError(t, err)
+	defer ctx.Check(igd.Close)
+
+	for _, method := range []string{"upnp", "natpmp"} {
+		t.Run(method, func(t *testing.T) {
+			config := nat.Config{
+				Enabled:          true,
+				Method:           method,
+				Gateway:          igd.NATPMPAddress(),
+				DiscoveryAddress: igd.SSDPAddress(),
+				Lifetime:         time.Hour,
+				RetryInterval:    time.Minute,
+				Timeout:          5 * time.Second,
+			}
+
+			var updates []string
+			service := nat.NewService(zaptest.NewLogger(t), config, 7777, func(address string) error {
+				updates = append(updates, address)
+				return nil
+			})
+
+			wait := service.Refresh(ctx)
+			assert.Equal(t, 30*time.Minute, wait)
+			assert.Equal(t, "203.0.113.7:7777", service.Address())
+			require.Len(t, igd.Mappings(), 1)
+
+			// refreshing an unchanged mapping doesn't update the address again
+			service.Refresh(ctx)
+			assert.Equal(t, []string{"203.0.113.7:7777"}, updates)
+
+			require.NoError(t, service.Close())
+			assert.Empty(t, igd.Mappings())
+			assert.Equal(t, "", service.Address())
+		})
+	}
+}
+
+func TestServiceNoGateway(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	igd, err := testnat.NewIGD(net.ParseIP("203.0.113.7"))
+	require.NoError(t, err)
+	address := igd.SSDPAddress()
+	require.NoError(t, igd.Close())
+
+	config := nat.Config{
+		Enabled:          true,
+		Method:           "upnp",
+		DiscoveryAddress: address,
+		Lifetime:         time.Hour,
+		RetryInterval:    time.Minute,
+		Timeout:          time.Second,
+	}
+
+	service := nat.NewService(zaptest.NewLogger(t), config, 7777, nil)
+	assert.Equal(t, time.Minute, service.Refresh(ctx))
+	assert.Equal(t, "", service.Address())
+	require.NoError(t, service.Close())
+}
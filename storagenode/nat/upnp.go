@@ -0,0 +1,334 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nat
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+)
+
+const (
+	ssdpSearchTarget = "urn:schemas-upnp-org:device:InternetGatewayDevice:1"
+
+	// errOnlyPermanentLeases is returned by gateways that don't support mappings with a lease duration
+	errOnlyPermanentLeases = "725"
+)
+
+// UPnP maps ports with the WANIPConnection or WANPPPConnection service of an UPnP internet gateway device
+type UPnP struct {
+	client      *http.Client
+	controlURL  string
+	serviceType string
+	localIP     net.IP
+}
+
+// DiscoverUPnP searches for an internet gateway device with SSDP on discoveryAddress
+func DiscoverUPnP(ctx context.Context, discoveryAddress string) (_ *UPnP, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	location, err := ssdpSearch(ctx, discoveryAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	client := &http.Client{}
+	controlURL, serviceType, err := findConnectionService(ctx, client, location)
+	if err != nil {
+		return nil, err
+	}
+
+	localIP, err := localIPFor(controlURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &UPnP{
+		client:      client,
+		controlURL:  controlURL,
+		serviceType: serviceType,
+		localIP:     localIP,
+	}, nil
+}
+
+// ssdpSearch sends an M-SEARCH request and returns the description location of the first gateway that responds
+func ssdpSearch(ctx context.Context, discoveryAddress string) (location string, err error) {
+	addr, err := net.ResolveUDPAddr("udp4", discoveryAddress)
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+
+	conn, err := net.ListenPacket("udp4", ":0")
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(conn.Close())) }()
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(3 * time.Second)
+	}
+	if err := conn.SetDeadline(deadline); err != nil {
+		return "", Error.Wrap(err)
+	}
+
+	request := "M-SEARCH * HTTP/1.1\r\n" +
+		"HOST: " + discoveryAddress + "\r\n" +
+		"ST: " + ssdpSearchTarget + "\r\n" +
+		"MAN: \"ssdp:discover\"\r\n" +
+		"MX: 2\r\n\r\n"
+	if _, err := conn.WriteTo([]byte(request), addr); err != nil {
+		return "", Error.Wrap(err)
+	}
+
+	buf := make([]byte, 2048)
+	for {
+		n, _, err := conn.ReadFrom(buf)
+		if err != nil {
+			return "", Error.New("no UPnP gateway found: %v", err)
+		}
+
+		response, err := http.ReadResponse(bufio.NewReader(bytes.NewReader(buf[:n])), nil)
+		if err != nil {
+			// not an SSDP response, keep waiting
+			continue
+		}
+		_ = response.Body.Close()
+
+		if !strings.Contains(response.Header.Get("St"), "InternetGatewayDevice") {
+			continue
+		}
+		if location := response.Header.Get("Location"); location != "" {
+			return location, nil
+		}
+	}
+}
+
+type upnpRoot struct {
+	URLBase string     `xml:"URLBase"`
+	Device  upnpDevice `xml:"device"`
+}
+
+type upnpDevice struct {
+	DeviceType string        `xml:"deviceType"`
+	Services   []upnpService `xml:"serviceList>service"`
+	Devices    []upnpDevice  `xml:"deviceList>device"`
+}
+
+type upnpService struct {
+	ServiceType string `xml:"serviceType"`
+	ControlURL  string `xml:"controlURL"`
+}
+
+// findService returns the first connection service of the device or its embedded devices
+func (device *upnpDevice) findService() (upnpService, bool) {
+	for _, service := range device.Services {
+		if strings.Contains(service.ServiceType, ":WANIPConnection:") || strings.Contains(service.ServiceType, ":WANPPPConnection:") {
+			return service, true
+		}
+	}
+	for i := range device.Devices {
+		if service, ok := device.Devices[i].findService(); ok {
+			return service, true
+		}
+	}
+	return upnpService{}, false
+}
+
+// findConnectionService fetches the device description and returns the control URL of the connection service
+func findConnectionService(ctx context.Context, client *http.Client, location string) (controlURL, serviceType string, err error) {
+	request, err := http.NewRequest(http.MethodGet, location, nil)
+	if err != nil {
+		return "", "", Error.Wrap(err)
+	}
+
+	response, err := client.Do(request.WithContext(ctx))
+	if err != nil {
+		return "", "", Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(response.Body.Close())) }()
+
+	if response.StatusCode != http.StatusOK {
+		return "", "", Error.New("unable to fetch device description: %s", response.Status)
+	}
+
+	var root upnpRoot
+	if err := xml.NewDecoder(response.Body).Decode(&root); err != nil {
+		return "", "", Error.Wrap(err)
+	}
+
+	service, ok := root.Device.findService()
+	if !ok {
+		return "", "", Error.New("gateway has no WANIPConnection or WANPPPConnection service")
+	}
+
+	base := location
+	if root.URLBase != "" {
+		base = root.URLBase
+	}
+	baseURL, err := url.Parse(base)
+	if err != nil {
+		return "", "", Error.Wrap(err)
+	}
+	control, err := baseURL.Parse(service.ControlURL)
+	if err != nil {
+		return "", "", Error.Wrap(err)
+	}
+	return control.String(), service.ServiceType, nil
+}
+
+// localIPFor returns the IP of the local interface used to reach the gateway
+func localIPFor(controlURL string) (net.IP, error) {
+	parsed, err := url.Parse(controlURL)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	address := parsed.Host
+	if parsed.Port() == "" {
+		port := "80"
+		if parsed.Scheme == "https" {
+			port = "443"
+		}
+		address = net.JoinHostPort(parsed.Hostname(), port)
+	}
+
+	// dialing UDP doesn't send any packets, it only selects the route
+	conn, err := net.Dial("udp4", address)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { _ = conn.Close() }()
+
+	return conn.LocalAddr().(*net.UDPAddr).IP, nil
+}
+
+// ExternalIP returns the public IP of the gateway
+func (upnp *UPnP) ExternalIP(ctx context.Context) (_ net.IP, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	response, err := upnp.soapRequest(ctx, "GetExternalIPAddress", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	ip := net.ParseIP(soapValue(response, "NewExternalIPAddress"))
+	if ip == nil {
+		return nil, Error.New("gateway returned an invalid external address")
+	}
+	return ip, nil
+}
+
+// AddPortMapping maps the TCP port externalPort of the gateway to internalPort of this host
+func (upnp *UPnP) AddPortMapping(ctx context.Context, internalPort, externalPort int, lifetime time.Duration) (_ int, _ time.Duration, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	add := func(lifetime time.Duration) error {
+		_, err := upnp.soapRequest(ctx, "AddPortMapping", [][2]string{
+			{"NewRemoteHost", ""},
+			{"NewExternalPort", strconv.Itoa(externalPort)},
+			{"NewProtocol", "TCP"},
+			{"NewInternalPort", strconv.Itoa(internalPort)},
+			{"NewInternalClient", upnp.localIP.String()},
+			{"NewEnabled", "1"},
+			{"NewPortMappingDescription", "storj storagenode"},
+			{"NewLeaseDuration", strconv.Itoa(int(lifetime / time.Second))},
+		})
+		return err
+	}
+
+	err = add(lifetime)
+	if soapError.Has(err) && strings.Contains(err.Error(), errOnlyPermanentLeases) {
+		lifetime = 0
+		err = add(lifetime)
+	}
+	if err != nil {
+		return 0, 0, err
+	}
+	return externalPort, lifetime, nil
+}
+
+// DeletePortMapping removes the mapping of externalPort
+func (upnp *UPnP) DeletePortMapping(ctx context.Context, internalPort, externalPort int) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = upnp.soapRequest(ctx, "DeletePortMapping", [][2]string{
+		{"NewRemoteHost", ""},
+		{"NewExternalPort", strconv.Itoa(externalPort)},
+		{"NewProtocol", "TCP"},
+	})
+	return err
+}
+
+// soapError is returned when the gateway rejects a request
+var soapError = errs.Class("upnp request failed")
+
+// soapRequest invokes an action of the connection service, arguments are sent in order
+func (upnp *UPnP) soapRequest(ctx context.Context, action string, arguments [][2]string) (_ []byte, err error) {
+	var body bytes.Buffer
+	body.WriteString(`<?xml version="1.0"?>` +
+		`<s:Envelope xmlns:s="http://schemas.xmlsoap.org/soap/envelope/" s:encodingStyle="http://schemas.xmlsoap.org/soap/encoding/">` +
+		`<s:Body>`)
+	fmt.Fprintf(&body, `<u:%s xmlns:u="%s">`, action, upnp.serviceType)
+	for _, argument := range arguments {
+		fmt.Fprintf(&body, "<%s>", argument[0])
+		if err := xml.EscapeText(&body, []byte(argument[1])); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		fmt.Fprintf(&body, "</%s>", argument[0])
+	}
+	fmt.Fprintf(&body, `</u:%s></s:Body></s:Envelope>`, action)
+
+	request, err := http.NewRequest(http.MethodPost, upnp.controlURL, &body)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	request.Header.Set("Content-Type", `text/xml; charset="utf-8"`)
+	request.Header.Set("SOAPAction", fmt.Sprintf(`"%s#%s"`, upnp.serviceType, action))
+
+	response, err := upnp.client.Do(request.WithContext(ctx))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(response.Body.Close())) }()
+
+	data, err := ioutil.ReadAll(io.LimitReader(response.Body, 64*1024))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, soapError.New("%s: %s %s", action, response.Status, soapValue(data, "errorCode"))
+	}
+	return data, nil
+}
+
+// soapValue returns the text of the first element with the local name
+func soapValue(data []byte, name string) string {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return ""
+		}
+		if start, ok := token.(xml.StartElement); ok && start.Name.Local == name {
+			var value string
+			if err := decoder.DecodeElement(&value, &start); err != nil {
+				return ""
+			}
+			return strings.TrimSpace(value)
+		}
+	}
+}
@@ -0,0 +1,23 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nat
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalIPFor(t *testing.T) {
+	for _, controlURL := range []string{
+		"http://127.0.0.1:5000/ctl/IPConn",
+		"http://127.0.0.1/ctl/IPConn",
+		"https://127.0.0.1/ctl/IPConn",
+	} {
+		ip, err := localIPFor(controlURL)
+		require.NoError(t, err, controlURL)
+		assert.Equal(t, "127.0.0.1", ip.String(), controlURL)
+	}
+}
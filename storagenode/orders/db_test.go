@@ -6,6 +6,7 @@ package orders_test
 import (
 	"crypto/rand"
 	"testing"
+	"time"
 
 	"storj.io/storj/internal/testidentity"
 
@@ -15,6 +16,7 @@ import (
 
 	"storj.io/storj/internal/testcontext"
 	"storj.io/storj/pkg/auth/signing"
+	"storj.io/storj/pkg/identity"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/storagenode"
@@ -135,3 +137,101 @@ func newRandomSerial() storj.SerialNumber {
 	_, _ = rand.Read(serial[:])
 	return serial
 }
+
+func TestOrdersExpiration(t *testing.T) {
+	storagenodedbtest.Run(t, func(t *testing.T, db storagenode.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		ordersdb := db.Orders()
+
+		storagenode := testidentity.MustPregeneratedSignedIdentity(0, storj.LatestIDVersion())
+		satellite0 := testidentity.MustPregeneratedSignedIdentity(1, storj.LatestIDVersion())
+		satellite1 := testidentity.MustPregeneratedSignedIdentity(2, storj.LatestIDVersion())
+		uplink := testidentity.MustPregeneratedSignedIdentity(3, storj.LatestIDVersion())
+
+		now := time.Now()
+
+		enqueue := func(satellite *identity.FullIdentity, orderExpiration time.Time) storj.SerialNumber {
+			serialNumber := newRandomSerial()
+
+			expiration, err := ptypes.TimestampProto(orderExpiration)
+			require.NoError(t, err)
+
+			limit, err := signing.SignOrderLimit(signing.SignerFromFullIdentity(satellite), &pb.OrderLimit2{
+				SerialNumber:    serialNumber,
+				SatelliteId:     satellite.ID,
+				UplinkId:        uplink.ID,
+				StorageNodeId:   storagenode.ID,
+				PieceId:         storj.NewPieceID(),
+				Limit:           100,
+				Action:          pb.PieceAction_GET,
+				OrderExpiration: expiration,
+			})
+			require.NoError(t, err)
+
+			order, err := signing.SignOrder(signing.SignerFromFullIdentity(uplink), &pb.Order2{
+				SerialNumber: serialNumber,
+				Amount:       50,
+			})
+			require.NoError(t, err)
+
+			err = ordersdb.Enqueue(ctx, &orders.Info{
+				Limit:  limit,
+				Order:  order,
+				Uplink: uplink.PeerIdentity(),
+			})
+			require.NoError(t, err)
+			return serialNumber
+		}
+
+		expired := enqueue(satellite0, now.Add(-time.Hour))
+		later := enqueue(satellite0, now.Add(2*time.Hour))
+		sooner := enqueue(satellite0, now.Add(time.Hour))
+		enqueue(satellite1, now.Add(time.Hour))
+
+		counts, err := ordersdb.CountUnsentBySatellite(ctx)
+		require.NoError(t, err)
+		require.Equal(t, map[storj.NodeID]int64{satellite0.ID: 3, satellite1.ID: 1}, counts)
+
+		// orders that expire first are listed first
+		unsent, err := ordersdb.ListUnsentForSatellite(ctx, satellite0.ID, 2)
+		require.NoError(t, err)
+		require.Len(t, unsent, 2)
+		require.Equal(t, expired, unsent[0].Limit.SerialNumber)
+		require.Equal(t, sooner, unsent[1].Limit.SerialNumber)
+
+		count, err := ordersdb.ExpireUnsent(ctx, now)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, count)
+
+		unsent, err = ordersdb.ListUnsentForSatellite(ctx, satellite0.ID, 10)
+		require.NoError(t, err)
+		require.Len(t, unsent, 2)
+		require.Equal(t, sooner, unsent[0].Limit.SerialNumber)
+		require.Equal(t, later, unsent[1].Limit.SerialNumber)
+
+		archived, err := ordersdb.ListArchived(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, archived, 1)
+		require.Equal(t, expired, archived[0].Limit.SerialNumber)
+		require.Equal(t, orders.StatusFailed, archived[0].Status)
+		require.True(t, archived[0].ArchivedAt.Equal(now), archived[0].ArchivedAt)
+
+		err = ordersdb.Archive(ctx, satellite0.ID, sooner, orders.StatusAccepted)
+		require.NoError(t, err)
+
+		// nothing was archived before now
+		count, err = ordersdb.DeleteArchived(ctx, now.Add(-time.Minute))
+		require.NoError(t, err)
+		require.EqualValues(t, 0, count)
+
+		count, err = ordersdb.DeleteArchived(ctx, time.Now().Add(time.Minute))
+		require.NoError(t, err)
+		require.EqualValues(t, 2, count)
+
+		archived, err = ordersdb.ListArchived(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, archived, 0)
+	})
+}
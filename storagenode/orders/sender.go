@@ -6,10 +6,14 @@ package orders
 import (
 	"context"
 	"io"
+	"sort"
+	"sync"
 	"time"
 
+	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
 
 	"storj.io/storj/internal/sync2"
 	"storj.io/storj/pkg/identity"
@@ -19,6 +23,13 @@ import (
 	"storj.io/storj/pkg/transport"
 )
 
+var (
+	mon = monkit.Package()
+
+	// Error is the default error class for orders.
+	Error = errs.Class("orders")
+)
+
 // Info contains full information about an order.
 type Info struct {
 	Limit  *pb.OrderLimit2
@@ -44,6 +55,8 @@ const (
 	StatusUnsent Status = iota
 	StatusAccepted
 	StatusRejected
+	// StatusFailed is used for orders that expired before they could be sent.
+	StatusFailed
 )
 
 // DB implements storing orders for sending to the satellite.
@@ -54,18 +67,44 @@ type DB interface {
 	ListUnsent(ctx context.Context, limit int) ([]*Info, error)
 	// ListUnsentBySatellite returns orders that haven't been sent yet grouped by satellite.
 	ListUnsentBySatellite(ctx context.Context) (map[storj.NodeID][]*Info, error)
+	// ListUnsentForSatellite returns at most limit orders of the satellite that haven't been sent yet.
+	ListUnsentForSatellite(ctx context.Context, satellite storj.NodeID, limit int) ([]*Info, error)
+	// CountUnsentBySatellite returns the number of orders that haven't been sent yet for every satellite.
+	CountUnsentBySatellite(ctx context.Context) (map[storj.NodeID]int64, error)
+	// ExpireUnsent archives unsent orders that expired before now as failed and returns how many were expired.
+	ExpireUnsent(ctx context.Context, now time.Time) (int64, error)
 
 	// Archive marks order as being handled.
 	Archive(ctx context.Context, satellite storj.NodeID, serial storj.SerialNumber, status Status) error
 
 	// ListArchived returns orders that have been sent.
 	ListArchived(ctx context.Context, limit int) ([]*ArchivedInfo, error)
+	// DeleteArchived deletes orders archived before archivedBefore and returns how many were deleted.
+	DeleteArchived(ctx context.Context, archivedBefore time.Time) (int64, error)
 }
 
 // SenderConfig defines configuration for sending orders.
 type SenderConfig struct {
-	Interval time.Duration `help:"duration between sending" default:"1h0m0s"`
-	Timeout  time.Duration `help:"timeout for sending" default:"1h0m0s"`
+	Interval         time.Duration `help:"duration between sending" default:"1h0m0s"`
+	Timeout          time.Duration `help:"timeout for sending" default:"1h0m0s"`
+	BatchSize        int           `help:"maximum number of orders sent to a satellite in a single settlement" default:"1000"`
+	MaxBackoff       time.Duration `help:"maximum duration to wait before retrying a satellite whose settlement failed" default:"24h0m0s"`
+	ArchiveRetention time.Duration `help:"how long settled and expired orders are kept in the archive, zero keeps them forever" default:"720h0m0s"`
+}
+
+// SatelliteQueue contains the unsent orders and the settlement state of a satellite.
+type SatelliteQueue struct {
+	SatelliteID storj.NodeID
+	Unsent      int64
+	Failures    int       // consecutive failed settlements
+	NextAttempt time.Time // zero when the satellite is not backed off
+}
+
+// backoff tracks consecutive failed settlements with a satellite.
+type backoff struct {
+	failures    int
+	skip        int // number of intervals to skip before the next attempt
+	nextAttempt time.Time
 }
 
 // Sender sends every interval unsent orders to the satellite.
@@ -78,10 +117,16 @@ type Sender struct {
 	orders    DB
 
 	Loop sync2.Cycle
+
+	mu       sync.Mutex
+	backoffs map[storj.NodeID]*backoff
 }
 
 // NewSender creates an order sender.
 func NewSender(log *zap.Logger, transport transport.Client, kademlia *kademlia.Kademlia, orders DB, config SenderConfig) *Sender {
+	if config.BatchSize <= 0 {
+		config.BatchSize = 1000
+	}
 	return &Sender{
 		log:       log,
 		transport: transport,
@@ -90,44 +135,194 @@ func NewSender(log *zap.Logger, transport transport.Client, kademlia *kademlia.K
 		config:    config,
 
 		Loop: *sync2.NewCycle(config.Interval),
+
+		backoffs: map[storj.NodeID]*backoff{},
 	}
 }
 
 // Run sends orders on every interval to the appropriate satellites.
 func (sender *Sender) Run(ctx context.Context) error {
 	return sender.Loop.Run(ctx, func(ctx context.Context) error {
-		sender.log.Debug("sending")
+		sender.runOnce(ctx, time.Now())
+		return nil
+	})
+}
 
-		ordersBySatellite, err := sender.orders.ListUnsentBySatellite(ctx)
-		if err != nil {
-			sender.log.Error("listing orders", zap.Error(err))
-			return nil
+// runOnce expires and prunes orders and settles the unsent orders with satellites that aren't backed off.
+func (sender *Sender) runOnce(ctx context.Context, now time.Time) {
+	sender.log.Debug("sending")
+
+	sender.cleanup(ctx, now)
+
+	queues, err := sender.orders.CountUnsentBySatellite(ctx)
+	if err != nil {
+		sender.log.Error("counting orders", zap.Error(err))
+		return
+	}
+
+	var unsent int64
+	for _, count := range queues {
+		unsent += count
+	}
+	mon.IntVal("unsent_orders").Observe(unsent)
+
+	sender.mu.Lock()
+	for satelliteID := range sender.backoffs {
+		if _, ok := queues[satelliteID]; !ok {
+			delete(sender.backoffs, satelliteID)
 		}
+	}
+	sender.mu.Unlock()
 
-		if len(ordersBySatellite) > 0 {
-			var group errgroup.Group
-			ctx, cancel := context.WithTimeout(ctx, sender.config.Timeout)
-			defer cancel()
+	if len(queues) == 0 {
+		sender.log.Debug("no orders to send")
+		return
+	}
 
-			for satelliteID, orders := range ordersBySatellite {
-				satelliteID, orders := satelliteID, orders
-				group.Go(func() error {
+	var group errgroup.Group
+	ctx, cancel := context.WithTimeout(ctx, sender.config.Timeout)
+	defer cancel()
+
+	for satelliteID := range queues {
+		satelliteID := satelliteID
+		if !sender.ready(satelliteID) {
+			sender.log.Debug("backing off", zap.Stringer("satellite", satelliteID))
+			continue
+		}
 
-					sender.Settle(ctx, satelliteID, orders)
-					return nil
-				})
+		group.Go(func() error {
+			err := sender.settleSatellite(ctx, satelliteID)
+			if err != nil {
+				sender.log.Named(satelliteID.String()).Error("settlement failed", zap.Error(err))
 			}
-			_ = group.Wait() // doesn't return errors
-		} else {
-			sender.log.Debug("no orders to send")
-		}
+			sender.update(satelliteID, now, err)
+			return nil
+		})
+	}
+	_ = group.Wait() // doesn't return errors
+}
 
-		return nil
+// cleanup archives orders that can't be sent anymore and deletes archived orders past the retention.
+func (sender *Sender) cleanup(ctx context.Context, now time.Time) {
+	expired, err := sender.orders.ExpireUnsent(ctx, now)
+	if err != nil {
+		sender.log.Error("expiring orders", zap.Error(err))
+	} else if expired > 0 {
+		sender.log.Warn("orders expired before they could be sent", zap.Int64("count", expired))
+	}
+	mon.IntVal("orders_expired").Observe(expired)
+
+	if sender.config.ArchiveRetention <= 0 {
+		return
+	}
+
+	deleted, err := sender.orders.DeleteArchived(ctx, now.Add(-sender.config.ArchiveRetention))
+	if err != nil {
+		sender.log.Error("deleting archived orders", zap.Error(err))
+		return
+	}
+	mon.IntVal("archived_orders_deleted").Observe(deleted)
+}
+
+// ready returns whether the satellite should be settled with in this interval.
+func (sender *Sender) ready(satelliteID storj.NodeID) bool {
+	sender.mu.Lock()
+	defer sender.mu.Unlock()
+
+	state, ok := sender.backoffs[satelliteID]
+	if !ok || state.skip <= 0 {
+		return true
+	}
+	state.skip--
+	return false
+}
+
+// update records the result of a settlement, every consecutive failure doubles the number
+// of intervals until the next attempt up to the maximum backoff.
+func (sender *Sender) update(satelliteID storj.NodeID, now time.Time, err error) {
+	sender.mu.Lock()
+	defer sender.mu.Unlock()
+
+	if err == nil {
+		delete(sender.backoffs, satelliteID)
+		return
+	}
+
+	state, ok := sender.backoffs[satelliteID]
+	if !ok {
+		state = &backoff{}
+		sender.backoffs[satelliteID] = state
+	}
+	state.failures++
+
+	maxIntervals := 1
+	if sender.config.Interval > 0 && sender.config.MaxBackoff > sender.config.Interval {
+		maxIntervals = int(sender.config.MaxBackoff / sender.config.Interval)
+	}
+	intervals := maxIntervals
+	if state.failures <= 30 && 1<<uint(state.failures-1) < maxIntervals {
+		intervals = 1 << uint(state.failures-1)
+	}
+
+	state.skip = intervals - 1
+	state.nextAttempt = now.Add(time.Duration(intervals) * sender.config.Interval)
+}
+
+// Queues returns the unsent orders and the settlement state of every satellite.
+func (sender *Sender) Queues(ctx context.Context) (_ []SatelliteQueue, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	counts, err := sender.orders.CountUnsentBySatellite(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	sender.mu.Lock()
+	defer sender.mu.Unlock()
+
+	queues := make([]SatelliteQueue, 0, len(counts))
+	for satelliteID, count := range counts {
+		queue := SatelliteQueue{
+			SatelliteID: satelliteID,
+			Unsent:      count,
+		}
+		if state, ok := sender.backoffs[satelliteID]; ok {
+			queue.Failures = state.failures
+			queue.NextAttempt = state.nextAttempt
+		}
+		queues = append(queues, queue)
+	}
+	sort.Slice(queues, func(i, k int) bool {
+		return queues[i].SatelliteID.Less(queues[k].SatelliteID)
 	})
+	return queues, nil
+}
+
+// settleSatellite settles the unsent orders of the satellite in batches until none are left.
+func (sender *Sender) settleSatellite(ctx context.Context, satelliteID storj.NodeID) error {
+	for {
+		orders, err := sender.orders.ListUnsentForSatellite(ctx, satelliteID, sender.config.BatchSize)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		if len(orders) == 0 {
+			return nil
+		}
+
+		// a successful settlement archives every order of the batch
+		if err := sender.Settle(ctx, satelliteID, orders); err != nil {
+			return err
+		}
+		if len(orders) < sender.config.BatchSize {
+			return nil
+		}
+	}
 }
 
-// Settle uploads orders to the satellite.
-func (sender *Sender) Settle(ctx context.Context, satelliteID storj.NodeID, orders []*Info) {
+// Settle uploads orders to the satellite, it fails when any of the orders wasn't archived.
+func (sender *Sender) Settle(ctx context.Context, satelliteID storj.NodeID, orders []*Info) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
 	log := sender.log.Named(satelliteID.String())
 
 	log.Info("sending", zap.Int("count", len(orders)))
@@ -135,14 +330,12 @@ func (sender *Sender) Settle(ctx context.Context, satelliteID storj.NodeID, orde
 
 	satellite, err := sender.kademlia.FindNode(ctx, satelliteID)
 	if err != nil {
-		log.Error("unable to find satellite on the network", zap.Error(err))
-		return
+		return Error.New("unable to find satellite on the network: %v", err)
 	}
 
 	conn, err := sender.transport.DialNode(ctx, &satellite)
 	if err != nil {
-		log.Error("unable to connect to the satellite", zap.Error(err))
-		return
+		return Error.New("unable to connect to the satellite: %v", err)
 	}
 	defer func() {
 		if err := conn.Close(); err != nil {
@@ -152,8 +345,7 @@ func (sender *Sender) Settle(ctx context.Context, satelliteID storj.NodeID, orde
 
 	client, err := pb.NewOrdersClient(conn).Settlement(ctx)
 	if err != nil {
-		log.Error("failed to start settlement", zap.Error(err))
-		return
+		return Error.New("failed to start settlement: %v", err)
 	}
 
 	var group errgroup.Group
@@ -170,14 +362,14 @@ func (sender *Sender) Settle(ctx context.Context, satelliteID storj.NodeID, orde
 		return client.CloseSend()
 	})
 
+	var archived int
+	var recvErr error
 	for {
 		response, err := client.Recv()
 		if err != nil {
-			if err == io.EOF {
-				break
+			if err != io.EOF {
+				recvErr = Error.New("failed to receive response: %v", err)
 			}
-
-			log.Error("failed to receive response", zap.Error(err))
 			break
 		}
 
@@ -186,20 +378,31 @@ func (sender *Sender) Settle(ctx context.Context, satelliteID storj.NodeID, orde
 			err = sender.orders.Archive(ctx, satelliteID, response.SerialNumber, StatusAccepted)
 			if err != nil {
 				log.Error("failed to archive order as accepted", zap.Stringer("serial", response.SerialNumber), zap.Error(err))
+				continue
 			}
+			archived++
 		case pb.SettlementResponse_REJECTED:
 			err = sender.orders.Archive(ctx, satelliteID, response.SerialNumber, StatusRejected)
 			if err != nil {
 				log.Error("failed to archive order as rejected", zap.Stringer("serial", response.SerialNumber), zap.Error(err))
+				continue
 			}
+			archived++
 		default:
-			log.Error("unexpected response", zap.Error(err))
+			log.Error("unexpected response", zap.Stringer("status", response.Status))
 		}
 	}
 
 	if err := group.Wait(); err != nil {
-		log.Error("sending agreements returned an error", zap.Error(err))
+		return Error.New("sending agreements returned an error: %v", err)
+	}
+	if recvErr != nil {
+		return recvErr
 	}
+	if archived < len(orders) {
+		return Error.New("%d of %d orders were not settled", len(orders)-archived, len(orders))
+	}
+	return nil
 }
 
 // Close stops the sending service.
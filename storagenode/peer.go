@@ -5,6 +5,9 @@ package storagenode
 
 import (
 	"context"
+	"net"
+	"strconv"
+	"strings"
 
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
@@ -25,11 +28,18 @@ import (
 	"storj.io/storj/pkg/transport"
 	"storj.io/storj/storage"
 	"storj.io/storj/storagenode/bandwidth"
+	"storj.io/storj/storagenode/collector"
+	"storj.io/storj/storagenode/console"
+	"storj.io/storj/storagenode/console/consoleserver"
+	"storj.io/storj/storagenode/contact"
+	"storj.io/storj/storagenode/gracefulexit"
 	"storj.io/storj/storagenode/inspector"
 	"storj.io/storj/storagenode/monitor"
+	"storj.io/storj/storagenode/nat"
 	"storj.io/storj/storagenode/orders"
 	"storj.io/storj/storagenode/pieces"
 	"storj.io/storj/storagenode/piecestore"
+	"storj.io/storj/storagenode/retain"
 	"storj.io/storj/storagenode/trust"
 )
 
@@ -47,6 +57,7 @@ type DB interface {
 	CertDB() trust.CertDB
 	Bandwidth() bandwidth.DB
 	UsedSerials() piecestore.UsedSerials
+	GracefulExit() gracefulexit.DB
 
 	// TODO: use better interfaces
 	PSDB() *psdb.DB
@@ -59,9 +70,17 @@ type Config struct {
 
 	Server   server.Config
 	Kademlia kademlia.Config
+	NAT      nat.Config
 	Storage  psserver.Config
 
-	Storage2 piecestore.Config
+	Storage2  piecestore.Config
+	Collector collector.Config
+
+	Contact contact.Config
+
+	GracefulExit gracefulexit.Config
+
+	Console consoleserver.Config
 
 	Version version.Config
 }
@@ -93,17 +112,40 @@ type Peer struct {
 		Inspector    *kademlia.Inspector
 	}
 
+	NAT *nat.Service
+
 	Agreements struct {
 		Sender *agreementsender.AgreementSender
 	}
 
 	Storage2 struct {
-		Trust     *trust.Pool
-		Store     *pieces.Store
-		Endpoint  *piecestore.Endpoint
-		Inspector *inspector.Endpoint
-		Monitor   *monitor.Service
-		Sender    *orders.Sender
+		Trust      *trust.Pool
+		Store      *pieces.Store
+		Reconciler *pieces.Reconciler
+		Endpoint   *piecestore.Endpoint
+		Inspector  *inspector.Endpoint
+		Monitor    *monitor.Service
+		Sender     *orders.Sender
+		Collector  *collector.Service
+		Retain     *retain.Service
+
+		UsedSerials      *piecestore.UsedSerialsTable
+		UsedSerialsChore *piecestore.UsedSerialsChore
+	}
+
+	Contact struct {
+		Chore *contact.Chore
+	}
+
+	GracefulExit struct {
+		Worker   *gracefulexit.Worker
+		Endpoint *gracefulexit.Endpoint
+	}
+
+	Console struct {
+		Listener net.Listener
+		Service  *console.Service
+		Endpoint *consoleserver.Server
 	}
 }
 
@@ -186,6 +228,32 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config Config, ver
 		pb.RegisterKadInspectorServer(peer.Server.PrivateGRPC(), peer.Kademlia.Inspector)
 	}
 
+	{ // setup port mapping
+		_, port, err := net.SplitHostPort(peer.Addr())
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+		internalPort, err := strconv.Atoi(port)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+
+		var update func(address string) error
+		// an explicitly configured external address takes precedence over the mapped one
+		if config.Kademlia.ExternalAddress == "" {
+			update = func(address string) error {
+				self := peer.Kademlia.RoutingTable.Local()
+				self.Address = &pb.NodeAddress{
+					Transport: pb.NodeTransport_TCP_TLS_GRPC,
+					Address:   address,
+				}
+				return peer.Kademlia.RoutingTable.UpdateSelf(&self)
+			}
+		}
+
+		peer.NAT = nat.NewService(peer.Log.Named("nat"), config.NAT, internalPort, update)
+	}
+
 	{ // agreements
 		config := config.Storage // TODO: separate config
 		peer.Agreements.Sender = agreementsender.New(
@@ -203,6 +271,12 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config Config, ver
 		}
 
 		peer.Storage2.Store = pieces.NewStore(peer.Log.Named("pieces"), peer.DB.Pieces())
+		peer.Storage2.Reconciler = pieces.NewReconciler(peer.Log.Named("pieces:reconciler"), peer.Storage2.Store, peer.DB.PieceInfo())
+
+		satelliteLimits, err := monitor.ParseSatelliteLimits(config.Storage2.Monitor.SatelliteLimits)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
 
 		peer.Storage2.Monitor = monitor.NewService(
 			log.Named("piecestore:monitor"),
@@ -214,6 +288,24 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config Config, ver
 			config.Storage.AllocatedBandwidth.Int64(),
 			//TODO use config.Storage.Monitor.Interval, but for some reason is not set
 			config.Storage.KBucketRefreshInterval,
+			config.Storage2.Monitor,
+			satelliteLimits,
+		)
+
+		peer.Storage2.UsedSerials = piecestore.NewUsedSerialsTable(config.Storage2.MaxUsedSerialsSize.Int64())
+		peer.Storage2.UsedSerialsChore = piecestore.NewUsedSerialsChore(
+			peer.Log.Named("piecestore:usedserials"),
+			peer.Storage2.UsedSerials,
+			peer.DB.UsedSerials(),
+			config.Storage2.UsedSerialsInterval,
+			config.Storage2.ExpirationGracePeriod,
+		)
+
+		peer.Storage2.Retain = retain.NewService(
+			peer.Log.Named("retain"),
+			peer.Storage2.Store,
+			peer.DB.PieceInfo(),
+			config.Storage2.RetainTimeBuffer,
 		)
 
 		peer.Storage2.Endpoint, err = piecestore.NewEndpoint(
@@ -226,6 +318,8 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config Config, ver
 			peer.DB.Orders(),
 			peer.DB.Bandwidth(),
 			peer.DB.UsedSerials(),
+			peer.Storage2.UsedSerials,
+			peer.Storage2.Retain,
 			config.Storage2,
 		)
 		if err != nil {
@@ -233,22 +327,96 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config Config, ver
 		}
 		pb.RegisterPiecestoreServer(peer.Server.GRPC(), peer.Storage2.Endpoint)
 
+		peer.Storage2.Collector = collector.NewService(
+			peer.Log.Named("collector"),
+			peer.Storage2.Store,
+			peer.DB.PieceInfo(),
+			config.Collector,
+		)
+
+		peer.Storage2.Sender = orders.NewSender(
+			log.Named("piecestore:orderssender"),
+			peer.Transport,
+			peer.Kademlia.Service,
+			peer.DB.Orders(),
+			config.Storage2.Sender,
+		)
+
 		peer.Storage2.Inspector = inspector.NewEndpoint(
 			peer.Log.Named("pieces:inspector"),
 			peer.DB.PieceInfo(),
 			peer.Kademlia.Service,
 			peer.DB.Bandwidth(),
 			peer.DB.PSDB(),
+			peer.Storage2.Collector,
+			peer.Storage2.Monitor,
+			peer.Storage2.Sender,
 			config.Storage,
 		)
 		pb.RegisterPieceStoreInspectorServer(peer.Server.PrivateGRPC(), peer.Storage2.Inspector)
+	}
 
-		peer.Storage2.Sender = orders.NewSender(
-			log.Named("piecestore:orderssender"),
+	{ // setup contact
+		peer.Contact.Chore = contact.NewChore(
+			peer.Log.Named("contact:chore"),
+			peer.Transport,
+			peer.Kademlia.Service,
+			peer.Storage2.Trust,
+			peer.Storage2.Monitor,
+			pb.NodeOperator{
+				Email:       config.Kademlia.Operator.Email,
+				Wallet:      config.Kademlia.Operator.Wallet,
+				CountryCode: strings.ToUpper(config.Kademlia.Operator.Country),
+			},
+			config.Contact,
+		)
+	}
+
+	{ // setup graceful exit
+		peer.GracefulExit.Worker = gracefulexit.NewWorker(
+			peer.Log.Named("gracefulexit:worker"),
+			peer.DB.GracefulExit(),
 			peer.Transport,
 			peer.Kademlia.Service,
+			peer.Storage2.Store,
+			peer.DB.PieceInfo(),
+			config.GracefulExit,
+		)
+
+		peer.GracefulExit.Endpoint = gracefulexit.NewEndpoint(
+			peer.Log.Named("gracefulexit:endpoint"),
+			peer.DB.GracefulExit(),
+			peer.Transport,
+			peer.Kademlia.Service,
+			peer.Storage2.Trust,
+		)
+		pb.RegisterNodeGracefulExitServer(peer.Server.PrivateGRPC(), peer.GracefulExit.Endpoint)
+	}
+
+	{ // setup console
+		peer.Console.Listener, err = net.Listen("tcp", config.Console.Address)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+
+		peer.Console.Service = console.NewService(
+			peer.Log.Named("console:service"),
+			peer.DB.Bandwidth(),
+			peer.DB.PieceInfo(),
 			peer.DB.Orders(),
-			config.Storage2.Sender,
+			peer.Kademlia.Service,
+			peer.Storage2.Monitor,
+			peer.Version,
+			versionInfo,
+			config.Storage.AllocatedBandwidth,
+			config.Storage.AllocatedDiskSpace,
+		)
+
+		peer.Console.Endpoint = consoleserver.NewServer(
+			peer.Log.Named("console:endpoint"),
+			config.Console,
+			peer.Console.Service,
+			peer.Console.Listener,
 		)
 	}
 
@@ -262,6 +430,9 @@ func (peer *Peer) Run(ctx context.Context) error {
 	group.Go(func() error {
 		return errs2.IgnoreCanceled(peer.Version.Run(ctx))
 	})
+	group.Go(func() error {
+		return errs2.IgnoreCanceled(peer.NAT.Run(ctx))
+	})
 	group.Go(func() error {
 		return errs2.IgnoreCanceled(peer.Kademlia.Service.Bootstrap(ctx))
 	})
@@ -277,6 +448,27 @@ func (peer *Peer) Run(ctx context.Context) error {
 	group.Go(func() error {
 		return errs2.IgnoreCanceled(peer.Storage2.Monitor.Run(ctx))
 	})
+	group.Go(func() error {
+		return errs2.IgnoreCanceled(peer.Storage2.Reconciler.Run(ctx))
+	})
+	group.Go(func() error {
+		return errs2.IgnoreCanceled(peer.Storage2.Collector.Run(ctx))
+	})
+	group.Go(func() error {
+		return errs2.IgnoreCanceled(peer.Storage2.UsedSerialsChore.Run(ctx))
+	})
+	group.Go(func() error {
+		return errs2.IgnoreCanceled(peer.Storage2.Retain.Run(ctx))
+	})
+	group.Go(func() error {
+		return errs2.IgnoreCanceled(peer.Contact.Chore.Run(ctx))
+	})
+	group.Go(func() error {
+		return errs2.IgnoreCanceled(peer.GracefulExit.Worker.Run(ctx))
+	})
+	group.Go(func() error {
+		return errs2.IgnoreCanceled(peer.Console.Endpoint.Run(ctx))
+	})
 	group.Go(func() error {
 		// TODO: move the message into Server instead
 		// Don't change the format of this comment, it is used to figure out the node id.
@@ -300,7 +492,33 @@ func (peer *Peer) Close() error {
 		errlist.Add(peer.Server.Close())
 	}
 
+	if peer.Console.Endpoint != nil {
+		errlist.Add(peer.Console.Endpoint.Close())
+	} else {
+		if peer.Console.Listener != nil {
+			errlist.Add(peer.Console.Listener.Close())
+		}
+	}
+
 	// close services in reverse initialization order
+	if peer.GracefulExit.Worker != nil {
+		errlist.Add(peer.GracefulExit.Worker.Close())
+	}
+	if peer.Contact.Chore != nil {
+		errlist.Add(peer.Contact.Chore.Close())
+	}
+	if peer.Storage2.Retain != nil {
+		errlist.Add(peer.Storage2.Retain.Close())
+	}
+	if peer.Storage2.Collector != nil {
+		errlist.Add(peer.Storage2.Collector.Close())
+	}
+	if peer.Storage2.UsedSerialsChore != nil {
+		errlist.Add(peer.Storage2.UsedSerialsChore.Close())
+	}
+	if peer.NAT != nil {
+		errlist.Add(peer.NAT.Close())
+	}
 	if peer.Kademlia.Service != nil {
 		errlist.Add(peer.Kademlia.Service.Close())
 	}
@@ -53,6 +53,7 @@ func TestPieceInfo(t *testing.T) {
 
 			PieceID:         pieceid0,
 			PieceSize:       123,
+			PieceCreation:   now.Add(-time.Hour),
 			PieceExpiration: &now,
 
 			UplinkPieceHash: piecehash0,
@@ -122,12 +123,78 @@ func TestPieceInfo(t *testing.T) {
 		require.NoError(t, err)
 		require.Empty(t, cmp.Diff(info1, info1loaded, cmp.Comparer(pb.Equal)))
 
+		// getting space used by satellite
+		spaceUsed, err := pieceinfos.SpaceUsedForSatellite(ctx, info0.SatelliteID)
+		require.NoError(t, err)
+		require.Equal(t, info0.PieceSize, spaceUsed)
+
+		spaceUsedBySatellite, err := pieceinfos.SpaceUsedBySatellite(ctx)
+		require.NoError(t, err)
+		require.Equal(t, map[storj.NodeID]int64{
+			info0.SatelliteID: info0.PieceSize,
+			info1.SatelliteID: info1.PieceSize,
+			info2.SatelliteID: info2.PieceSize,
+		}, spaceUsedBySatellite)
+
+		// getting pieces created before some time
+		pieceIDs, err := pieceinfos.GetPieceIDs(ctx, info0.SatelliteID, now, 10, 0)
+		require.NoError(t, err)
+		require.Equal(t, []storj.PieceID{info0.PieceID}, pieceIDs)
+
+		pieceIDs, err = pieceinfos.GetPieceIDs(ctx, info0.SatelliteID, now.Add(-2*time.Hour), 10, 0)
+		require.NoError(t, err)
+		require.Empty(t, pieceIDs)
+
 		// getting expired pieces
 		exp := time.Now().Add(time.Hour * 24)
-		infoexp, err := pieceinfos.GetExpired(ctx, exp)
+		infoexp, err := pieceinfos.GetExpired(ctx, exp, 10, 0)
 		assert.NoError(t, err)
 		assert.NotEmpty(t, infoexp)
 
+		// trashing
+		err = pieceinfos.Trash(ctx, info0.SatelliteID, info0.PieceID, now)
+		require.NoError(t, err)
+
+		_, err = pieceinfos.Get(ctx, info0.SatelliteID, info0.PieceID)
+		require.Error(t, err, "getting trashed element")
+
+		trashUsed, err := pieceinfos.SpaceUsedInTrash(ctx)
+		require.NoError(t, err)
+		require.Equal(t, info0.PieceSize, trashUsed)
+
+		deleted, err := pieceinfos.DeleteTrash(ctx, now.Add(-time.Minute))
+		require.NoError(t, err)
+		require.Zero(t, deleted, "deleting trash of pieces trashed later")
+
+		// restoring the trash
+		restored, err := pieceinfos.RestoreTrash(ctx, info1.SatelliteID)
+		require.NoError(t, err)
+		require.Zero(t, restored, "restoring trash of a different satellite")
+
+		restored, err = pieceinfos.RestoreTrash(ctx, info0.SatelliteID)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, restored)
+
+		info0loaded, err = pieceinfos.Get(ctx, info0.SatelliteID, info0.PieceID)
+		require.NoError(t, err)
+		require.Empty(t, cmp.Diff(info0, info0loaded, cmp.Comparer(pb.Equal)))
+
+		// emptying the trash
+		err = pieceinfos.Trash(ctx, info0.SatelliteID, info0.PieceID, now)
+		require.NoError(t, err)
+
+		deleted, err = pieceinfos.DeleteTrash(ctx, now.Add(time.Minute))
+		require.NoError(t, err)
+		require.EqualValues(t, 1, deleted)
+
+		restored, err = pieceinfos.RestoreTrash(ctx, info0.SatelliteID)
+		require.NoError(t, err)
+		require.Zero(t, restored, "restoring emptied trash")
+
+		trashUsed, err = pieceinfos.SpaceUsedInTrash(ctx)
+		require.NoError(t, err)
+		require.Zero(t, trashUsed)
+
 		// deleting
 		err = pieceinfos.Delete(ctx, info0.SatelliteID, info0.PieceID)
 		require.NoError(t, err)
@@ -0,0 +1,158 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pieces
+
+import (
+	"context"
+	"io"
+	"os"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storage"
+)
+
+// MigrateReport summarizes moving pieces from one blob storage to another
+type MigrateReport struct {
+	Moved      int64
+	MovedBytes int64
+	// Skipped counts blobs without piece information, they are left in place
+	Skipped int64
+}
+
+// ErrUnknownBlobs is returned by Migrate when blobs without piece information were left in the source
+var ErrUnknownBlobs = errs.Class("blobs without piece information")
+
+// Migrate moves the blobs of all pieces with piece information from one blob storage to another,
+// it must only be used while the storage node is not running. Every blob is committed to the
+// destination before it's deleted from the source, so an interrupted migration can be repeated.
+// Blobs without piece information are left in the source, unless skipUnknown is set
+// the migration fails with ErrUnknownBlobs after moving the other blobs of their satellite.
+func Migrate(ctx context.Context, log *zap.Logger, from, to storage.Blobs, pieceinfos DB, skipUnknown bool) (report MigrateReport, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	namespaces, err := from.ListNamespaces(ctx)
+	if err != nil {
+		return report, Error.Wrap(err)
+	}
+
+	for _, namespace := range namespaces {
+		satelliteID, err := storj.NodeIDFromBytes(namespace)
+		if err != nil {
+			// not a satellite namespace
+			continue
+		}
+
+		if err := migrateSatellite(ctx, log, from, to, pieceinfos, satelliteID, skipUnknown, &report); err != nil {
+			return report, err
+		}
+	}
+	return report, nil
+}
+
+// migrateSatellite moves the blobs of a single satellite
+func migrateSatellite(ctx context.Context, log *zap.Logger, from, to storage.Blobs, pieceinfos DB, satelliteID storj.NodeID, skipUnknown bool, report *MigrateReport) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	known := make(map[storj.PieceID]struct{})
+	createdBefore := time.Now()
+	for offset := 0; ; offset += reconcileBatchSize {
+		pieceIDs, err := pieceinfos.GetPieceIDs(ctx, satelliteID, createdBefore, reconcileBatchSize, offset)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		for _, pieceID := range pieceIDs {
+			known[pieceID] = struct{}{}
+		}
+		if len(pieceIDs) < reconcileBatchSize {
+			break
+		}
+	}
+
+	skipped := report.Skipped
+	err = from.WalkNamespace(ctx, satelliteID.Bytes(), func(blob storage.BlobInfo) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		pieceID, err := storj.PieceIDFromBytes(blob.Ref.Key)
+		if err != nil {
+			// not a piece
+			return nil
+		}
+		if _, ok := known[pieceID]; !ok {
+			log.Warn("skipping blob without piece information",
+				zap.Stringer("satellite ID", satelliteID),
+				zap.Stringer("piece ID", pieceID))
+			report.Skipped++
+			return nil
+		}
+
+		if err := moveBlob(ctx, from, to, blob); err != nil {
+			return Error.New("unable to move piece %v: %v", pieceID, err)
+		}
+		report.Moved++
+		report.MovedBytes += blob.Size
+		return nil
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	if skipped = report.Skipped - skipped; skipped > 0 && !skipUnknown {
+		return ErrUnknownBlobs.New("%d blobs of satellite %v were left in place", skipped, satelliteID)
+	}
+	return nil
+}
+
+// moveBlob copies the blob to the destination and deletes it from the source
+func moveBlob(ctx context.Context, from, to storage.Blobs, blob storage.BlobInfo) error {
+	existing, err := to.Open(ctx, blob.Ref)
+	switch {
+	case err == nil:
+		// a previous migration was interrupted after committing the copy
+		size, err := existing.Size()
+		err = errs.Combine(err, existing.Close())
+		if err != nil {
+			return err
+		}
+		if size != blob.Size {
+			return errs.New("destination contains a different blob of %d bytes", size)
+		}
+		return from.Delete(ctx, blob.Ref)
+	case !os.IsNotExist(err):
+		return err
+	}
+
+	if err := copyBlob(ctx, from, to, blob); err != nil {
+		return err
+	}
+	return from.Delete(ctx, blob.Ref)
+}
+
+// copyBlob copies the blob to the destination and commits it
+func copyBlob(ctx context.Context, from, to storage.Blobs, blob storage.BlobInfo) (err error) {
+	reader, err := from.Open(ctx, blob.Ref)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, reader.Close()) }()
+
+	writer, err := to.Create(ctx, blob.Ref, blob.Size)
+	if err != nil {
+		return err
+	}
+
+	copied, err := io.Copy(writer, reader)
+	if err == nil && copied != blob.Size {
+		err = errs.New("copied %d bytes, expected %d", copied, blob.Size)
+	}
+	if err != nil {
+		return errs.Combine(err, writer.Cancel())
+	}
+	return writer.Commit()
+}
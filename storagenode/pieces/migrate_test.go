@@ -0,0 +1,136 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pieces_test
+
+import (
+	"io/ioutil"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testidentity"
+	"storj.io/storj/pkg/auth/signing"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storage"
+	"storj.io/storj/storage/filestore"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/storagenodedb/storagenodedbtest"
+)
+
+func TestMigrate(t *testing.T) {
+	storagenodedbtest.Run(t, func(t *testing.T, db storagenode.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		fromBlobs, err := filestore.NewAt(ctx.Dir("from"))
+		require.NoError(t, err)
+		defer ctx.Check(fromBlobs.Close)
+
+		toBlobs, err := filestore.NewAt(ctx.Dir("to"))
+		require.NoError(t, err)
+		defer ctx.Check(toBlobs.Close)
+
+		log := zaptest.NewLogger(t)
+		from := pieces.NewStore(log, fromBlobs)
+		to := pieces.NewStore(log, toBlobs)
+		pieceinfos := db.PieceInfo()
+
+		satellite := testidentity.MustPregeneratedSignedIdentity(0, storj.LatestIDVersion())
+		uplink := testidentity.MustPregeneratedSignedIdentity(1, storj.LatestIDVersion())
+
+		writeBlob := func(store *pieces.Store, pieceID storj.PieceID, data []byte) {
+			writer, err := store.Writer(ctx, satellite.ID, pieceID)
+			require.NoError(t, err)
+			_, err = writer.Write(data)
+			require.NoError(t, err)
+			require.NoError(t, writer.Commit())
+		}
+
+		randomData := func(size int) []byte {
+			data := make([]byte, size)
+			_, _ = rand.Read(data)
+			return data
+		}
+
+		addInfo := func(pieceID storj.PieceID, size int64) {
+			hash, err := signing.SignPieceHash(
+				signing.SignerFromFullIdentity(uplink),
+				&pb.PieceHash{
+					PieceId: pieceID,
+					Hash:    []byte{1, 2, 3},
+				})
+			require.NoError(t, err)
+
+			require.NoError(t, pieceinfos.Add(ctx, &pieces.Info{
+				SatelliteID:     satellite.ID,
+				PieceID:         pieceID,
+				PieceSize:       size,
+				PieceCreation:   time.Now().Add(-time.Hour),
+				UplinkPieceHash: hash,
+				Uplink:          uplink.PeerIdentity(),
+			}))
+		}
+
+		expected := map[storj.PieceID][]byte{}
+
+		for i := 0; i < 3; i++ {
+			pieceID := storj.NewPieceID()
+			data := randomData(100 + i)
+			writeBlob(from, pieceID, data)
+			addInfo(pieceID, int64(len(data)))
+			expected[pieceID] = data
+		}
+
+		// an interrupted migration committed the copy, but didn't delete the source
+		copiedPiece := storj.NewPieceID()
+		copiedData := randomData(200)
+		writeBlob(from, copiedPiece, copiedData)
+		writeBlob(to, copiedPiece, copiedData)
+		addInfo(copiedPiece, int64(len(copiedData)))
+		expected[copiedPiece] = copiedData
+
+		orphanPiece := storj.NewPieceID()
+		writeBlob(from, orphanPiece, randomData(300))
+
+		// blobs without piece information fail the migration unless they are skipped
+		report, err := pieces.Migrate(ctx, log, fromBlobs, toBlobs, pieceinfos, false)
+		require.True(t, pieces.ErrUnknownBlobs.Has(err), err)
+		assert.EqualValues(t, 4, report.Moved)
+		assert.EqualValues(t, 100+101+102+200, report.MovedBytes)
+		assert.EqualValues(t, 1, report.Skipped)
+
+		for pieceID, data := range expected {
+			_, err := from.Reader(ctx, satellite.ID, pieceID)
+			require.Error(t, err)
+
+			reader, err := toBlobs.Open(ctx, storage.BlobRef{
+				Namespace: satellite.ID.Bytes(),
+				Key:       pieceID.Bytes(),
+			})
+			require.NoError(t, err)
+			read, err := ioutil.ReadAll(reader)
+			require.NoError(t, err)
+			require.NoError(t, reader.Close())
+			require.Equal(t, data, read)
+		}
+
+		// blobs without piece information are left in place
+		reader, err := from.Reader(ctx, satellite.ID, orphanPiece)
+		require.NoError(t, err)
+		require.NoError(t, reader.Close())
+
+		// repeating the migration has nothing left to move
+		report, err = pieces.Migrate(ctx, log, fromBlobs, toBlobs, pieceinfos, true)
+		require.NoError(t, err)
+		assert.EqualValues(t, 0, report.Moved)
+		assert.EqualValues(t, 1, report.Skipped)
+	})
+}
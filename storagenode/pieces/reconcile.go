@@ -0,0 +1,203 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pieces
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/internal/errs2"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storage"
+)
+
+var mon = monkit.Package()
+
+const (
+	// mtimeGranularity is the coarsest modification time resolution of supported file systems,
+	// blobs modified within it before the reconciler started may still be in use
+	mtimeGranularity = 2 * time.Second
+
+	// reconcileBatchSize is the number of piece IDs loaded at once
+	reconcileBatchSize = 1000
+)
+
+// MissingPiece is a piece that has piece information, but no stored blob
+type MissingPiece struct {
+	SatelliteID storj.NodeID
+	PieceID     storj.PieceID
+}
+
+// ReconcileReport summarizes a consistency pass between stored blobs and piece information
+type ReconcileReport struct {
+	Blobs       int64
+	Orphans     int64
+	OrphanBytes int64
+	Missing     []MissingPiece
+}
+
+// Reconciler makes sure that stored blobs and piece information agree after an unclean shutdown,
+// blobs without piece information are moved to the trash and pieces without blobs are reported
+type Reconciler struct {
+	log        *zap.Logger
+	store      *Store
+	pieceinfos DB
+	startedAt  time.Time
+}
+
+// NewReconciler creates a new reconciler, blobs written after it's created are never trashed
+func NewReconciler(log *zap.Logger, store *Store, pieceinfos DB) *Reconciler {
+	return &Reconciler{
+		log:        log,
+		store:      store,
+		pieceinfos: pieceinfos,
+		startedAt:  time.Now(),
+	}
+}
+
+// Run reconciles the stored blobs with piece information once,
+// failures are only logged since the node can operate without reconciliation
+func (reconciler *Reconciler) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	report, err := reconciler.Reconcile(ctx)
+	if err != nil {
+		if errs2.IgnoreCanceled(err) == nil {
+			return err
+		}
+		reconciler.log.Error("unable to reconcile pieces", zap.Error(err))
+		return nil
+	}
+
+	for _, missing := range report.Missing {
+		reconciler.log.Warn("piece is missing from the disk",
+			zap.Stringer("satellite ID", missing.SatelliteID),
+			zap.Stringer("piece ID", missing.PieceID))
+	}
+	reconciler.log.Info("reconciled pieces",
+		zap.Int64("blobs", report.Blobs),
+		zap.Int64("orphans trashed", report.Orphans),
+		zap.Int64("orphan bytes", report.OrphanBytes),
+		zap.Int("missing", len(report.Missing)))
+	return nil
+}
+
+// Reconcile trashes blobs that have no piece information and reports pieces whose blob is missing
+func (reconciler *Reconciler) Reconcile(ctx context.Context) (report ReconcileReport, err error) {
+	defer mon.Task()(&ctx)(&err)
+	defer func() {
+		mon.IntVal("reconcile_orphans").Observe(report.Orphans)
+		mon.IntVal("reconcile_orphan_bytes").Observe(report.OrphanBytes)
+		mon.IntVal("reconcile_missing").Observe(int64(len(report.Missing)))
+	}()
+
+	namespaces, err := reconciler.store.blobs.ListNamespaces(ctx)
+	if err != nil {
+		return report, Error.Wrap(err)
+	}
+
+	satellites := make(map[storj.NodeID]struct{})
+	for _, namespace := range namespaces {
+		satelliteID, err := storj.NodeIDFromBytes(namespace)
+		if err != nil {
+			// not a satellite namespace
+			continue
+		}
+		satellites[satelliteID] = struct{}{}
+	}
+
+	// satellites whose blobs are all gone still have piece information
+	spaceUsed, err := reconciler.pieceinfos.SpaceUsedBySatellite(ctx)
+	if err != nil {
+		return report, Error.Wrap(err)
+	}
+	for satelliteID := range spaceUsed {
+		satellites[satelliteID] = struct{}{}
+	}
+
+	for satelliteID := range satellites {
+		if err := reconciler.reconcileSatellite(ctx, satelliteID, &report); err != nil {
+			return report, err
+		}
+	}
+	return report, nil
+}
+
+// reconcileSatellite reconciles the blobs of a single satellite
+func (reconciler *Reconciler) reconcileSatellite(ctx context.Context, satelliteID storj.NodeID, report *ReconcileReport) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// pieces created afterwards are not known, but their blobs are too new to be trashed
+	known := make(map[storj.PieceID]struct{})
+	createdBefore := time.Now()
+	for offset := 0; ; offset += reconcileBatchSize {
+		pieceIDs, err := reconciler.pieceinfos.GetPieceIDs(ctx, satelliteID, createdBefore, reconcileBatchSize, offset)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		for _, pieceID := range pieceIDs {
+			known[pieceID] = struct{}{}
+		}
+		if len(pieceIDs) < reconcileBatchSize {
+			break
+		}
+	}
+
+	cutoff := reconciler.startedAt.Add(-mtimeGranularity)
+	err = reconciler.store.blobs.WalkNamespace(ctx, satelliteID.Bytes(), func(blob storage.BlobInfo) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		pieceID, err := storj.PieceIDFromBytes(blob.Ref.Key)
+		if err != nil {
+			// not a piece
+			return nil
+		}
+		report.Blobs++
+
+		if _, ok := known[pieceID]; ok {
+			delete(known, pieceID)
+			return nil
+		}
+		if !blob.ModTime.Before(cutoff) {
+			return nil
+		}
+
+		// the piece information may have been restored from the trash while walking
+		_, err = reconciler.pieceinfos.Get(ctx, satelliteID, pieceID)
+		switch {
+		case err == nil:
+			return nil
+		case errs.Unwrap(err) != sql.ErrNoRows:
+			return Error.Wrap(err)
+		}
+
+		// orphans are only trashed, so that a mistake can be undone by restoring the trash
+		err = reconciler.store.blobs.Trash(ctx, blob.Ref)
+		if err != nil && !os.IsNotExist(errs.Unwrap(err)) {
+			return Error.Wrap(err)
+		}
+		report.Orphans++
+		report.OrphanBytes += blob.Size
+		return nil
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	for pieceID := range known {
+		report.Missing = append(report.Missing, MissingPiece{
+			SatelliteID: satelliteID,
+			PieceID:     pieceID,
+		})
+	}
+	return nil
+}
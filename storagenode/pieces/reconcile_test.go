@@ -0,0 +1,146 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pieces_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testidentity"
+	"storj.io/storj/pkg/auth/signing"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storage/filestore"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/storagenodedb/storagenodedbtest"
+)
+
+func TestReconcile(t *testing.T) {
+	storagenodedbtest.Run(t, func(t *testing.T, db storagenode.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		blobs, err := filestore.NewAt(ctx.Dir("store"))
+		require.NoError(t, err)
+		defer ctx.Check(blobs.Close)
+
+		log := zaptest.NewLogger(t)
+		store := pieces.NewStore(log, blobs)
+		pieceinfos := db.PieceInfo()
+
+		satellite := testidentity.MustPregeneratedSignedIdentity(0, storj.LatestIDVersion())
+		uplink := testidentity.MustPregeneratedSignedIdentity(1, storj.LatestIDVersion())
+
+		writeBlob := func(pieceID storj.PieceID, size int64) {
+			writer, err := store.Writer(ctx, satellite.ID, pieceID)
+			require.NoError(t, err)
+			_, err = writer.Write(make([]byte, size))
+			require.NoError(t, err)
+			require.NoError(t, writer.Commit())
+		}
+
+		addInfo := func(pieceID storj.PieceID, size int64) {
+			hash, err := signing.SignPieceHash(
+				signing.SignerFromFullIdentity(uplink),
+				&pb.PieceHash{
+					PieceId: pieceID,
+					Hash:    []byte{1, 2, 3},
+				})
+			require.NoError(t, err)
+
+			require.NoError(t, pieceinfos.Add(ctx, &pieces.Info{
+				SatelliteID:     satellite.ID,
+				PieceID:         pieceID,
+				PieceSize:       size,
+				PieceCreation:   time.Now().Add(-time.Hour),
+				UplinkPieceHash: hash,
+				Uplink:          uplink.PeerIdentity(),
+			}))
+		}
+
+		knownPiece := storj.NewPieceID()
+		writeBlob(knownPiece, 100)
+		addInfo(knownPiece, 100)
+
+		orphanPiece := storj.NewPieceID()
+		writeBlob(orphanPiece, 200)
+
+		// the piece information of this piece is restored after the piece IDs were loaded
+		restoredPiece := storj.NewPieceID()
+		writeBlob(restoredPiece, 500)
+		addInfo(restoredPiece, 500)
+
+		missingPiece := storj.NewPieceID()
+		addInfo(missingPiece, 300)
+
+		// blobs left behind by a previous run are old
+		old := time.Now().Add(-time.Hour)
+		err = filepath.Walk(ctx.Dir("store", "blob"), func(path string, info os.FileInfo, err error) error {
+			if err != nil || info.IsDir() {
+				return err
+			}
+			return os.Chtimes(path, old, old)
+		})
+		require.NoError(t, err)
+
+		reconciler := pieces.NewReconciler(log, store, &hidingPieceInfos{DB: pieceinfos, hidden: restoredPiece})
+
+		// blobs of uploads that are still in progress must not be deleted
+		uploadingPiece := storj.NewPieceID()
+		writeBlob(uploadingPiece, 400)
+
+		report, err := reconciler.Reconcile(ctx)
+		require.NoError(t, err)
+		assert.EqualValues(t, 4, report.Blobs)
+		assert.EqualValues(t, 1, report.Orphans)
+		assert.EqualValues(t, 200, report.OrphanBytes)
+		assert.Equal(t, []pieces.MissingPiece{
+			{SatelliteID: satellite.ID, PieceID: missingPiece},
+		}, report.Missing)
+
+		for _, pieceID := range []storj.PieceID{knownPiece, uploadingPiece, restoredPiece} {
+			reader, err := store.Reader(ctx, satellite.ID, pieceID)
+			require.NoError(t, err)
+			require.NoError(t, reader.Close())
+		}
+
+		_, err = store.Reader(ctx, satellite.ID, orphanPiece)
+		require.Error(t, err)
+
+		// orphans are only trashed
+		restored, err := store.RestoreTrash(ctx, satellite.ID)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, restored)
+
+		reader, err := store.Reader(ctx, satellite.ID, orphanPiece)
+		require.NoError(t, err)
+		require.NoError(t, reader.Close())
+	})
+}
+
+// hidingPieceInfos doesn't list the hidden piece, as if its piece information was added after listing
+type hidingPieceInfos struct {
+	pieces.DB
+	hidden storj.PieceID
+}
+
+func (db *hidingPieceInfos) GetPieceIDs(ctx context.Context, satelliteID storj.NodeID, createdBefore time.Time, limit, offset int) ([]storj.PieceID, error) {
+	pieceIDs, err := db.DB.GetPieceIDs(ctx, satelliteID, createdBefore, limit, offset)
+	for i, pieceID := range pieceIDs {
+		if pieceID == db.hidden {
+			pieceIDs = append(pieceIDs[:i], pieceIDs[i+1:]...)
+			break
+		}
+	}
+	return pieceIDs, err
+}
@@ -32,6 +32,7 @@ type Info struct {
 
 	PieceID         storj.PieceID
 	PieceSize       int64
+	PieceCreation   time.Time
 	PieceExpiration *time.Time
 
 	UplinkPieceHash *pb.PieceHash
@@ -46,12 +47,26 @@ type DB interface {
 	Get(ctx context.Context, satelliteID storj.NodeID, pieceID storj.PieceID) (*Info, error)
 	// Delete deletes Info about a piece.
 	Delete(ctx context.Context, satelliteID storj.NodeID, pieceID storj.PieceID) error
+	// GetPieceIDs gets piece IDs stored for the satellite that were created before createdBefore
+	GetPieceIDs(ctx context.Context, satelliteID storj.NodeID, createdBefore time.Time, limit, offset int) ([]storj.PieceID, error)
 	// SpaceUsed calculates disk space used by all pieces
 	SpaceUsed(ctx context.Context) (int64, error)
-	// GetExpired gets orders that are expired and were created before some time
-	GetExpired(ctx context.Context, expiredAt time.Time) ([]Info, error)
+	// SpaceUsedForSatellite calculates disk space used by pieces of a single satellite
+	SpaceUsedForSatellite(ctx context.Context, satelliteID storj.NodeID) (int64, error)
+	// SpaceUsedBySatellite calculates disk space used by pieces grouped by satellite
+	SpaceUsedBySatellite(ctx context.Context) (map[storj.NodeID]int64, error)
+	// GetExpired gets at most limit pieces that expired before expiredAt, skipping the first offset pieces
+	GetExpired(ctx context.Context, expiredAt time.Time, limit, offset int64) ([]Info, error)
 	// DeleteExpired deletes pieces that are expired
 	DeleteExpired(ctx context.Context, expiredAt time.Time, satelliteID storj.NodeID, pieceID storj.PieceID) error
+	// Trash moves Info about a piece to the trash
+	Trash(ctx context.Context, satelliteID storj.NodeID, pieceID storj.PieceID, trashedAt time.Time) error
+	// RestoreTrash moves Info about all trashed pieces of the satellite back and returns their number
+	RestoreTrash(ctx context.Context, satelliteID storj.NodeID) (int64, error)
+	// DeleteTrash deletes Info about pieces trashed before trashedBefore and returns their number
+	DeleteTrash(ctx context.Context, trashedBefore time.Time) (int64, error)
+	// SpaceUsedInTrash calculates disk space used by trashed pieces
+	SpaceUsedInTrash(ctx context.Context) (int64, error)
 }
 
 // Store implements storing pieces onto a blob storage implementation.
@@ -105,6 +120,33 @@ func (store *Store) Delete(ctx context.Context, satellite storj.NodeID, pieceID
 	return Error.Wrap(err)
 }
 
+// Trash moves the specified piece to the trash.
+func (store *Store) Trash(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID) error {
+	err := store.blobs.Trash(ctx, storage.BlobRef{
+		Namespace: satellite.Bytes(),
+		Key:       pieceID.Bytes(),
+	})
+	return Error.Wrap(err)
+}
+
+// RestoreTrash restores all trashed pieces of the satellite and returns their number.
+func (store *Store) RestoreTrash(ctx context.Context, satellite storj.NodeID) (int64, error) {
+	keys, err := store.blobs.RestoreTrash(ctx, satellite.Bytes())
+	return int64(len(keys)), Error.Wrap(err)
+}
+
+// EmptyTrash permanently deletes the pieces trashed before trashedBefore and returns the freed bytes.
+func (store *Store) EmptyTrash(ctx context.Context, trashedBefore time.Time) (int64, error) {
+	freed, err := store.blobs.EmptyTrash(ctx, trashedBefore)
+	return freed, Error.Wrap(err)
+}
+
+// EstimateSpaceUsed estimates the size of all stored pieces by measuring at most samples directories of every satellite.
+func (store *Store) EstimateSpaceUsed(ctx context.Context, samples int) (int64, error) {
+	size, err := store.blobs.EstimateSpaceUsed(ctx, samples)
+	return size, Error.Wrap(err)
+}
+
 // StorageStatus contains information about the disk store is using.
 type StorageStatus struct {
 	DiskUsed int64
@@ -6,23 +6,28 @@ package piecestore
 import (
 	"context"
 	"io"
+	"sync/atomic"
 	"time"
 
 	"github.com/golang/protobuf/ptypes"
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	monkit "gopkg.in/spacemonkeygo/monkit.v2"
 
 	"storj.io/storj/internal/memory"
 	"storj.io/storj/internal/sync2"
 	"storj.io/storj/pkg/auth/signing"
+	"storj.io/storj/pkg/bloomfilter"
 	"storj.io/storj/pkg/identity"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/storagenode/bandwidth"
 	"storj.io/storj/storagenode/monitor"
 	"storj.io/storj/storagenode/orders"
 	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/retain"
 	"storj.io/storj/storagenode/trust"
 )
 
@@ -35,12 +40,23 @@ var (
 	ErrProtocol = errs.Class("piecestore protocol")
 	// ErrInternal is the default error class for internal piecestore errors.
 	ErrInternal = errs.Class("piecestore internal")
+	// ErrOverloaded is the error class for requests rejected because the storage node is saturated.
+	ErrOverloaded = errs.Class("piecestore overloaded")
 )
 var _ pb.PiecestoreServer = (*Endpoint)(nil)
 
 // Config defines parameters for piecestore endpoint.
 type Config struct {
 	ExpirationGracePeriod time.Duration `help:"how soon before expiration date should things be considered expired" default:"48h0m0s"`
+	RetainTimeBuffer      time.Duration `help:"allows for small differences in the satellite and storagenode clocks when garbage collecting" default:"1h0m0s"`
+	MaxUsedSerialsSize    memory.Size   `help:"amount of memory allowed for used serial numbers, orders are rejected when it's full" default:"64MiB"`
+	UsedSerialsInterval   time.Duration `help:"how frequently expired used serial numbers are deleted" default:"1h0m0s"`
+
+	MaxConcurrentUploads   int     `help:"how many uploads may run at the same time, further uploads are rejected, zero is unlimited" default:"0"`
+	MaxConcurrentDownloads int     `help:"how many downloads may run at the same time, further downloads are rejected, zero is unlimited" default:"0"`
+	UplinkRequestRate      float64 `help:"how many uploads and downloads a single uplink may start per second, zero is unlimited" default:"0"`
+	UplinkRequestBurst     int     `help:"how many uploads and downloads a single uplink may start at once before its rate applies" default:"20"`
+	UplinkRequestUplinks   int     `help:"how many uplinks the request rate is tracked for, further uplinks are rejected until others become idle, zero is unlimited" default:"100000"`
 
 	Monitor monitor.Config
 	Sender  orders.SenderConfig
@@ -60,10 +76,16 @@ type Endpoint struct {
 	orders      orders.DB
 	usage       bandwidth.DB
 	usedSerials UsedSerials
+	serials     *UsedSerialsTable
+	retain      *retain.Service
+
+	limiter       *RateLimiter
+	liveUploads   int32
+	liveDownloads int32
 }
 
 // NewEndpoint creates a new piecestore endpoint.
-func NewEndpoint(log *zap.Logger, signer signing.Signer, trust *trust.Pool, monitor *monitor.Service, store *pieces.Store, pieceinfo pieces.DB, orders orders.DB, usage bandwidth.DB, usedSerials UsedSerials, config Config) (*Endpoint, error) {
+func NewEndpoint(log *zap.Logger, signer signing.Signer, trust *trust.Pool, monitor *monitor.Service, store *pieces.Store, pieceinfo pieces.DB, orders orders.DB, usage bandwidth.DB, usedSerials UsedSerials, serials *UsedSerialsTable, retain *retain.Service, config Config) (*Endpoint, error) {
 	return &Endpoint{
 		log:    log,
 		config: config,
@@ -77,6 +99,10 @@ func NewEndpoint(log *zap.Logger, signer signing.Signer, trust *trust.Pool, moni
 		orders:      orders,
 		usage:       usage,
 		usedSerials: usedSerials,
+		serials:     serials,
+		retain:      retain,
+
+		limiter: NewRateLimiter(config.UplinkRequestRate, config.UplinkRequestBurst, config.UplinkRequestUplinks),
 	}, nil
 }
 
@@ -93,9 +119,10 @@ func (endpoint *Endpoint) Delete(ctx context.Context, delete *pb.PieceDeleteRequ
 		return nil, Error.Wrap(err)
 	}
 
+	// pieces are only trashed, so that they can be restored when the satellite deleted them by mistake
 	// TODO: parallelize this and maybe return early
-	pieceInfoErr := endpoint.pieceinfo.Delete(ctx, delete.Limit.SatelliteId, delete.Limit.PieceId)
-	pieceErr := endpoint.store.Delete(ctx, delete.Limit.SatelliteId, delete.Limit.PieceId)
+	pieceInfoErr := endpoint.pieceinfo.Trash(ctx, delete.Limit.SatelliteId, delete.Limit.PieceId, time.Now())
+	pieceErr := endpoint.store.Trash(ctx, delete.Limit.SatelliteId, delete.Limit.PieceId)
 
 	if err := errs.Combine(pieceInfoErr, pieceErr); err != nil {
 		// explicitly ignoring error because the errors
@@ -110,6 +137,94 @@ func (endpoint *Endpoint) Delete(ctx context.Context, delete *pb.PieceDeleteRequ
 	return &pb.PieceDeleteResponse{}, nil
 }
 
+// Retain queues the bloom filter of the satellite, the pieces created before the filter which are not in it
+// are trashed in the background.
+func (endpoint *Endpoint) Retain(ctx context.Context, retainReq *pb.RetainRequest) (_ *pb.RetainResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	err = endpoint.trust.VerifySatelliteID(ctx, peer.ID)
+	if err != nil {
+		return nil, Error.New("retain called with untrusted ID")
+	}
+
+	filter, err := bloomfilter.NewFromBytes(retainReq.GetFilter())
+	if err != nil {
+		return nil, ErrProtocol.Wrap(err)
+	}
+
+	createdBefore, err := ptypes.Timestamp(retainReq.GetCreationDate())
+	if err != nil {
+		return nil, ErrProtocol.Wrap(err)
+	}
+
+	endpoint.retain.Queue(retain.Request{
+		SatelliteID:   peer.ID,
+		CreatedBefore: createdBefore,
+		Filter:        filter,
+	})
+
+	return &pb.RetainResponse{}, nil
+}
+
+// RestoreTrash restores all pieces of the satellite that are still in the trash.
+func (endpoint *Endpoint) RestoreTrash(ctx context.Context, restoreReq *pb.RestoreTrashRequest) (_ *pb.RestoreTrashResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	err = endpoint.trust.VerifySatelliteID(ctx, peer.ID)
+	if err != nil {
+		return nil, Error.New("restore trash called with untrusted ID")
+	}
+
+	// blobs are restored first, a failure afterwards leaves orphans instead of missing pieces
+	restored, err := endpoint.store.RestoreTrash(ctx, peer.ID)
+	if err != nil {
+		return nil, ErrInternal.Wrap(err)
+	}
+
+	_, err = endpoint.pieceinfo.RestoreTrash(ctx, peer.ID)
+	if err != nil {
+		return nil, ErrInternal.Wrap(err)
+	}
+
+	mon.IntVal("trash_pieces_restored").Observe(restored)
+	endpoint.log.Info("restored trash", zap.Stringer("Satellite ID", peer.ID), zap.Int64("restored", restored))
+
+	return &pb.RestoreTrashResponse{}, nil
+}
+
+// admit checks the rate limit of the uplink and the number of live transfers of the kind before a transfer starts,
+// it must only be called with verified order limits. Rejections are reported as unavailable so that uplinks
+// can move on to other nodes without waiting.
+func (endpoint *Endpoint) admit(limit *pb.OrderLimit2, kind string, live *int32, max int) (release func(), err error) {
+	switch limit.Action {
+	case pb.PieceAction_GET_AUDIT, pb.PieceAction_GET_REPAIR, pb.PieceAction_PUT_REPAIR:
+		// requests of the satellite are never rejected, failing audits and repairs would hurt the reputation of the node
+		return func() {}, nil
+	}
+
+	if !endpoint.limiter.Allow(limit.UplinkId, time.Now()) {
+		mon.Meter(kind + "_rejected_rate_limit").Mark(1)
+		return nil, status.Error(codes.Unavailable, ErrOverloaded.New("too many %ss from %v", kind, limit.UplinkId).Error())
+	}
+
+	if count := atomic.AddInt32(live, 1); max > 0 && int(count) > max {
+		atomic.AddInt32(live, -1)
+		mon.Meter(kind + "_rejected_concurrency").Mark(1)
+		return nil, status.Error(codes.Unavailable, ErrOverloaded.New("too many concurrent %ss", kind).Error())
+	}
+	return func() { atomic.AddInt32(live, -1) }, nil
+}
+
 // Upload handles uploading a piece on piece store.
 func (endpoint *Endpoint) Upload(stream pb.Piecestore_UploadServer) (err error) {
 	ctx := stream.Context()
@@ -141,6 +256,17 @@ func (endpoint *Endpoint) Upload(stream pb.Piecestore_UploadServer) (err error)
 		return err // TODO: report grpc status unauthorized or bad request
 	}
 
+	release, err := endpoint.admit(limit, "upload", &endpoint.liveUploads, endpoint.config.MaxConcurrentUploads)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	// the last disk check found the disk running out of space
+	if endpoint.monitor.DiskStatus().Full {
+		return status.Error(codes.Unavailable, "out of space: disk is full")
+	}
+
 	defer func() {
 		if err != nil {
 			endpoint.log.Info("upload failed", zap.Stringer("Piece ID", limit.PieceId), zap.Stringer("Node ID", limit.StorageNodeId), zap.Error(err))
@@ -165,12 +291,12 @@ func (endpoint *Endpoint) Upload(stream pb.Piecestore_UploadServer) (err error)
 		}
 	}()
 
-	availableBandwidth, err := endpoint.monitor.AvailableBandwidth(ctx)
+	availableBandwidth, err := endpoint.monitor.AvailableBandwidthForSatellite(ctx, limit.SatelliteId)
 	if err != nil {
 		return ErrInternal.Wrap(err)
 	}
 
-	availableSpace, err := endpoint.monitor.AvailableSpace(ctx)
+	availableSpace, err := endpoint.monitor.AvailableSpaceForSatellite(ctx, limit.SatelliteId)
 	if err != nil {
 		return ErrInternal.Wrap(err)
 	}
@@ -250,6 +376,7 @@ func (endpoint *Endpoint) Upload(stream pb.Piecestore_UploadServer) (err error)
 
 					PieceID:         limit.PieceId,
 					PieceSize:       pieceWriter.Size(),
+					PieceCreation:   time.Now(),
 					PieceExpiration: expiration,
 
 					UplinkPieceHash: message.Done,
@@ -309,6 +436,12 @@ func (endpoint *Endpoint) Download(stream pb.Piecestore_DownloadServer) (err err
 		return Error.Wrap(err) // TODO: report grpc status unauthorized or bad request
 	}
 
+	release, err := endpoint.admit(limit, "download", &endpoint.liveDownloads, endpoint.config.MaxConcurrentDownloads)
+	if err != nil {
+		return err
+	}
+	defer release()
+
 	defer func() {
 		if err != nil {
 			endpoint.log.Info("download failed", zap.Stringer("Piece ID", limit.PieceId), zap.Error(err))
@@ -339,7 +472,7 @@ func (endpoint *Endpoint) Download(stream pb.Piecestore_DownloadServer) (err err
 		return Error.New("requested more data than available, requesting=%v available=%v", chunk.Offset+chunk.ChunkSize, pieceReader.Size())
 	}
 
-	availableBandwidth, err := endpoint.monitor.AvailableBandwidth(ctx)
+	availableBandwidth, err := endpoint.monitor.AvailableBandwidthForSatellite(ctx, limit.SatelliteId)
 	if err != nil {
 		return ErrInternal.Wrap(err)
 	}
@@ -13,6 +13,7 @@ import (
 	"github.com/golang/protobuf/ptypes"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
 
 	"storj.io/storj/internal/memory"
 	"storj.io/storj/internal/testcontext"
@@ -21,6 +22,7 @@ import (
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/pkcrypto"
 	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storagenode"
 	"storj.io/storj/storagenode/bandwidth"
 	"storj.io/storj/uplink/piecestore"
 )
@@ -175,6 +177,117 @@ func TestUpload(t *testing.T) {
 	}
 }
 
+func TestUploadOverloaded(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	planet, err := testplanet.NewCustom(zaptest.NewLogger(t), testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			StorageNode: func(index int, config *storagenode.Config) {
+				config.Storage2.UplinkRequestRate = 0.001
+				config.Storage2.UplinkRequestBurst = 2
+			},
+		},
+	})
+	require.NoError(t, err)
+	defer ctx.Check(planet.Shutdown)
+
+	planet.Start(ctx)
+
+	client, err := planet.Uplinks[0].DialPiecestore(ctx, planet.StorageNodes[0])
+	require.NoError(t, err)
+	defer ctx.Check(client.Close)
+
+	signer := signing.SignerFromFullIdentity(planet.Satellites[0].Identity)
+
+	data := make([]byte, 10*memory.KiB)
+	_, _ = rand.Read(data)
+
+	upload := func(pieceID storj.PieceID, action pb.PieceAction) (writeErr, err error) {
+		var serialNumber storj.SerialNumber
+		_, _ = rand.Read(serialNumber[:])
+
+		orderLimit := GenerateOrderLimit(t, planet.Satellites[0].ID(), planet.Uplinks[0].ID(), planet.StorageNodes[0].ID(),
+			pieceID, action, serialNumber, 24*time.Hour, 24*time.Hour, int64(len(data)))
+		orderLimit, err = signing.SignOrderLimit(signer, orderLimit)
+		require.NoError(t, err)
+
+		uploader, err := client.Upload(ctx, orderLimit)
+		require.NoError(t, err)
+
+		// a rejected upload may fail on write, the reason is reported by commit
+		_, writeErr = uploader.Write(data)
+		_, err = uploader.Commit()
+		return writeErr, err
+	}
+
+	for i := 0; i < 3; i++ {
+		writeErr, err := upload(storj.PieceID{byte(i + 1)}, pb.PieceAction_PUT)
+
+		// the third upload exceeds the burst of the uplink
+		if i < 2 {
+			require.NoError(t, writeErr)
+			require.NoError(t, err)
+		} else {
+			require.Error(t, err)
+			require.Contains(t, err.Error(), "piecestore overloaded")
+		}
+	}
+
+	// repairs of the satellite aren't limited
+	writeErr, err := upload(storj.PieceID{4}, pb.PieceAction_PUT_REPAIR)
+	require.NoError(t, writeErr)
+	require.NoError(t, err)
+}
+
+func TestUploadDiskFull(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	planet, err := testplanet.NewCustom(zaptest.NewLogger(t), testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			StorageNode: func(index int, config *storagenode.Config) {
+				// no disk has that much free space
+				config.Storage2.Monitor.MinimumDiskSpace = 1 << 62
+			},
+		},
+	})
+	require.NoError(t, err)
+	defer ctx.Check(planet.Shutdown)
+
+	planet.Start(ctx)
+
+	node := planet.StorageNodes[0]
+	node.Storage2.Monitor.Loop.TriggerWait()
+	require.True(t, node.Storage2.Monitor.DiskStatus().Full)
+
+	client, err := planet.Uplinks[0].DialPiecestore(ctx, node)
+	require.NoError(t, err)
+	defer ctx.Check(client.Close)
+
+	signer := signing.SignerFromFullIdentity(planet.Satellites[0].Identity)
+
+	data := make([]byte, 10*memory.KiB)
+	_, _ = rand.Read(data)
+
+	orderLimit := GenerateOrderLimit(t, planet.Satellites[0].ID(), planet.Uplinks[0].ID(), node.ID(),
+		storj.PieceID{1}, pb.PieceAction_PUT, storj.SerialNumber{1}, 24*time.Hour, 24*time.Hour, int64(len(data)))
+	orderLimit, err = signing.SignOrderLimit(signer, orderLimit)
+	require.NoError(t, err)
+
+	uploader, err := client.Upload(ctx, orderLimit)
+	require.NoError(t, err)
+
+	// a rejected upload may fail on write, the reason is reported by commit
+	_, _ = uploader.Write(data)
+	_, err = uploader.Commit()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "code = Unavailable")
+	require.Contains(t, err.Error(), "disk is full")
+}
+
 func TestDownload(t *testing.T) {
 	ctx := testcontext.New(t)
 	defer ctx.Cleanup()
@@ -376,6 +489,79 @@ func TestDelete(t *testing.T) {
 	}
 }
 
+func TestRestoreTrash(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	planet, err := testplanet.New(t, 1, 1, 1)
+	require.NoError(t, err)
+	defer ctx.Check(planet.Shutdown)
+
+	planet.Start(ctx)
+
+	satellite := planet.Satellites[0]
+	node := planet.StorageNodes[0]
+
+	client, err := planet.Uplinks[0].DialPiecestore(ctx, node)
+	require.NoError(t, err)
+	defer ctx.Check(client.Close)
+
+	signer := signing.SignerFromFullIdentity(satellite.Identity)
+	pieceID := storj.PieceID{1}
+
+	var serialNumber storj.SerialNumber
+	_, _ = rand.Read(serialNumber[:])
+
+	expectedData := make([]byte, 10*memory.KiB)
+	_, _ = rand.Read(expectedData)
+
+	orderLimit := GenerateOrderLimit(t, satellite.ID(), planet.Uplinks[0].ID(), node.ID(), pieceID,
+		pb.PieceAction_PUT, serialNumber, 24*time.Hour, 24*time.Hour, int64(len(expectedData)))
+	orderLimit, err = signing.SignOrderLimit(signer, orderLimit)
+	require.NoError(t, err)
+
+	uploader, err := client.Upload(ctx, orderLimit)
+	require.NoError(t, err)
+	_, err = uploader.Write(expectedData)
+	require.NoError(t, err)
+	_, err = uploader.Commit()
+	require.NoError(t, err)
+
+	_, _ = rand.Read(serialNumber[:])
+	orderLimit = GenerateOrderLimit(t, satellite.ID(), planet.Uplinks[0].ID(), node.ID(), pieceID,
+		pb.PieceAction_DELETE, serialNumber, 24*time.Hour, 24*time.Hour, 100)
+	orderLimit, err = signing.SignOrderLimit(signer, orderLimit)
+	require.NoError(t, err)
+	require.NoError(t, client.Delete(ctx, orderLimit))
+
+	_, err = node.Storage2.Store.Reader(ctx, satellite.ID(), pieceID)
+	require.Error(t, err)
+	_, err = node.DB.PieceInfo().Get(ctx, satellite.ID(), pieceID)
+	require.Error(t, err)
+
+	// only trusted satellites are allowed to restore the trash
+	require.Error(t, client.RestoreTrash(ctx))
+
+	conn, err := satellite.Transport.DialNode(ctx, &pb.Node{
+		Id:      node.ID(),
+		Address: node.Local().Address,
+		Type:    pb.NodeType_STORAGE,
+	})
+	require.NoError(t, err)
+	satelliteClient := piecestore.NewClient(satellite.Log, signer, conn, piecestore.DefaultConfig)
+	defer ctx.Check(satelliteClient.Close)
+
+	require.NoError(t, satelliteClient.RestoreTrash(ctx))
+
+	reader, err := node.Storage2.Store.Reader(ctx, satellite.ID(), pieceID)
+	require.NoError(t, err)
+	require.NoError(t, reader.Close())
+
+	info, err := node.DB.PieceInfo().Get(ctx, satellite.ID(), pieceID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(expectedData)), info.PieceSize)
+}
+
 func GenerateOrderLimit(t *testing.T, satellite storj.NodeID, uplink storj.NodeID, storageNode storj.NodeID, pieceID storj.PieceID,
 	action pb.PieceAction, serialNumber storj.SerialNumber, pieceExpiration, orderExpiration time.Duration, limit int64) *pb.OrderLimit2 {
 
@@ -0,0 +1,99 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package piecestore
+
+import (
+	"sync"
+	"time"
+
+	"storj.io/storj/pkg/storj"
+)
+
+// rateLimiterIdle is how long an uplink has to be idle before its bucket is dropped
+const rateLimiterIdle = 10 * time.Minute
+
+// RateLimiter limits how many requests each uplink may start per second,
+// every uplink has a token bucket which allows short bursts of requests
+type RateLimiter struct {
+	rate       float64
+	burst      float64
+	maxUplinks int
+
+	mu          sync.Mutex
+	buckets     map[storj.NodeID]*tokenBucket
+	lastCleanup time.Time
+}
+
+// tokenBucket holds the tokens available to a single uplink
+type tokenBucket struct {
+	tokens  float64
+	updated time.Time
+}
+
+// NewRateLimiter creates a rate limiter that allows rate requests per second with bursts of burst requests,
+// zero rate disables the limit. At most maxUplinks uplinks are tracked, zero is unlimited.
+func NewRateLimiter(rate float64, burst, maxUplinks int) *RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &RateLimiter{
+		rate:       rate,
+		burst:      float64(burst),
+		maxUplinks: maxUplinks,
+		buckets:    make(map[storj.NodeID]*tokenBucket),
+	}
+}
+
+// Allow returns whether the uplink may start another request at the given time
+func (limiter *RateLimiter) Allow(uplinkID storj.NodeID, now time.Time) bool {
+	if limiter.rate <= 0 {
+		return true
+	}
+
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+
+	if now.Sub(limiter.lastCleanup) > rateLimiterIdle {
+		limiter.cleanup(now)
+	}
+
+	bucket, ok := limiter.buckets[uplinkID]
+	if !ok {
+		if limiter.maxUplinks > 0 && len(limiter.buckets) >= limiter.maxUplinks {
+			limiter.cleanup(now)
+		}
+		// uplinks beyond the limit are rejected until others become idle
+		if limiter.maxUplinks > 0 && len(limiter.buckets) >= limiter.maxUplinks {
+			return false
+		}
+		bucket = &tokenBucket{tokens: limiter.burst, updated: now}
+		limiter.buckets[uplinkID] = bucket
+	}
+
+	if elapsed := now.Sub(bucket.updated); elapsed > 0 {
+		bucket.tokens += elapsed.Seconds() * limiter.rate
+		if bucket.tokens > limiter.burst {
+			bucket.tokens = limiter.burst
+		}
+		bucket.updated = now
+	}
+
+	if bucket.tokens < 1 {
+		return false
+	}
+	bucket.tokens--
+	return true
+}
+
+// cleanup drops the buckets of idle uplinks and the buckets that are refilled,
+// which don't differ from the bucket of a new uplink
+func (limiter *RateLimiter) cleanup(now time.Time) {
+	for uplinkID, bucket := range limiter.buckets {
+		elapsed := now.Sub(bucket.updated)
+		if elapsed > rateLimiterIdle || bucket.tokens+elapsed.Seconds()*limiter.rate >= limiter.burst {
+			delete(limiter.buckets, uplinkID)
+		}
+	}
+	limiter.lastCleanup = now
+}
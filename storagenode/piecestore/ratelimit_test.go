@@ -0,0 +1,59 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package piecestore_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"storj.io/storj/internal/testidentity"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storagenode/piecestore"
+)
+
+func TestRateLimiter(t *testing.T) {
+	uplink0 := testidentity.MustPregeneratedIdentity(0, storj.LatestIDVersion()).ID
+	uplink1 := testidentity.MustPregeneratedIdentity(1, storj.LatestIDVersion()).ID
+
+	now := time.Now()
+	limiter := piecestore.NewRateLimiter(2, 3, 0)
+
+	// the burst is allowed at once
+	for i := 0; i < 3; i++ {
+		assert.True(t, limiter.Allow(uplink0, now))
+	}
+	assert.False(t, limiter.Allow(uplink0, now))
+
+	// other uplinks have their own limit
+	assert.True(t, limiter.Allow(uplink1, now))
+
+	// tokens are refilled at the rate
+	assert.True(t, limiter.Allow(uplink0, now.Add(500*time.Millisecond)))
+	assert.False(t, limiter.Allow(uplink0, now.Add(500*time.Millisecond)))
+
+	// tokens don't accumulate beyond the burst
+	later := now.Add(time.Minute)
+	for i := 0; i < 3; i++ {
+		assert.True(t, limiter.Allow(uplink0, later))
+	}
+	assert.False(t, limiter.Allow(uplink0, later))
+
+	// zero rate is unlimited
+	unlimited := piecestore.NewRateLimiter(0, 0, 0)
+	for i := 0; i < 100; i++ {
+		assert.True(t, unlimited.Allow(uplink0, now))
+	}
+
+	// only a limited number of uplinks is tracked
+	uplink2 := testidentity.MustPregeneratedIdentity(2, storj.LatestIDVersion()).ID
+	limited := piecestore.NewRateLimiter(1, 2, 2)
+	assert.True(t, limited.Allow(uplink0, now))
+	assert.True(t, limited.Allow(uplink1, now))
+	assert.False(t, limited.Allow(uplink2, now))
+
+	// refilled buckets make room for other uplinks
+	assert.True(t, limited.Allow(uplink2, now.Add(time.Second)))
+}
@@ -16,7 +16,7 @@ type SerialNumberFn func(satelliteID storj.NodeID, serialNumber storj.SerialNumb
 // UsedSerials is a persistent store for serial numbers.
 // TODO: maybe this should be in orders.UsedSerials
 type UsedSerials interface {
-	// Add adds a serial to the database.
+	// Add adds a serial to the database, it returns ErrSerialAlreadyUsed when the serial is already there.
 	Add(ctx context.Context, satelliteID storj.NodeID, serialNumber storj.SerialNumber, expiration time.Time) error
 	// DeleteExpired deletes expired serial numbers
 	DeleteExpired(ctx context.Context, now time.Time) error
@@ -13,10 +13,13 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
 
+	"storj.io/storj/internal/memory"
 	"storj.io/storj/internal/testcontext"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/piecestore"
 	"storj.io/storj/storagenode/storagenodedb/storagenodedbtest"
 )
 
@@ -69,7 +72,7 @@ func TestUsedSerials(t *testing.T) {
 		for _, serial := range serialNumbers {
 			expirationDelta := time.Duration(rand.Intn(10)-5) * time.Hour
 			err = usedSerials.Add(ctx, serial.SatelliteID, serial.SerialNumber, serial.Expiration.Add(expirationDelta))
-			assert.Error(t, err)
+			assert.True(t, piecestore.ErrSerialAlreadyUsed.Has(err), err)
 		}
 
 		// ensure we can list all of them
@@ -106,3 +109,85 @@ func newRandomSerial() storj.SerialNumber {
 	_, _ = rand.Read(serial[:])
 	return serial
 }
+
+func TestUsedSerialsTable(t *testing.T) {
+	satellite0 := testidentity.MustPregeneratedIdentity(0, storj.LatestIDVersion())
+	satellite1 := testidentity.MustPregeneratedIdentity(1, storj.LatestIDVersion())
+
+	now := time.Now()
+
+	// room for three serial numbers of about 48 bytes
+	table := piecestore.NewUsedSerialsTable(3 * 48)
+
+	serial1 := newRandomSerial()
+	serial2 := newRandomSerial()
+
+	require.NoError(t, table.Add(satellite0.ID, serial1, now.Add(time.Minute)))
+	require.NoError(t, table.Add(satellite1.ID, serial1, now.Add(time.Minute)))
+	require.NoError(t, table.Add(satellite0.ID, serial2, now.Add(3*time.Hour)))
+
+	// duplicates are rejected regardless of their expiration
+	err := table.Add(satellite0.ID, serial1, now.Add(5*time.Hour))
+	require.True(t, piecestore.ErrSerialAlreadyUsed.Has(err))
+
+	// the table is full
+	err = table.Add(satellite1.ID, newRandomSerial(), now.Add(time.Minute))
+	require.True(t, piecestore.ErrUsedSerialsFull.Has(err))
+	require.EqualValues(t, 3, table.Count())
+
+	// serial numbers that haven't expired yet are kept
+	require.EqualValues(t, 0, table.DeleteExpired(now))
+
+	require.EqualValues(t, 2, table.DeleteExpired(now.Add(2*time.Hour)))
+	require.EqualValues(t, 1, table.Count())
+
+	// deleting a serial number makes room for it again
+	table.Delete(satellite0.ID, serial2, now.Add(3*time.Hour))
+	require.EqualValues(t, 0, table.Count())
+	require.NoError(t, table.Add(satellite0.ID, serial2, now.Add(3*time.Hour)))
+	require.EqualValues(t, 1, table.Count())
+
+	// pruning made room for new serial numbers
+	require.NoError(t, table.Add(satellite0.ID, serial1, now.Add(time.Minute)))
+	require.NoError(t, table.Add(satellite1.ID, serial1, now.Add(time.Minute)))
+	err = table.Add(satellite0.ID, serial2, now.Add(time.Minute))
+	require.True(t, piecestore.ErrSerialAlreadyUsed.Has(err))
+}
+
+func TestUsedSerialsChore(t *testing.T) {
+	storagenodedbtest.Run(t, func(t *testing.T, db storagenode.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		usedSerials := db.UsedSerials()
+
+		satellite := testidentity.MustPregeneratedIdentity(0, storj.LatestIDVersion())
+
+		now := time.Now()
+		expired := newRandomSerial()
+		active := newRandomSerial()
+
+		require.NoError(t, usedSerials.Add(ctx, satellite.ID, expired, now.Add(-3*time.Hour)))
+		require.NoError(t, usedSerials.Add(ctx, satellite.ID, active, now.Add(3*time.Hour)))
+
+		table := piecestore.NewUsedSerialsTable(memory.MiB.Int64())
+		chore := piecestore.NewUsedSerialsChore(zaptest.NewLogger(t), table, usedSerials, time.Hour, time.Hour)
+
+		// serial numbers are loaded from the database
+		require.NoError(t, chore.Load(ctx))
+		require.EqualValues(t, 2, table.Count())
+		require.Error(t, table.Add(satellite.ID, expired, now.Add(-3*time.Hour)))
+		require.Error(t, table.Add(satellite.ID, active, now.Add(3*time.Hour)))
+
+		// serial numbers are pruned after the grace period
+		require.NoError(t, chore.Prune(ctx, now))
+		require.EqualValues(t, 1, table.Count())
+
+		var stored []storj.SerialNumber
+		err := usedSerials.IterateAll(ctx, func(satelliteID storj.NodeID, serialNumber storj.SerialNumber, expiration time.Time) {
+			stored = append(stored, serialNumber)
+		})
+		require.NoError(t, err)
+		require.Equal(t, []storj.SerialNumber{active}, stored)
+	})
+}
@@ -0,0 +1,224 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package piecestore
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/sync2"
+	"storj.io/storj/pkg/storj"
+)
+
+var (
+	// ErrSerialAlreadyUsed is returned when the serial number is already in the used serials table.
+	ErrSerialAlreadyUsed = errs.Class("serial number already used")
+	// ErrUsedSerialsFull is returned when the used serials table has no room for another serial number.
+	ErrUsedSerialsFull = errs.Class("used serials table full")
+)
+
+const (
+	// usedSerialSize is the approximate memory used by a serial number in the table, including map overhead
+	usedSerialSize = int64(len(storj.SerialNumber{})) + 32
+
+	// expirationBucket is the granularity of expirations used for grouping serial numbers
+	expirationBucket = time.Hour
+)
+
+// UsedSerialsTable keeps the used serial numbers in memory to reject replayed orders,
+// serial numbers are grouped by satellite and expiration so that expired ones can be dropped at once
+type UsedSerialsTable struct {
+	mu      sync.Mutex
+	maxSize int64
+	size    int64
+	serials map[storj.NodeID]map[int64]map[storj.SerialNumber]struct{}
+}
+
+// NewUsedSerialsTable creates a used serials table that uses at most maxSize bytes of memory
+func NewUsedSerialsTable(maxSize int64) *UsedSerialsTable {
+	return &UsedSerialsTable{
+		maxSize: maxSize,
+		serials: make(map[storj.NodeID]map[int64]map[storj.SerialNumber]struct{}),
+	}
+}
+
+// Add adds the serial number to the table, it fails when the serial number is already used or when the table is full
+func (table *UsedSerialsTable) Add(satelliteID storj.NodeID, serialNumber storj.SerialNumber, expiration time.Time) error {
+	table.mu.Lock()
+	defer table.mu.Unlock()
+
+	buckets, ok := table.serials[satelliteID]
+	if !ok {
+		buckets = make(map[int64]map[storj.SerialNumber]struct{})
+		table.serials[satelliteID] = buckets
+	}
+
+	// the same serial number may have been signed with a different expiration
+	for _, bucket := range buckets {
+		if _, used := bucket[serialNumber]; used {
+			return ErrSerialAlreadyUsed.New("%v", serialNumber)
+		}
+	}
+
+	if table.size+usedSerialSize > table.maxSize {
+		return ErrUsedSerialsFull.New("%d bytes used", table.size)
+	}
+
+	key := expiration.Truncate(expirationBucket).Unix()
+	bucket, ok := buckets[key]
+	if !ok {
+		bucket = make(map[storj.SerialNumber]struct{})
+		buckets[key] = bucket
+	}
+	bucket[serialNumber] = struct{}{}
+	table.size += usedSerialSize
+	return nil
+}
+
+// Delete removes the serial number that was added with the expiration from the table
+func (table *UsedSerialsTable) Delete(satelliteID storj.NodeID, serialNumber storj.SerialNumber, expiration time.Time) {
+	table.mu.Lock()
+	defer table.mu.Unlock()
+
+	buckets, ok := table.serials[satelliteID]
+	if !ok {
+		return
+	}
+
+	key := expiration.Truncate(expirationBucket).Unix()
+	bucket, ok := buckets[key]
+	if !ok {
+		return
+	}
+	if _, used := bucket[serialNumber]; !used {
+		return
+	}
+
+	delete(bucket, serialNumber)
+	table.size -= usedSerialSize
+	if len(bucket) == 0 {
+		delete(buckets, key)
+	}
+	if len(buckets) == 0 {
+		delete(table.serials, satelliteID)
+	}
+}
+
+// DeleteExpired removes the serial numbers that expired before the given time and returns how many were removed,
+// serial numbers are kept until every serial number of their expiration group has expired
+func (table *UsedSerialsTable) DeleteExpired(expiredBefore time.Time) (deleted int64) {
+	table.mu.Lock()
+	defer table.mu.Unlock()
+
+	for satelliteID, buckets := range table.serials {
+		for key, bucket := range buckets {
+			if time.Unix(key, 0).Add(expirationBucket).After(expiredBefore) {
+				continue
+			}
+			deleted += int64(len(bucket))
+			delete(buckets, key)
+		}
+		if len(buckets) == 0 {
+			delete(table.serials, satelliteID)
+		}
+	}
+
+	table.size -= deleted * usedSerialSize
+	return deleted
+}
+
+// Count returns the number of serial numbers in the table
+func (table *UsedSerialsTable) Count() int64 {
+	table.mu.Lock()
+	defer table.mu.Unlock()
+	return table.size / usedSerialSize
+}
+
+// UsedSerialsChore loads the used serials table from the database on startup and prunes expired serial numbers
+type UsedSerialsChore struct {
+	log         *zap.Logger
+	table       *UsedSerialsTable
+	usedSerials UsedSerials
+	gracePeriod time.Duration
+
+	Loop sync2.Cycle
+}
+
+// NewUsedSerialsChore creates a new used serials chore, serial numbers are kept for gracePeriod after they expire,
+// because orders are accepted that long after their expiration
+func NewUsedSerialsChore(log *zap.Logger, table *UsedSerialsTable, usedSerials UsedSerials, interval, gracePeriod time.Duration) *UsedSerialsChore {
+	return &UsedSerialsChore{
+		log:         log,
+		table:       table,
+		usedSerials: usedSerials,
+		gracePeriod: gracePeriod,
+
+		Loop: *sync2.NewCycle(interval),
+	}
+}
+
+// Run loads the used serial numbers and prunes expired serial numbers on every interval
+func (chore *UsedSerialsChore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := chore.Load(ctx); err != nil {
+		return err
+	}
+
+	return chore.Loop.Run(ctx, func(ctx context.Context) error {
+		if err := chore.Prune(ctx, time.Now()); err != nil {
+			chore.log.Error("unable to prune used serials", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Load adds the serial numbers stored in the database to the table
+func (chore *UsedSerialsChore) Load(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var loaded, full int64
+	err = chore.usedSerials.IterateAll(ctx, func(satelliteID storj.NodeID, serialNumber storj.SerialNumber, expiration time.Time) {
+		err := chore.table.Add(satelliteID, serialNumber, expiration)
+		switch {
+		case err == nil:
+			loaded++
+		case ErrUsedSerialsFull.Has(err):
+			full++
+		}
+	})
+	if err != nil {
+		return ErrInternal.Wrap(err)
+	}
+
+	if full > 0 {
+		// the database still rejects the serial numbers that didn't fit
+		chore.log.Warn("used serials table is full", zap.Int64("loaded", loaded), zap.Int64("skipped", full))
+	}
+	chore.log.Debug("loaded used serials", zap.Int64("count", loaded))
+	return nil
+}
+
+// Prune deletes the serial numbers whose orders cannot be accepted anymore from the table and the database
+func (chore *UsedSerialsChore) Prune(ctx context.Context, now time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	expiredBefore := now.Add(-chore.gracePeriod)
+
+	deleted := chore.table.DeleteExpired(expiredBefore)
+	mon.IntVal("used_serials_deleted").Observe(deleted)
+	mon.IntVal("used_serials").Observe(chore.table.Count())
+
+	return ErrInternal.Wrap(chore.usedSerials.DeleteExpired(ctx, expiredBefore.UTC()))
+}
+
+// Close stops the used serials chore
+func (chore *UsedSerialsChore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
@@ -24,10 +24,14 @@ var (
 	ErrVerifyUntrusted = errs.Class("untrusted")
 	// ErrVerifyDuplicateRequest is returned when serial number has been already used to submit an action.
 	ErrVerifyDuplicateRequest = errs.Class("duplicate request")
+	// ErrVerifyOverloaded is returned when the used serials table has no room for the serial number,
+	// the request may be retried once expired serial numbers have been pruned.
+	ErrVerifyOverloaded = errs.Class("too many used serial numbers")
 )
 
 // VerifyOrderLimit verifies that the order limit is properly signed and has sane values.
-// It also verifies that the serial number has not been used.
+// It also verifies that the serial number has not been used, it returns ErrVerifyDuplicateRequest
+// when it has been and ErrVerifyOverloaded when there is no room to remember it.
 func (endpoint *Endpoint) VerifyOrderLimit(ctx context.Context, limit *pb.OrderLimit2) error {
 	// sanity checks
 	switch {
@@ -77,9 +81,22 @@ func (endpoint *Endpoint) VerifyOrderLimit(ctx context.Context, limit *pb.OrderL
 	if err != nil {
 		return ErrInternal.Wrap(err)
 	}
-	if err := endpoint.usedSerials.Add(ctx, limit.SatelliteId, limit.SerialNumber, serialExpiration); err != nil {
+	if err := endpoint.serials.Add(limit.SatelliteId, limit.SerialNumber, serialExpiration); err != nil {
+		if ErrUsedSerialsFull.Has(err) {
+			mon.Meter("used_serials_full").Mark(1)
+			return ErrVerifyOverloaded.Wrap(err)
+		}
 		return ErrVerifyDuplicateRequest.Wrap(err)
 	}
+	// the database keeps the serial numbers across restarts
+	if err := endpoint.usedSerials.Add(ctx, limit.SatelliteId, limit.SerialNumber, serialExpiration); err != nil {
+		if ErrSerialAlreadyUsed.Has(err) {
+			return ErrVerifyDuplicateRequest.Wrap(err)
+		}
+		// the serial number wasn't used, so it may be retried
+		endpoint.serials.Delete(limit.SatelliteId, limit.SerialNumber, serialExpiration)
+		return ErrInternal.Wrap(err)
+	}
 
 	return nil
 }
@@ -317,6 +317,9 @@ func setSpace(ctx context.Context, t *testing.T, planet *testplanet.Planet, spac
 		return
 	}
 	for _, storageNode := range planet.StorageNodes {
+		// wait for the disk check, it sets the allocated and the free space
+		storageNode.Storage2.Monitor.Loop.TriggerWait()
+
 		availableSpace, err := storageNode.Storage2.Monitor.AvailableSpace(ctx)
 		require.NoError(t, err)
 		diff := (space - availableSpace) * -1
@@ -328,5 +331,9 @@ func setSpace(ctx context.Context, t *testing.T, planet *testplanet.Planet, spac
 			UplinkPieceHash: &pb.PieceHash{},
 		})
 		require.NoError(t, err)
+
+		availableSpace, err = storageNode.Storage2.Monitor.AvailableSpace(ctx)
+		require.NoError(t, err)
+		require.Equal(t, space, availableSpace)
 	}
 }
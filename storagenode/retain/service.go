@@ -0,0 +1,195 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package retain
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/pkg/bloomfilter"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storagenode/pieces"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is the default error class for retain errors
+	Error = errs.Class("retain")
+)
+
+// batchSize is the number of piece IDs checked against the bloom filter at once
+const batchSize = 1000
+
+// Request contains the bloom filter of the pieces a satellite wants the node to keep
+type Request struct {
+	SatelliteID storj.NodeID
+	// CreatedBefore is the time the filter was created, newer pieces are never trashed
+	CreatedBefore time.Time
+	Filter        *bloomfilter.Filter
+}
+
+// Service trashes the pieces which are not in the bloom filter of their satellite in the background,
+// because walking all pieces of a satellite takes longer than the satellite waits for a response
+type Service struct {
+	log        *zap.Logger
+	store      *pieces.Store
+	pieceinfos pieces.DB
+	timeBuffer time.Duration
+
+	wake chan struct{}
+
+	mu      sync.Mutex
+	cond    sync.Cond
+	pending map[storj.NodeID]Request
+	working bool
+}
+
+// NewService creates a new retain service, pieces created within timeBuffer before the filter are kept,
+// because they might not have reached the satellite yet
+func NewService(log *zap.Logger, store *pieces.Store, pieceinfos pieces.DB, timeBuffer time.Duration) *Service {
+	service := &Service{
+		log:        log,
+		store:      store,
+		pieceinfos: pieceinfos,
+		timeBuffer: timeBuffer,
+
+		wake:    make(chan struct{}, 1),
+		pending: make(map[storj.NodeID]Request),
+	}
+	service.cond.L = &service.mu
+	return service
+}
+
+// Queue queues the request, it replaces the request of the satellite that hasn't been started yet
+func (service *Service) Queue(req Request) {
+	service.mu.Lock()
+	service.pending[req.SatelliteID] = req
+	service.mu.Unlock()
+
+	select {
+	case service.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Run processes the queued requests one at a time
+func (service *Service) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for {
+		for {
+			req, ok := service.next()
+			if !ok {
+				break
+			}
+
+			err := service.retain(ctx, req)
+			service.done()
+			if err != nil {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				service.log.Error("failed to collect garbage", zap.Stringer("Satellite ID", req.SatelliteID), zap.Error(err))
+			}
+		}
+
+		select {
+		case <-service.wake:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// next takes a pending request
+func (service *Service) next() (Request, bool) {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	for satelliteID, req := range service.pending {
+		delete(service.pending, satelliteID)
+		service.working = true
+		return req, true
+	}
+	return Request{}, false
+}
+
+// done marks the current request as finished
+func (service *Service) done() {
+	service.mu.Lock()
+	service.working = false
+	service.cond.Broadcast()
+	service.mu.Unlock()
+}
+
+// Wait waits until all queued requests have been processed
+func (service *Service) Wait() {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	for service.working || len(service.pending) > 0 {
+		service.cond.Wait()
+	}
+}
+
+// retain trashes the pieces of the satellite which were created before the filter and are not in it
+func (service *Service) retain(ctx context.Context, req Request) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	createdBefore := req.CreatedBefore.Add(-service.timeBuffer)
+
+	var deleted int64
+	offset := 0
+	for {
+		pieceIDs, err := service.pieceinfos.GetPieceIDs(ctx, req.SatelliteID, createdBefore, batchSize, offset)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		for _, pieceID := range pieceIDs {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if req.Filter.Contains(pieceID) {
+				offset++
+				continue
+			}
+
+			pieceInfoErr := service.pieceinfos.Trash(ctx, req.SatelliteID, pieceID, time.Now())
+			pieceErr := service.store.Trash(ctx, req.SatelliteID, pieceID)
+			if pieceInfoErr != nil {
+				// the piece info is still there, skip it in the next batch
+				offset++
+			}
+			if err := errs.Combine(pieceInfoErr, pieceErr); err != nil {
+				service.log.Error("failed to delete garbage piece", zap.Stringer("Piece ID", pieceID), zap.Error(err))
+				continue
+			}
+			deleted++
+		}
+
+		if len(pieceIDs) < batchSize {
+			break
+		}
+	}
+
+	mon.IntVal("garbage_pieces_deleted").Observe(deleted)
+	service.log.Info("garbage collected", zap.Stringer("Satellite ID", req.SatelliteID), zap.Int64("deleted", deleted))
+	return nil
+}
+
+// Close wakes up waiters, the service stops with the context of Run
+func (service *Service) Close() error {
+	service.mu.Lock()
+	service.pending = make(map[storj.NodeID]Request)
+	service.cond.Broadcast()
+	service.mu.Unlock()
+	return nil
+}
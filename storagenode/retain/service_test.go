@@ -0,0 +1,125 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package retain_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testidentity"
+	"storj.io/storj/pkg/auth/signing"
+	"storj.io/storj/pkg/bloomfilter"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/retain"
+	"storj.io/storj/storagenode/storagenodedb/storagenodedbtest"
+)
+
+func TestRetain(t *testing.T) {
+	storagenodedbtest.Run(t, func(t *testing.T, db storagenode.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		log := zaptest.NewLogger(t)
+		store := pieces.NewStore(log, db.Pieces())
+		pieceinfos := db.PieceInfo()
+
+		satellite := testidentity.MustPregeneratedSignedIdentity(0, storj.LatestIDVersion())
+		uplink := testidentity.MustPregeneratedSignedIdentity(1, storj.LatestIDVersion())
+
+		now := time.Now()
+
+		addPiece := func(creation time.Time) storj.PieceID {
+			pieceID := storj.NewPieceID()
+
+			writer, err := store.Writer(ctx, satellite.ID, pieceID)
+			require.NoError(t, err)
+			_, err = writer.Write(make([]byte, 100))
+			require.NoError(t, err)
+			require.NoError(t, writer.Commit())
+
+			hash, err := signing.SignPieceHash(
+				signing.SignerFromFullIdentity(uplink),
+				&pb.PieceHash{
+					PieceId: pieceID,
+					Hash:    []byte{1, 2, 3},
+				})
+			require.NoError(t, err)
+
+			require.NoError(t, pieceinfos.Add(ctx, &pieces.Info{
+				SatelliteID:     satellite.ID,
+				PieceID:         pieceID,
+				PieceSize:       100,
+				PieceCreation:   creation,
+				UplinkPieceHash: hash,
+				Uplink:          uplink.PeerIdentity(),
+			}))
+			return pieceID
+		}
+
+		keptPiece := addPiece(now.Add(-2 * time.Hour))
+		garbagePiece := addPiece(now.Add(-2 * time.Hour))
+		// this piece might not have reached the satellite yet
+		recentPiece := addPiece(now.Add(-time.Minute))
+
+		filter := bloomfilter.NewOptimal(10, 0.000001)
+		filter.Add(keptPiece)
+
+		service := retain.NewService(log, store, pieceinfos, time.Hour)
+		defer ctx.Check(service.Close)
+
+		// the request which hasn't been started yet is replaced by the newer one
+		service.Queue(retain.Request{
+			SatelliteID:   satellite.ID,
+			CreatedBefore: now,
+			Filter:        bloomfilter.NewOptimal(10, 0.000001),
+		})
+		service.Queue(retain.Request{
+			SatelliteID:   satellite.ID,
+			CreatedBefore: now,
+			Filter:        filter,
+		})
+
+		runCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		ctx.Go(func() error {
+			err := service.Run(runCtx)
+			if err == context.Canceled {
+				return nil
+			}
+			return err
+		})
+
+		service.Wait()
+
+		for _, pieceID := range []storj.PieceID{keptPiece, recentPiece} {
+			_, err := pieceinfos.Get(ctx, satellite.ID, pieceID)
+			require.NoError(t, err)
+
+			reader, err := store.Reader(ctx, satellite.ID, pieceID)
+			require.NoError(t, err)
+			require.NoError(t, reader.Close())
+		}
+
+		_, err := pieceinfos.Get(ctx, satellite.ID, garbagePiece)
+		require.Error(t, err)
+		_, err = store.Reader(ctx, satellite.ID, garbagePiece)
+		require.Error(t, err)
+
+		// the garbage piece can be restored from the trash
+		restored, err := pieceinfos.RestoreTrash(ctx, satellite.ID)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, restored)
+		restored, err = store.RestoreTrash(ctx, satellite.ID)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, restored)
+	})
+}
@@ -67,6 +67,38 @@ func (db *bandwidthdb) Summary(ctx context.Context, from, to time.Time) (_ *band
 	return usage, ErrInfo.Wrap(rows.Err())
 }
 
+// SatelliteSummary returns summary of bandwidth usage of a single satellite.
+func (db *bandwidthdb) SatelliteSummary(ctx context.Context, satelliteID storj.NodeID, from, to time.Time) (_ *bandwidth.Usage, err error) {
+	defer db.locked()()
+
+	usage := &bandwidth.Usage{}
+
+	rows, err := db.db.Query(`
+		SELECT action, sum(amount) 
+		FROM bandwidth_usage
+		WHERE satellite_id = ? AND ? <= created_at AND created_at <= ?
+		GROUP BY action`, satelliteID, from, to)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return usage, nil
+		}
+		return nil, ErrInfo.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var action pb.PieceAction
+		var amount int64
+		err := rows.Scan(&action, &amount)
+		if err != nil {
+			return nil, ErrInfo.Wrap(err)
+		}
+		usage.Include(action, amount)
+	}
+
+	return usage, ErrInfo.Wrap(rows.Err())
+}
+
 // SummaryBySatellite returns summary of bandwidth usage grouping by satellite.
 func (db *bandwidthdb) SummaryBySatellite(ctx context.Context, from, to time.Time) (_ map[storj.NodeID]*bandwidth.Usage, err error) {
 	defer db.locked()()
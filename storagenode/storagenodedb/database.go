@@ -27,6 +27,16 @@ type Config struct {
 	Kademlia string
 
 	Pieces string
+	// AdditionalPieces are the directories used for storing pieces besides Pieces
+	AdditionalPieces []string
+	// Placement decides in which directory new pieces are stored
+	Placement filestore.Placement
+}
+
+// blobStore is a blob storage that needs to be closed
+type blobStore interface {
+	storage.Blobs
+	Close() error
 }
 
 // DB contains access to different database tables
@@ -34,10 +44,7 @@ type DB struct {
 	log  *zap.Logger
 	psdb *psdb.DB
 
-	pieces interface {
-		storage.Blobs
-		Close() error
-	}
+	pieces blobStore
 
 	info *InfoDB
 
@@ -46,11 +53,10 @@ type DB struct {
 
 // New creates a new master database for storage node
 func New(log *zap.Logger, config Config) (*DB, error) {
-	piecesDir, err := filestore.NewDir(config.Pieces)
+	pieces, err := openPieces(config)
 	if err != nil {
 		return nil, err
 	}
-	pieces := filestore.New(piecesDir)
 
 	infodb, err := newInfo(config.Info2)
 	if err != nil {
@@ -80,6 +86,20 @@ func New(log *zap.Logger, config Config) (*DB, error) {
 	}, nil
 }
 
+// openPieces opens the blob storage for pieces in the configured directories
+func openPieces(config Config) (blobStore, error) {
+	if len(config.AdditionalPieces) == 0 {
+		piecesDir, err := filestore.NewDir(config.Pieces)
+		if err != nil {
+			return nil, err
+		}
+		return filestore.New(piecesDir), nil
+	}
+
+	paths := append([]string{config.Pieces}, config.AdditionalPieces...)
+	return filestore.NewMultiStoreAt(paths, config.Placement)
+}
+
 // NewInMemory creates new inmemory master database for storage node
 // TODO: still stores data on disk
 func NewInMemory(log *zap.Logger, storageDir string) (*DB, error) {
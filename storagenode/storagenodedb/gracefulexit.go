@@ -0,0 +1,86 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package storagenodedb
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storagenode/gracefulexit"
+)
+
+type gracefulExit struct {
+	*InfoDB
+}
+
+// GracefulExit returns graceful exit database.
+func (db *DB) GracefulExit() gracefulexit.DB { return db.info.GracefulExit() }
+
+// GracefulExit returns graceful exit database.
+func (db *InfoDB) GracefulExit() gracefulexit.DB { return &gracefulExit{db} }
+
+// InitiateExit records the start of an exit, an existing exit is left unchanged.
+func (db *gracefulExit) InitiateExit(ctx context.Context, satelliteID storj.NodeID, initiatedAt time.Time) error {
+	defer db.locked()()
+
+	_, err := db.db.Exec(`
+		INSERT OR IGNORE INTO
+			satellite_exit(satellite_id, initiated_at, finished_at, successful, pieces_transferred, transfers_failed)
+		VALUES(?, ?, NULL, 0, 0, 0)`, satelliteID, initiatedAt)
+
+	return ErrInfo.Wrap(err)
+}
+
+// IncrementProgress adds to the transferred pieces and failed transfers of an exit.
+func (db *gracefulExit) IncrementProgress(ctx context.Context, satelliteID storj.NodeID, piecesTransferred, transfersFailed int64) error {
+	defer db.locked()()
+
+	_, err := db.db.Exec(`
+		UPDATE satellite_exit
+		SET pieces_transferred = pieces_transferred + ?,
+			transfers_failed = transfers_failed + ?
+		WHERE satellite_id = ?`, piecesTransferred, transfersFailed, satelliteID)
+
+	return ErrInfo.Wrap(err)
+}
+
+// CompleteExit records the outcome of an exit.
+func (db *gracefulExit) CompleteExit(ctx context.Context, satelliteID storj.NodeID, finishedAt time.Time, successful bool) error {
+	defer db.locked()()
+
+	_, err := db.db.Exec(`
+		UPDATE satellite_exit
+		SET finished_at = ?, successful = ?
+		WHERE satellite_id = ?`, finishedAt, successful, satelliteID)
+
+	return ErrInfo.Wrap(err)
+}
+
+// ListExits returns all exits, finished or not.
+func (db *gracefulExit) ListExits(ctx context.Context) (exits []gracefulexit.ExitProgress, err error) {
+	defer db.locked()()
+
+	rows, err := db.db.Query(`
+		SELECT satellite_id, initiated_at, finished_at, successful, pieces_transferred, transfers_failed
+		FROM satellite_exit
+		ORDER BY initiated_at`)
+	if err != nil {
+		return nil, ErrInfo.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, ErrInfo.Wrap(rows.Close())) }()
+
+	for rows.Next() {
+		var exit gracefulexit.ExitProgress
+		err := rows.Scan(&exit.SatelliteID, &exit.InitiatedAt, &exit.FinishedAt, &exit.Successful, &exit.PiecesTransferred, &exit.TransfersFailed)
+		if err != nil {
+			return nil, ErrInfo.Wrap(err)
+		}
+		exits = append(exits, exit)
+	}
+
+	return exits, ErrInfo.Wrap(rows.Err())
+}
@@ -4,6 +4,7 @@
 package storagenodedb
 
 import (
+	"context"
 	"database/sql"
 	"os"
 	"path/filepath"
@@ -71,6 +72,22 @@ func (db *InfoDB) RawDB() *sql.DB { return db.db }
 // Begin begins transaction
 func (db *InfoDB) Begin() (*sql.Tx, error) { return db.db.Begin() }
 
+// withTx runs fn in a transaction, which is committed when fn succeeds and rolled back otherwise.
+func (db *InfoDB) withTx(ctx context.Context, fn func(tx *sql.Tx) error) (err error) {
+	tx, err := db.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			err = errs.Combine(err, tx.Rollback())
+			return
+		}
+		err = tx.Commit()
+	}()
+	return fn(tx)
+}
+
 // Rebind rebind parameters
 func (db *InfoDB) Rebind(s string) string { return s }
 
@@ -163,6 +180,58 @@ func (db *InfoDB) Migration() *migrate.Migration {
 					`CREATE INDEX idx_order_archive_status ON order_archive(status)`,
 				},
 			},
+			{
+				Description: "Add creation date to pieceinfo for garbage collection",
+				Version:     1,
+				Action: migrate.SQL{
+					// pieces stored before this migration are treated as old enough to be collected
+					`ALTER TABLE pieceinfo ADD COLUMN piece_creation TIMESTAMP NOT NULL DEFAULT '1970-01-01 00:00:00+00:00'`,
+				},
+			},
+			{
+				Description: "Add table for graceful exits from satellites",
+				Version:     2,
+				Action: migrate.SQL{
+					`CREATE TABLE satellite_exit (
+						satellite_id       BLOB      NOT NULL,
+						initiated_at       TIMESTAMP NOT NULL,
+						finished_at        TIMESTAMP,
+						successful         INTEGER   NOT NULL,
+						pieces_transferred BIGINT    NOT NULL,
+						transfers_failed   BIGINT    NOT NULL
+					)`,
+					`CREATE UNIQUE INDEX pk_satellite_exit ON satellite_exit(satellite_id)`,
+				},
+			},
+			{
+				Description: "Add trash for piece information of deleted pieces",
+				Version:     3,
+				Action: migrate.SQL{
+					`CREATE TABLE pieceinfo_trash (
+						satellite_id     BLOB      NOT NULL,
+						piece_id         BLOB      NOT NULL,
+						piece_size       BIGINT    NOT NULL,
+						piece_expiration TIMESTAMP,
+						piece_creation   TIMESTAMP NOT NULL,
+
+						uplink_piece_hash BLOB    NOT NULL,
+						uplink_cert_id    INTEGER NOT NULL,
+
+						trashed_at TIMESTAMP NOT NULL,
+
+						FOREIGN KEY(uplink_cert_id) REFERENCES certificate(cert_id)
+					)`,
+					`CREATE UNIQUE INDEX pk_pieceinfo_trash ON pieceinfo_trash(satellite_id, piece_id)`,
+					`CREATE INDEX idx_pieceinfo_trash_trashed_at ON pieceinfo_trash(trashed_at)`,
+				},
+			},
+			{
+				Description: "Add index for expiring unsent orders",
+				Version:     4,
+				Action: migrate.SQL{
+					`CREATE INDEX idx_unsent_order_expiration ON unsent_order(order_limit_expiration)`,
+				},
+			},
 		},
 	}
 }
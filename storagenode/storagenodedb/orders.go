@@ -164,6 +164,122 @@ func (db *ordersdb) ListUnsentBySatellite(ctx context.Context) (map[storj.NodeID
 	return infos, ErrInfo.Wrap(rows.Err())
 }
 
+// ListUnsentForSatellite returns at most limit orders of the satellite that haven't been sent yet,
+// orders that expire first are returned first. Does not return uplink identity.
+func (db *ordersdb) ListUnsentForSatellite(ctx context.Context, satellite storj.NodeID, limit int) (_ []*orders.Info, err error) {
+	defer db.locked()()
+
+	rows, err := db.db.Query(`
+		SELECT order_limit_serialized, order_serialized
+		FROM unsent_order
+		WHERE satellite_id = ?
+		ORDER BY order_limit_expiration
+		LIMIT ?
+	`, satellite, limit)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, ErrInfo.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var infos []*orders.Info
+	for rows.Next() {
+		var limitSerialized []byte
+		var orderSerialized []byte
+
+		err := rows.Scan(&limitSerialized, &orderSerialized)
+		if err != nil {
+			return nil, ErrInfo.Wrap(err)
+		}
+
+		var info orders.Info
+		info.Limit = &pb.OrderLimit2{}
+		info.Order = &pb.Order2{}
+
+		err = proto.Unmarshal(limitSerialized, info.Limit)
+		if err != nil {
+			return nil, ErrInfo.Wrap(err)
+		}
+
+		err = proto.Unmarshal(orderSerialized, info.Order)
+		if err != nil {
+			return nil, ErrInfo.Wrap(err)
+		}
+
+		infos = append(infos, &info)
+	}
+
+	return infos, ErrInfo.Wrap(rows.Err())
+}
+
+// CountUnsentBySatellite returns the number of orders that haven't been sent yet for every satellite.
+func (db *ordersdb) CountUnsentBySatellite(ctx context.Context) (_ map[storj.NodeID]int64, err error) {
+	defer db.locked()()
+
+	rows, err := db.db.Query(`
+		SELECT satellite_id, COUNT(*)
+		FROM unsent_order
+		GROUP BY satellite_id
+	`)
+	if err != nil {
+		return nil, ErrInfo.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	counts := map[storj.NodeID]int64{}
+	for rows.Next() {
+		var satelliteID storj.NodeID
+		var count int64
+
+		err := rows.Scan(&satelliteID, &count)
+		if err != nil {
+			return nil, ErrInfo.Wrap(err)
+		}
+		counts[satelliteID] = count
+	}
+
+	return counts, ErrInfo.Wrap(rows.Err())
+}
+
+// ExpireUnsent archives unsent orders that expired before now as failed and returns how many were expired.
+func (db *ordersdb) ExpireUnsent(ctx context.Context, now time.Time) (count int64, err error) {
+	defer db.locked()()
+
+	err = db.withTx(ctx, func(tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO order_archive (
+				satellite_id, serial_number,
+				order_limit_serialized, order_serialized,
+				uplink_cert_id,
+				status, archived_at
+			) SELECT
+				satellite_id, serial_number,
+				order_limit_serialized, order_serialized,
+				uplink_cert_id,
+				?, ?
+			FROM unsent_order
+			WHERE order_limit_expiration < ?
+		`, int(orders.StatusFailed), now.UTC(), now.UTC())
+		if err != nil {
+			return err
+		}
+
+		result, err := tx.ExecContext(ctx, `DELETE FROM unsent_order WHERE order_limit_expiration < ?`, now.UTC())
+		if err != nil {
+			return err
+		}
+
+		count, err = result.RowsAffected()
+		return err
+	})
+	if err != nil {
+		return 0, ErrInfo.Wrap(err)
+	}
+	return count, nil
+}
+
 // Archive marks order as being handled.
 func (db *ordersdb) Archive(ctx context.Context, satellite storj.NodeID, serial storj.SerialNumber, status orders.Status) error {
 	defer db.locked()()
@@ -260,3 +376,22 @@ func (db *ordersdb) ListArchived(ctx context.Context, limit int) ([]*orders.Arch
 
 	return infos, ErrInfo.Wrap(rows.Err())
 }
+
+// DeleteArchived deletes orders archived before archivedBefore and returns how many were deleted.
+func (db *ordersdb) DeleteArchived(ctx context.Context, archivedBefore time.Time) (count int64, err error) {
+	defer db.locked()()
+
+	err = db.withTx(ctx, func(tx *sql.Tx) error {
+		result, err := tx.ExecContext(ctx, `DELETE FROM order_archive WHERE julianday(archived_at) < julianday(?)`, archivedBefore.UTC())
+		if err != nil {
+			return err
+		}
+
+		count, err = result.RowsAffected()
+		return err
+	})
+	if err != nil {
+		return 0, ErrInfo.Wrap(err)
+	}
+	return count, nil
+}
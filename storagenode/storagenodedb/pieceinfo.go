@@ -41,9 +41,9 @@ func (db *pieceinfo) Add(ctx context.Context, info *pieces.Info) error {
 
 	_, err = db.db.Exec(`
 		INSERT INTO
-			pieceinfo(satellite_id, piece_id, piece_size, piece_expiration, uplink_piece_hash, uplink_cert_id)
-		VALUES (?,?,?,?,?,?)
-	`, info.SatelliteID, info.PieceID, info.PieceSize, info.PieceExpiration, uplinkPieceHash, certid)
+			pieceinfo(satellite_id, piece_id, piece_size, piece_creation, piece_expiration, uplink_piece_hash, uplink_cert_id)
+		VALUES (?,?,?,?,?,?,?)
+	`, info.SatelliteID, info.PieceID, info.PieceSize, info.PieceCreation.UTC(), info.PieceExpiration, uplinkPieceHash, certid)
 
 	return ErrInfo.Wrap(err)
 }
@@ -59,11 +59,11 @@ func (db *pieceinfo) Get(ctx context.Context, satelliteID storj.NodeID, pieceID
 
 	db.mu.Lock()
 	err := db.db.QueryRow(`
-		SELECT piece_size, piece_expiration, uplink_piece_hash, certificate.peer_identity
+		SELECT piece_size, piece_creation, piece_expiration, uplink_piece_hash, certificate.peer_identity
 		FROM pieceinfo
 		INNER JOIN certificate ON pieceinfo.uplink_cert_id = certificate.cert_id
 		WHERE satellite_id = ? AND piece_id = ?
-	`, satelliteID, pieceID).Scan(&info.PieceSize, &info.PieceExpiration, &uplinkPieceHash, &uplinkIdentity)
+	`, satelliteID, pieceID).Scan(&info.PieceSize, &info.PieceCreation, &info.PieceExpiration, &uplinkPieceHash, &uplinkIdentity)
 	db.mu.Unlock()
 
 	if err != nil {
@@ -93,6 +93,33 @@ func (db *pieceinfo) Delete(ctx context.Context, satelliteID storj.NodeID, piece
 	return ErrInfo.Wrap(err)
 }
 
+// GetPieceIDs gets piece IDs stored for the satellite that were created before createdBefore.
+func (db *pieceinfo) GetPieceIDs(ctx context.Context, satelliteID storj.NodeID, createdBefore time.Time, limit, offset int) (pieceIDs []storj.PieceID, err error) {
+	defer db.locked()()
+
+	rows, err := db.db.QueryContext(ctx, db.Rebind(`
+		SELECT piece_id
+		FROM pieceinfo
+		WHERE satellite_id = ? AND julianday(piece_creation) < julianday(?)
+		ORDER BY piece_id
+		LIMIT ? OFFSET ?
+	`), satelliteID, createdBefore.UTC(), limit, offset)
+	if err != nil {
+		return nil, ErrInfo.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var pieceID storj.PieceID
+		err = rows.Scan(&pieceID)
+		if err != nil {
+			return pieceIDs, ErrInfo.Wrap(err)
+		}
+		pieceIDs = append(pieceIDs, pieceID)
+	}
+	return pieceIDs, nil
+}
+
 // SpaceUsed calculates disk space used by all pieces
 func (db *pieceinfo) SpaceUsed(ctx context.Context) (int64, error) {
 	defer db.locked()()
@@ -105,14 +132,49 @@ func (db *pieceinfo) SpaceUsed(ctx context.Context) (int64, error) {
 	return *sum, err
 }
 
-// GetExpired gets orders that are expired and were created before some time
-func (db *pieceinfo) GetExpired(ctx context.Context, expiredAt time.Time) (info []pieces.Info, err error) {
+// SpaceUsedForSatellite calculates disk space used by pieces of a single satellite
+func (db *pieceinfo) SpaceUsedForSatellite(ctx context.Context, satelliteID storj.NodeID) (int64, error) {
+	defer db.locked()()
+
+	var sum *int64
+	err := db.db.QueryRow(`SELECT SUM(piece_size) FROM pieceinfo WHERE satellite_id = ?;`, satelliteID).Scan(&sum)
+	if err == sql.ErrNoRows || sum == nil {
+		return 0, nil
+	}
+	return *sum, ErrInfo.Wrap(err)
+}
+
+// SpaceUsedBySatellite calculates disk space used by pieces grouped by satellite
+func (db *pieceinfo) SpaceUsedBySatellite(ctx context.Context) (_ map[storj.NodeID]int64, err error) {
+	defer db.locked()()
+
+	usage := map[storj.NodeID]int64{}
+
+	rows, err := db.db.Query(`SELECT satellite_id, SUM(piece_size) FROM pieceinfo GROUP BY satellite_id;`)
+	if err != nil {
+		return nil, ErrInfo.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var satelliteID storj.NodeID
+		var sum int64
+		if err := rows.Scan(&satelliteID, &sum); err != nil {
+			return nil, ErrInfo.Wrap(err)
+		}
+		usage[satelliteID] = sum
+	}
+	return usage, ErrInfo.Wrap(rows.Err())
+}
+
+// GetExpired gets at most limit pieces that expired before expiredAt, skipping the first offset pieces
+func (db *pieceinfo) GetExpired(ctx context.Context, expiredAt time.Time, limit, offset int64) (info []pieces.Info, err error) {
 	var getExpiredSQL = `SELECT satellite_id, piece_id, piece_size, piece_expiration, uplink_piece_hash, certificate.peer_identity
 		FROM pieceinfo
 		INNER JOIN certificate ON pieceinfo.uplink_cert_id = certificate.cert_id
-		WHERE piece_expiration < ? ORDER BY satellite_id `
+		WHERE piece_expiration < ? ORDER BY satellite_id, piece_id LIMIT ? OFFSET ? `
 
-	rows, err := db.db.QueryContext(ctx, db.Rebind(getExpiredSQL), expiredAt)
+	rows, err := db.db.QueryContext(ctx, db.Rebind(getExpiredSQL), expiredAt, limit, offset)
 	if err != nil {
 		return nil, ErrInfo.Wrap(err)
 	}
@@ -149,3 +211,87 @@ func (db *pieceinfo) DeleteExpired(ctx context.Context, expiredAt time.Time, sat
 
 	return ErrInfo.Wrap(err)
 }
+
+// Trash moves piece information to the trash.
+func (db *pieceinfo) Trash(ctx context.Context, satelliteID storj.NodeID, pieceID storj.PieceID, trashedAt time.Time) error {
+	defer db.locked()()
+
+	return ErrInfo.Wrap(db.withTx(ctx, func(tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, `
+			INSERT OR REPLACE INTO pieceinfo_trash (
+				satellite_id, piece_id, piece_size, piece_expiration, piece_creation,
+				uplink_piece_hash, uplink_cert_id,
+				trashed_at
+			) SELECT
+				satellite_id, piece_id, piece_size, piece_expiration, piece_creation,
+				uplink_piece_hash, uplink_cert_id,
+				?
+			FROM pieceinfo
+			WHERE satellite_id = ? AND piece_id = ?
+		`, trashedAt.UTC(), satelliteID, pieceID)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, `DELETE FROM pieceinfo WHERE satellite_id = ? AND piece_id = ?`, satelliteID, pieceID)
+		return err
+	}))
+}
+
+// RestoreTrash moves piece information of all trashed pieces of the satellite back.
+func (db *pieceinfo) RestoreTrash(ctx context.Context, satelliteID storj.NodeID) (count int64, err error) {
+	defer db.locked()()
+
+	err = db.withTx(ctx, func(tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, `
+			INSERT OR IGNORE INTO pieceinfo (
+				satellite_id, piece_id, piece_size, piece_expiration, piece_creation,
+				uplink_piece_hash, uplink_cert_id
+			) SELECT
+				satellite_id, piece_id, piece_size, piece_expiration, piece_creation,
+				uplink_piece_hash, uplink_cert_id
+			FROM pieceinfo_trash
+			WHERE satellite_id = ?
+		`, satelliteID)
+		if err != nil {
+			return err
+		}
+
+		result, err := tx.ExecContext(ctx, `DELETE FROM pieceinfo_trash WHERE satellite_id = ?`, satelliteID)
+		if err != nil {
+			return err
+		}
+
+		count, err = result.RowsAffected()
+		return err
+	})
+	if err != nil {
+		return 0, ErrInfo.Wrap(err)
+	}
+	return count, nil
+}
+
+// DeleteTrash deletes piece information of pieces trashed before trashedBefore.
+func (db *pieceinfo) DeleteTrash(ctx context.Context, trashedBefore time.Time) (int64, error) {
+	defer db.locked()()
+
+	result, err := db.db.Exec(`DELETE FROM pieceinfo_trash WHERE julianday(trashed_at) < julianday(?)`, trashedBefore.UTC())
+	if err != nil {
+		return 0, ErrInfo.Wrap(err)
+	}
+
+	count, err := result.RowsAffected()
+	return count, ErrInfo.Wrap(err)
+}
+
+// SpaceUsedInTrash calculates disk space used by trashed pieces
+func (db *pieceinfo) SpaceUsedInTrash(ctx context.Context) (int64, error) {
+	defer db.locked()()
+
+	var sum *int64
+	err := db.db.QueryRow(`SELECT SUM(piece_size) FROM pieceinfo_trash;`).Scan(&sum)
+	if err == sql.ErrNoRows || sum == nil {
+		return 0, nil
+	}
+	return *sum, ErrInfo.Wrap(err)
+}
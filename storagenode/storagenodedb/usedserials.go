@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	sqlite3 "github.com/mattn/go-sqlite3"
 	"github.com/zeebo/errs"
 
 	"storj.io/storj/pkg/storj"
@@ -31,6 +32,9 @@ func (db *usedSerials) Add(ctx context.Context, satelliteID storj.NodeID, serial
 		INSERT INTO 
 			used_serial(satellite_id, serial_number, expiration) 
 		VALUES(?, ?, ?)`, satelliteID, serialNumber, expiration)
+	if sqliteErr, ok := err.(sqlite3.Error); ok && sqliteErr.Code == sqlite3.ErrConstraint {
+		return piecestore.ErrSerialAlreadyUsed.Wrap(err)
+	}
 
 	return ErrInfo.Wrap(err)
 }
@@ -94,6 +94,18 @@ func (pool *Pool) VerifyUplinkID(ctx context.Context, id storj.NodeID) error {
 	return nil
 }
 
+// GetSatellites returns a list of satellites in the pool.
+// When all satellites are trusted, only satellites that have contacted the node are included.
+func (pool *Pool) GetSatellites(ctx context.Context) (satellites []storj.NodeID) {
+	pool.mu.RLock()
+	defer pool.mu.RUnlock()
+
+	for id := range pool.trustedSatellites {
+		satellites = append(satellites, id)
+	}
+	return satellites
+}
+
 // GetSignee gets the corresponding signee for verifying signatures.
 // It ignores passed in ctx cancellation to avoid miscaching between concurrent requests.
 func (pool *Pool) GetSignee(ctx context.Context, id storj.NodeID) (signing.Signee, error) {
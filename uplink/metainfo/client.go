@@ -57,6 +57,7 @@ type Client interface {
 	ReadSegment(ctx context.Context, bucket string, path storj.Path, segmentIndex int64) (*pb.Pointer, []*pb.AddressedOrderLimit, error)
 	DeleteSegment(ctx context.Context, bucket string, path storj.Path, segmentIndex int64) ([]*pb.AddressedOrderLimit, error)
 	ListSegments(ctx context.Context, bucket string, prefix, startAfter, endBefore storj.Path, recursive bool, limit int32, metaFlags uint32) (items []ListItem, more bool, err error)
+	SetBucketPlacement(ctx context.Context, bucket string, placement string) error
 }
 
 // NewClient initializes a new metainfo client
@@ -223,3 +224,14 @@ func (metainfo *Metainfo) ListSegments(ctx context.Context, bucket string, prefi
 
 	return items, response.GetMore(), nil
 }
+
+// SetBucketPlacement sets the placement constraint (e.g. "EU" or a country code) of the bucket, empty removes it
+func (metainfo *Metainfo) SetBucketPlacement(ctx context.Context, bucket string, placement string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = metainfo.client.SetBucketPlacement(ctx, &pb.SetBucketPlacementRequest{
+		Bucket:    []byte(bucket),
+		Placement: placement,
+	})
+	return Error.Wrap(err)
+}
@@ -65,6 +65,18 @@ func (client *Client) Delete(ctx context.Context, limit *pb.OrderLimit2) error {
 	return Error.Wrap(err)
 }
 
+// Retain uses a bloom filter to tell the piece store which pieces to keep.
+func (client *Client) Retain(ctx context.Context, req *pb.RetainRequest) error {
+	_, err := client.client.Retain(ctx, req)
+	return Error.Wrap(err)
+}
+
+// RestoreTrash asks the storage node to restore all pieces of the satellite that are in the trash.
+func (client *Client) RestoreTrash(ctx context.Context) error {
+	_, err := client.client.RestoreTrash(ctx, &pb.RestoreTrashRequest{})
+	return Error.Wrap(err)
+}
+
 // Close closes the underlying connection.
 func (client *Client) Close() error {
 	return client.conn.Close()